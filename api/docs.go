@@ -72,6 +72,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/httputil.Error"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -168,7 +174,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/dialogs": {
+        "/blocks": {
             "get": {
                 "security": [
                     {
@@ -182,14 +188,47 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "dialogs"
+                    "user-blocks"
                 ],
-                "summary": "List all dialogs",
+                "summary": "List users blocked by the current user",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.DialogList"
+                            "$ref": "#/definitions/v1.UserBlockList"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/channels": {
+            "get": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "channels"
+                ],
+                "summary": "List channels the current user is subscribed to",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ChannelList"
                         }
                     },
                     "500": {
@@ -206,6 +245,7 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
+                "description": "The current user is subscribed to the created channel as an admin.",
                 "consumes": [
                     "application/json"
                 ],
@@ -213,9 +253,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "dialogs"
+                    "channels"
                 ],
-                "summary": "Create a dialog with a specified partner",
+                "summary": "Create a channel",
                 "parameters": [
                     {
                         "description": "Body to create",
@@ -223,7 +263,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.DialogCreate"
+                            "$ref": "#/definitions/v1.ChannelCreate"
                         }
                     }
                 ],
@@ -231,7 +271,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/v1.Dialog"
+                            "$ref": "#/definitions/v1.Channel"
                         }
                     },
                     "400": {
@@ -249,7 +289,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/dialogs/{dialog_id}": {
+        "/channels/{channel_id}": {
             "get": {
                 "security": [
                     {
@@ -263,14 +303,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "dialogs"
+                    "channels"
                 ],
-                "summary": "Get a specified dialog",
+                "summary": "Get a specified channel",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Dialog identity",
-                        "name": "dialog_id",
+                        "description": "Channel identity",
+                        "name": "channel_id",
                         "in": "path",
                         "required": true
                     }
@@ -279,7 +319,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.Dialog"
+                            "$ref": "#/definitions/v1.Channel"
                         }
                     },
                     "400": {
@@ -302,7 +342,7 @@ const docTemplate = `{
                     }
                 }
             },
-            "patch": {
+            "put": {
                 "security": [
                     {
                         "JWTAuth": []
@@ -315,14 +355,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "dialogs"
+                    "channels"
                 ],
-                "summary": "Update a specified dialog",
+                "summary": "Update a specified channel",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Dialog identity",
-                        "name": "dialog_id",
+                        "description": "Channel identity",
+                        "name": "channel_id",
                         "in": "path",
                         "required": true
                     },
@@ -332,13 +372,16 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.DialogUpdate"
+                            "$ref": "#/definitions/v1.ChannelUpdate"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Channel"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -359,10 +402,8 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/groups": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "JWTAuth": []
@@ -375,14 +416,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "channels"
+                ],
+                "summary": "Delete a specified channel",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Channel identity",
+                        "name": "channel_id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "List all groups",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/v1.GroupList"
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
                         }
                     },
                     "500": {
@@ -392,13 +451,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/channels/{channel_id}/admins/{user_id}": {
+            "put": {
                 "security": [
                     {
                         "JWTAuth": []
                     }
                 ],
+                "description": "Only admins can grant admin rights.",
                 "consumes": [
                     "application/json"
                 ],
@@ -406,26 +468,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "channel-subscribers"
                 ],
-                "summary": "Create a group",
+                "summary": "Grant a specified subscriber rights to post to a channel",
                 "parameters": [
                     {
-                        "description": "Body to create",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/v1.GroupCreate"
-                        }
+                        "type": "integer",
+                        "description": "Channel identity",
+                        "name": "channel_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "User identity",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/v1.Group"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -433,6 +497,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/httputil.Error"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -440,15 +516,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/groups/{group_id}": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "JWTAuth": []
                     }
                 ],
+                "description": "Only admins can revoke admin rights, the last admin can't be revoked.",
                 "consumes": [
                     "application/json"
                 ],
@@ -456,24 +531,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "channel-subscribers"
                 ],
-                "summary": "Get a specified group",
+                "summary": "Revoke rights of a specified subscriber to post to a channel",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Group identity",
-                        "name": "group_id",
+                        "description": "Channel identity",
+                        "name": "channel_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "User identity",
+                        "name": "user_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/v1.Group"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -481,6 +560,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/httputil.Error"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -494,13 +579,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/channels/{channel_id}/subscription": {
+            "post": {
                 "security": [
                     {
                         "JWTAuth": []
                     }
                 ],
+                "description": "Subscribers can only read the channel.",
                 "consumes": [
                     "application/json"
                 ],
@@ -508,32 +596,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "channel-subscribers"
                 ],
-                "summary": "Update a specified group",
+                "summary": "Subscribe the current user to a channel",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Group identity",
-                        "name": "group_id",
+                        "description": "Channel identity",
+                        "name": "channel_id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Body to update",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/v1.GroupUpdate"
-                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/v1.Group"
+                            "$ref": "#/definitions/v1.ChannelSubscriber"
                         }
                     },
                     "400": {
@@ -562,6 +641,7 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
+                "description": "The last admin can't unsubscribe from the channel.",
                 "consumes": [
                     "application/json"
                 ],
@@ -569,14 +649,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "channel-subscribers"
                 ],
-                "summary": "Delete a specified group",
+                "summary": "Unsubscribe the current user from a channel",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Group identity",
-                        "name": "group_id",
+                        "description": "Channel identity",
+                        "name": "channel_id",
                         "in": "path",
                         "required": true
                     }
@@ -606,7 +686,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/groups/{group_id}/participants": {
+        "/chat-folders": {
             "get": {
                 "security": [
                     {
@@ -620,35 +700,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "group-participants"
-                ],
-                "summary": "List all participants for a specified group",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Group identity",
-                        "name": "group_id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "chat-folders"
                 ],
+                "summary": "List chat folders of the current user",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.GroupParticipantList"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/httputil.Error"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/httputil.Error"
+                            "$ref": "#/definitions/v1.ChatFolderList"
                         }
                     },
                     "500": {
@@ -665,6 +724,7 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
+                "description": "A chat is in the folder if it's included explicitly or it matches the rules of the folder\nand it isn't excluded explicitly. Chats which the user isn't in are skipped.",
                 "consumes": [
                     "application/json"
                 ],
@@ -672,30 +732,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "group-participants"
+                    "chat-folders"
                 ],
-                "summary": "Invite a specified participant in a group",
+                "summary": "Create a chat folder",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Group identity",
-                        "name": "group_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "User identity to invite",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
+                        "description": "Body to create",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ChatFolderCreate"
+                        }
                     }
                 ],
                 "responses": {
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/v1.GroupParticipant"
+                            "$ref": "#/definitions/v1.ChatFolder"
                         }
                     },
                     "400": {
@@ -704,18 +759,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/httputil.Error"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/httputil.Error"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/httputil.Error"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -725,7 +768,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/groups/{group_id}/participants/{user_id}": {
+        "/chat-folders/{folder_id}": {
             "get": {
                 "security": [
                     {
@@ -739,21 +782,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "group-participants"
+                    "chat-folders"
                 ],
-                "summary": "Get a specified participant in a group",
+                "summary": "Get a specified chat folder",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Group identity",
-                        "name": "group_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "User identity",
-                        "name": "user_id",
+                        "description": "Chat folder identity",
+                        "name": "folder_id",
                         "in": "path",
                         "required": true
                     }
@@ -762,7 +798,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.GroupParticipant"
+                            "$ref": "#/definitions/v1.ChatFolder"
                         }
                     },
                     "400": {
@@ -785,13 +821,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "patch": {
+            "put": {
                 "security": [
                     {
                         "JWTAuth": []
                     }
                 ],
-                "description": "It can be used to join/kick/leave participant from the group.",
+                "description": "Explicitly included and excluded chats of the folder are replaced with the given ones.",
                 "consumes": [
                     "application/json"
                 ],
@@ -799,21 +835,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "group-participants"
+                    "chat-folders"
                 ],
-                "summary": "Update a specified participant in a group",
+                "summary": "Update a specified chat folder",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Group identity",
-                        "name": "group_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "User identity",
-                        "name": "user_id",
+                        "description": "Chat folder identity",
+                        "name": "folder_id",
                         "in": "path",
                         "required": true
                     },
@@ -823,13 +852,16 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.GroupParticipantUpdate"
+                            "$ref": "#/definitions/v1.ChatFolderUpdate"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ChatFolder"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -837,8 +869,51 @@ const docTemplate = `{
                             "$ref": "#/definitions/httputil.Error"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "chat-folders"
+                ],
+                "summary": "Delete a specified chat folder, chats of the folder remain",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Chat folder identity",
+                        "name": "folder_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/httputil.Error"
                         }
@@ -858,13 +933,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/messages": {
+        "/chats": {
             "get": {
                 "security": [
                     {
                         "JWTAuth": []
                     }
                 ],
+                "description": "Pinned chats go first, the others are sorted by the time of their last message.\nThe next page is requested by the cursor of the previous one.\nArchived chats are listed only on request, chats of a folder are listed regardless of the archive.",
                 "consumes": [
                     "application/json"
                 ],
@@ -872,28 +948,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "messages"
+                    "chats"
                 ],
-                "summary": "List messages for a specified chat",
+                "summary": "List dialogs and groups sorted by last activity",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Chat id for dialog or group",
-                        "name": "chat_id",
-                        "in": "query",
-                        "required": true
+                        "type": "string",
+                        "description": "Cursor of the next page returned with the previous one",
+                        "name": "cursor",
+                        "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Chat type (dialog or group)",
-                        "name": "chat_type",
-                        "in": "query",
-                        "required": true
+                        "type": "boolean",
+                        "description": "List archived chats instead of the others",
+                        "name": "archived",
+                        "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "Message id that excludes already-retrieved messages",
-                        "name": "id_after",
+                        "description": "List chats of the folder",
+                        "name": "folder_id",
                         "in": "query"
                     },
                     {
@@ -901,20 +975,13 @@ const docTemplate = `{
                         "description": "Number of items to list per page (default: 20, max: 100)",
                         "name": "limit",
                         "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Sort order (asc or desc)",
-                        "name": "sort",
-                        "in": "query",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.MessageList"
+                            "$ref": "#/definitions/v1.ChatList"
                         }
                     },
                     "400": {
@@ -936,8 +1003,10 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/chats/{chat_type}/{chat_id}/settings": {
+            "get": {
                 "security": [
                     {
                         "JWTAuth": []
@@ -950,39 +1019,99 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "messages"
+                    "chats"
                 ],
-                "summary": "Send message to the specified chat",
+                "summary": "Get personal settings of a specified dialog or group",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Chat type (dialog or group)",
+                        "name": "chat_type",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "type": "integer",
-                        "description": "Chat id for dialog or group",
+                        "description": "Chat identity",
                         "name": "chat_id",
-                        "in": "query",
+                        "in": "path",
                         "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ChatSettings"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "The chat pinned later is listed before the chats pinned earlier.\nOther devices of the user are notified about the change.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "chats"
+                ],
+                "summary": "Update personal settings of a specified dialog or group",
+                "parameters": [
                     {
                         "type": "string",
                         "description": "Chat type (dialog or group)",
                         "name": "chat_type",
-                        "in": "query",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Body to create",
+                        "type": "integer",
+                        "description": "Chat identity",
+                        "name": "chat_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Body to update",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.MessageCreate"
+                            "$ref": "#/definitions/v1.ChatSettingsUpdate"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.Message"
+                            "$ref": "#/definitions/v1.ChatSettings"
                         }
                     },
                     "400": {
@@ -1006,7 +1135,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/users": {
+        "/contacts": {
             "get": {
                 "security": [
                     {
@@ -1020,14 +1149,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "contacts"
                 ],
-                "summary": "List all existing users",
+                "summary": "List contacts of the current user",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.UserList"
+                            "$ref": "#/definitions/v1.ContactList"
                         }
                     },
                     "500": {
@@ -1039,6 +1168,11 @@ const docTemplate = `{
                 }
             },
             "post": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
                 "consumes": [
                     "application/json"
                 ],
@@ -1046,9 +1180,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "contacts"
                 ],
-                "summary": "Create a new user",
+                "summary": "Add a specified user to contacts of the current user",
                 "parameters": [
                     {
                         "description": "Body to create",
@@ -1056,7 +1190,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.UserCreate"
+                            "$ref": "#/definitions/v1.ContactCreate"
                         }
                     }
                 ],
@@ -1064,7 +1198,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/v1.User"
+                            "$ref": "#/definitions/v1.Contact"
                         }
                     },
                     "400": {
@@ -1082,13 +1216,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/me": {
-            "put": {
+        "/contacts/import": {
+            "post": {
                 "security": [
                     {
                         "JWTAuth": []
                     }
                 ],
+                "description": "Users who can't be added don't fail the request, the reason is returned for every username and email.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1096,17 +1231,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "contacts"
                 ],
-                "summary": "Update information about the current authenticated user",
+                "summary": "Add users found by usernames and emails to contacts of the current user",
                 "parameters": [
                     {
-                        "description": "Body to update",
+                        "description": "Usernames and emails to import",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.UserUpdate"
+                            "$ref": "#/definitions/v1.ContactImport"
                         }
                     }
                 ],
@@ -1114,7 +1249,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.User"
+                            "$ref": "#/definitions/v1.ContactImportResultList"
                         }
                     },
                     "400": {
@@ -1123,12 +1258,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/httputil.Error"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/httputil.Error"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1136,8 +1265,10 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/contacts/{user_id}": {
+            "put": {
                 "security": [
                     {
                         "JWTAuth": []
@@ -1150,12 +1281,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "contacts"
+                ],
+                "summary": "Update a display name of a specified contact",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Contact user identity",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Body to update",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ContactUpdate"
+                        }
+                    }
                 ],
-                "summary": "Delete the current authenticated user",
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Contact"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
                     },
                     "404": {
                         "description": "Not Found",
@@ -1170,10 +1328,8 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/users/me/password": {
-            "patch": {
+            },
+            "delete": {
                 "security": [
                     {
                         "JWTAuth": []
@@ -1186,18 +1342,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "contacts"
                 ],
-                "summary": "Update the current authenticated user's password",
+                "summary": "Remove a specified user from contacts of the current user",
                 "parameters": [
                     {
-                        "description": "Body to update",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/v1.UserUpdatePassword"
-                        }
+                        "type": "integer",
+                        "description": "Contact user identity",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1225,13 +1379,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{user_id}": {
+        "/dialog-requests": {
             "get": {
                 "security": [
                     {
                         "JWTAuth": []
                     }
                 ],
+                "description": "Dialogs started by users who aren't in the contacts of the current user.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1239,14 +1394,136 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "dialogs"
                 ],
-                "summary": "Get a specified user",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "User identity",
-                        "name": "user_id",
+                "summary": "List message requests sent to the current user",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.DialogList"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/dialogs": {
+            "get": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Message requests which the current user hasn't accepted aren't listed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dialogs"
+                ],
+                "summary": "List all dialogs",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.DialogList"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "If the current user isn't in the contacts of the partner, the dialog becomes a message request.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dialogs"
+                ],
+                "summary": "Create a dialog with a specified partner",
+                "parameters": [
+                    {
+                        "description": "Body to create",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.DialogCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Dialog"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/dialogs/{dialog_id}": {
+            "get": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dialogs"
+                ],
+                "summary": "Get a specified dialog",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dialog identity",
+                        "name": "dialog_id",
                         "in": "path",
                         "required": true
                     }
@@ -1255,7 +1532,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.User"
+                            "$ref": "#/definitions/v1.Dialog"
                         }
                     },
                     "400": {
@@ -1277,77 +1554,3218 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "entity.GroupParticipantStatus": {
-            "type": "string",
-            "enum": [
-                "joined",
-                "kicked",
-                "left"
-            ],
-            "x-enum-varnames": [
-                "JoinedStatus",
-                "KickedStatus",
-                "LeftStatus"
-            ]
-        },
-        "http.Credentials": {
-            "type": "object",
-            "required": [
-                "password",
-                "username"
-            ],
-            "properties": {
-                "password": {
-                    "type": "string",
-                    "maxLength": 27,
-                    "minLength": 8
-                },
-                "username": {
-                    "type": "string",
-                    "maxLength": 50
+            },
+            "delete": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "By default the dialog is deleted on the side of the current user only and is shown again once a new message arrives.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dialogs"
+                ],
+                "summary": "Delete a specified dialog",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dialog identity",
+                        "name": "dialog_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Delete the dialog with its messages for both sides",
+                        "name": "for_both",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
                 }
-            }
-        },
-        "http.RefreshToken": {
-            "type": "object",
-            "required": [
-                "refresh_token"
-            ],
-            "properties": {
-                "refresh_token": {
-                    "type": "string"
+            },
+            "patch": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dialogs"
+                ],
+                "summary": "Update a specified dialog",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dialog identity",
+                        "name": "dialog_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Body to update",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.DialogUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/dialogs/{dialog_id}/accept": {
+            "post": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dialogs"
+                ],
+                "summary": "Accept a message request in a specified dialog",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dialog identity",
+                        "name": "dialog_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/dialogs/{dialog_id}/clear": {
+            "post": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Messages are cleared on the side of the current user only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dialogs"
+                ],
+                "summary": "Clear the history of a specified dialog",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dialog identity",
+                        "name": "dialog_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/dialogs/{dialog_id}/decline": {
+            "post": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dialogs"
+                ],
+                "summary": "Decline a message request in a specified dialog",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dialog identity",
+                        "name": "dialog_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/dialogs/{dialog_id}/report": {
+            "post": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "The request is declined and its sender is blocked.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dialogs"
+                ],
+                "summary": "Report a message request in a specified dialog as spam",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dialog identity",
+                        "name": "dialog_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups": {
+            "get": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "List all groups",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.GroupList"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Create a group",
+                "parameters": [
+                    {
+                        "description": "Body to create",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.GroupCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Group"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/join/{code}": {
+            "post": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "The code is either a token of the group invite link or the public group uname.\nIf the invite link requires approval, a pending join request is created instead.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group-participants"
+                ],
+                "summary": "Join the current user to a group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Invite link token or group uname",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.GroupParticipant"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/v1.GroupParticipant"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{group_id}": {
+            "get": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Get a specified group",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Group identity",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Group"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Update a specified group",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Group identity",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Body to update",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.GroupUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Group"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "The owner can restore the deleted group until the retention period is over.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Delete a specified group",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Group identity",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{group_id}/audit-log": {
+            "get": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Only admins and the owner of the group can view the audit log.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group-audit-log"
+                ],
+                "summary": "List administrative actions performed in a specified group",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Group identity",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Action to filter by",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "User who performed the action",
+                        "name": "actor_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "User on whom the action was performed",
+                        "name": "target_user_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Entry id that excludes already-retrieved entries",
+                        "name": "id_after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items to list per page (default: 20, max: 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort order (asc or desc, default: desc)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.GroupAuditLog"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{group_id}/bulk-invite": {
+            "post": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Users who can't be invited don't fail the request, the reason is returned for every user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group-participants"
+                ],
+                "summary": "Invite several users in a group at once",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Group identity",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Users to invite",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.GroupParticipantBulkInvite"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.GroupInviteResultList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{group_id}/invite-links": {
+            "get": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group-invite-links"
+                ],
+                "summary": "List all invite links of a specified group",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Group identity",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.GroupInviteLinkList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "The link is unlimited unless max uses or expiration time are specified.\nJoining by the link requiring approval creates a pending join request.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group-invite-links"
+                ],
+                "summary": "Create an invite link of a specified group",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Group identity",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Body to create",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.GroupInviteLinkCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.GroupInviteLink"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{group_id}/invite-links/{token}": {
+            "delete": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group-invite-links"
+                ],
+                "summary": "Revoke an invite link of a specified group",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Group identity",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Invite link token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{group_id}/join-requests": {
+            "get": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group-participants"
+                ],
+                "summary": "List pending join requests of a specified group",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Group identity",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.GroupParticipantList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{group_id}/join-requests/{user_id}/approve": {
+            "post": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group-participants"
+                ],
+                "summary": "Approve a join request of a specified user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Group identity",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "User identity",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{group_id}/join-requests/{user_id}/reject": {
+            "post": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group-participants"
+                ],
+                "summary": "Reject a join request of a specified user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Group identity",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "User identity",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{group_id}/participants": {
+            "get": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group-participants"
+                ],
+                "summary": "List all participants for a specified group",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Group identity",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.GroupParticipantList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group-participants"
+                ],
+                "summary": "Invite a specified participant in a group",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Group identity",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "User identity to invite",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.GroupParticipant"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{group_id}/participants/{user_id}": {
+            "get": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group-participants"
+                ],
+                "summary": "Get a specified participant in a group",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Group identity",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "User identity",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.GroupParticipant"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "It can be used to join/kick/leave participant from the group.\nMuted participants can read the group but can't post, banned ones can't join the group.\nMute and ban are permanent unless restricted_until is specified.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group-participants"
+                ],
+                "summary": "Update a specified participant in a group",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Group identity",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "User identity",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Body to update",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.GroupParticipantUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{group_id}/participants/{user_id}/demote": {
+            "post": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Participant can demote only participants with roles lower than own one.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group-participants"
+                ],
+                "summary": "Demote a specified participant in a group",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Group identity",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "User identity",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Role to grant",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.GroupParticipantRoleUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{group_id}/participants/{user_id}/promote": {
+            "post": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Participant can grant only roles lower than own one.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group-participants"
+                ],
+                "summary": "Promote a specified participant in a group",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Group identity",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "User identity",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Role to grant",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.GroupParticipantRoleUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{group_id}/participants/{user_id}/transfer-ownership": {
+            "post": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Only the owner can transfer ownership, the former owner becomes an admin.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group-participants"
+                ],
+                "summary": "Transfer ownership of a group to a specified participant",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Group identity",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "User identity of the new owner",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{group_id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Only the owner can restore the group until the retention period is over.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Restore a specified deleted group",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Group identity",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Group"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{group_id}/settings": {
+            "get": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Get settings of a specified group",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Group identity",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.GroupSettings"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Only participants who can edit the group info can update its settings.\nAdmins aren't restricted by the settings.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Update settings of a specified group",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Group identity",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Body to update",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.GroupSettingsUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.GroupSettings"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/messages": {
+            "get": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "messages"
+                ],
+                "summary": "List messages for a specified chat",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Chat id for dialog, group or channel",
+                        "name": "chat_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Chat type (dialog, group or channel)",
+                        "name": "chat_type",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Message id that excludes already-retrieved messages",
+                        "name": "id_after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items to list per page (default: 20, max: 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort order (asc or desc)",
+                        "name": "sort",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.MessageList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "messages"
+                ],
+                "summary": "Send message to the specified chat",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Chat id for dialog, group or channel",
+                        "name": "chat_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Chat type (dialog, group or channel)",
+                        "name": "chat_type",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "Body to create",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.MessageCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Message"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/messages/forward": {
+            "post": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "messages"
+                ],
+                "summary": "Forward message of another chat to the specified chat",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Chat id for dialog, group or channel",
+                        "name": "chat_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Chat type (dialog, group or channel)",
+                        "name": "chat_type",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "Message to forward",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.MessageForward"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Message"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/saved-messages": {
+            "get": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "The dialog of the current user with themselves is created on the first request.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dialogs"
+                ],
+                "summary": "Get the saved messages dialog of the current user",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Dialog"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Users are sorted by their usernames, the next page is requested by the cursor of the previous one.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "List existing users",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search users by username prefix or by first and last name",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor of the next page returned with the previous one",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items to list per page (default: 20, max: 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.UserList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "A verification token is sent to the email of the user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Create a new user",
+                "parameters": [
+                    {
+                        "description": "Body to create",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.UserCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me": {
+            "put": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Update information about the current authenticated user",
+                "parameters": [
+                    {
+                        "description": "Body to update",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.UserUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Delete the current authenticated user",
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/blocks/{user_id}": {
+            "post": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "The blocked user can't write to the current user, invite them to groups\nor create a dialog with them. The existing dialog is blocked as well.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user-blocks"
+                ],
+                "summary": "Block a specified user for the current user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User identity",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.UserBlock"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "The existing dialog with the user is unblocked as well.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user-blocks"
+                ],
+                "summary": "Unblock a specified user for the current user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User identity",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/password": {
+            "patch": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Update the current authenticated user's password",
+                "parameters": [
+                    {
+                        "description": "Body to update",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.UserUpdatePassword"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/verify-email/resend": {
+            "post": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Previous tokens of the user become invalid.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Send a new verification token to the email of the current authenticated user",
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/verify-email": {
+            "post": {
+                "description": "The token is sent to the email on signup and on the email change.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Verify the email of a user",
+                "parameters": [
+                    {
+                        "description": "Body to verify",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.UserVerifyEmail"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{user_id}": {
+            "get": {
+                "security": [
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "If the user is in contacts of the current user, the contact info is returned as well.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get a specified user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User identity",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.Error"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "entity.ChatType": {
+            "type": "string",
+            "enum": [
+                "dialog",
+                "group",
+                "channel"
+            ],
+            "x-enum-varnames": [
+                "DialogChatType",
+                "GroupChatType",
+                "ChannelChatType"
+            ]
+        },
+        "entity.ContactImportStatus": {
+            "type": "string",
+            "enum": [
+                "added",
+                "already_exists",
+                "not_found",
+                "self"
+            ],
+            "x-enum-varnames": [
+                "AddedContactImportStatus",
+                "AlreadyExistsContactImportStatus",
+                "NotFoundContactImportStatus",
+                "SelfContactImportStatus"
+            ]
+        },
+        "entity.ContentType": {
+            "type": "string",
+            "enum": [
+                "text",
+                "image"
+            ],
+            "x-enum-varnames": [
+                "TextContentType",
+                "ImageContentType"
+            ]
+        },
+        "entity.GroupAuditAction": {
+            "type": "string",
+            "enum": [
+                "group_updated",
+                "group_deleted",
+                "group_restored",
+                "settings_updated",
+                "participant_invited",
+                "participant_status_changed",
+                "participant_role_changed"
+            ],
+            "x-enum-varnames": [
+                "GroupUpdatedAuditAction",
+                "GroupDeletedAuditAction",
+                "GroupRestoredAuditAction",
+                "GroupSettingsUpdatedAuditAction",
+                "ParticipantInvitedAuditAction",
+                "ParticipantStatusChangedAuditAction",
+                "ParticipantRoleChangedAuditAction"
+            ]
+        },
+        "entity.GroupInviteStatus": {
+            "type": "string",
+            "enum": [
+                "added",
+                "already_exists",
+                "non_existent",
+                "limit_reached",
+                "blocked"
+            ],
+            "x-enum-varnames": [
+                "AddedInviteStatus",
+                "AlreadyExistsInviteStatus",
+                "NonExistentUserInviteStatus",
+                "LimitReachedInviteStatus",
+                "BlockedInviteStatus"
+            ]
+        },
+        "entity.GroupParticipantStatus": {
+            "type": "string",
+            "enum": [
+                "joined",
+                "kicked",
+                "left",
+                "pending",
+                "muted",
+                "banned"
+            ],
+            "x-enum-varnames": [
+                "JoinedStatus",
+                "KickedStatus",
+                "LeftStatus",
+                "PendingStatus",
+                "MutedStatus",
+                "BannedStatus"
+            ]
+        },
+        "entity.GroupRole": {
+            "type": "string",
+            "enum": [
+                "owner",
+                "admin",
+                "moderator",
+                "member"
+            ],
+            "x-enum-varnames": [
+                "OwnerRole",
+                "AdminRole",
+                "ModeratorRole",
+                "MemberRole"
+            ]
+        },
+        "entity.ServiceActionType": {
+            "type": "string",
+            "enum": [
+                "participant_invited",
+                "participant_joined",
+                "participant_returned",
+                "participant_left",
+                "participant_kicked",
+                "participant_muted",
+                "participant_unmuted",
+                "participant_banned",
+                "participant_unbanned",
+                "join_requested",
+                "join_request_approved",
+                "ownership_transferred",
+                "ownership_passed",
+                "group_renamed",
+                "group_setting_changed",
+                "dialog_blocked",
+                "dialog_unblocked",
+                "chat_settings_changed",
+                "chat_folders_changed"
+            ],
+            "x-enum-varnames": [
+                "ParticipantInvitedServiceAction",
+                "ParticipantJoinedServiceAction",
+                "ParticipantReturnedServiceAction",
+                "ParticipantLeftServiceAction",
+                "ParticipantKickedServiceAction",
+                "ParticipantMutedServiceAction",
+                "ParticipantUnmutedServiceAction",
+                "ParticipantBannedServiceAction",
+                "ParticipantUnbannedServiceAction",
+                "JoinRequestedServiceAction",
+                "JoinRequestApprovedServiceAction",
+                "OwnershipTransferredServiceAction",
+                "OwnershipPassedServiceAction",
+                "GroupRenamedServiceAction",
+                "GroupSettingChangedServiceAction",
+                "DialogBlockedServiceAction",
+                "DialogUnblockedServiceAction",
+                "ChatSettingsChangedServiceAction",
+                "ChatFoldersChangedServiceAction"
+            ]
+        },
+        "http.Credentials": {
+            "type": "object",
+            "required": [
+                "password",
+                "username"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string",
+                    "maxLength": 27,
+                    "minLength": 8
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 50
+                }
+            }
+        },
+        "http.RefreshToken": {
+            "type": "object",
+            "required": [
+                "refresh_token"
+            ],
+            "properties": {
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "http.TokenPair": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "httputil.Error": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "data": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.Channel": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "uname": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ChannelCreate": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 10000
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "uname": {
+                    "type": "string",
+                    "maxLength": 32,
+                    "minLength": 5
+                }
+            }
+        },
+        "v1.ChannelList": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.Channel"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.ChannelSubscriber": {
+            "type": "object",
+            "properties": {
+                "is_admin": {
+                    "type": "boolean"
+                },
+                "subscribed_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.ChannelUpdate": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 10000
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "uname": {
+                    "type": "string",
+                    "maxLength": 32,
+                    "minLength": 5
+                }
+            }
+        },
+        "v1.ChatFolder": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "exclude_archived": {
+                    "type": "boolean"
+                },
+                "exclude_muted": {
+                    "type": "boolean"
+                },
+                "excluded_chats": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.FolderChat"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "include_dialogs": {
+                    "type": "boolean"
+                },
+                "include_groups": {
+                    "type": "boolean"
+                },
+                "included_chats": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.FolderChat"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ChatFolderCreate": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "exclude_archived": {
+                    "type": "boolean"
+                },
+                "exclude_muted": {
+                    "type": "boolean"
+                },
+                "excluded_chats": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "items": {
+                        "$ref": "#/definitions/v1.FolderChat"
+                    }
+                },
+                "include_dialogs": {
+                    "type": "boolean"
+                },
+                "include_groups": {
+                    "type": "boolean"
+                },
+                "included_chats": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "items": {
+                        "$ref": "#/definitions/v1.FolderChat"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 64
+                }
+            }
+        },
+        "v1.ChatFolderList": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ChatFolder"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.ChatFolderUpdate": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "exclude_archived": {
+                    "type": "boolean"
+                },
+                "exclude_muted": {
+                    "type": "boolean"
+                },
+                "excluded_chats": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "items": {
+                        "$ref": "#/definitions/v1.FolderChat"
+                    }
+                },
+                "include_dialogs": {
+                    "type": "boolean"
+                },
+                "include_groups": {
+                    "type": "boolean"
+                },
+                "included_chats": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "items": {
+                        "$ref": "#/definitions/v1.FolderChat"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 64
+                }
+            }
+        },
+        "v1.ChatList": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ChatListItem"
+                    }
+                },
+                "next_cursor": {
+                    "description": "NextCursor is set if there may be more chats after the listed ones.",
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.ChatListItem": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_archived": {
+                    "type": "boolean"
+                },
+                "is_muted": {
+                    "type": "boolean"
+                },
+                "is_pinned": {
+                    "type": "boolean"
+                },
+                "last_activity_at": {
+                    "type": "string"
+                },
+                "last_message": {
+                    "$ref": "#/definitions/v1.Message"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "partner_user_id": {
+                    "type": "integer"
+                },
+                "type": {
+                    "$ref": "#/definitions/entity.ChatType"
+                },
+                "unread_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.ChatSettings": {
+            "type": "object",
+            "properties": {
+                "is_archived": {
+                    "type": "boolean"
+                },
+                "is_muted": {
+                    "type": "boolean"
+                },
+                "is_pinned": {
+                    "type": "boolean"
+                },
+                "muted_until": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ChatSettingsUpdate": {
+            "type": "object",
+            "properties": {
+                "is_archived": {
+                    "type": "boolean"
+                },
+                "is_pinned": {
+                    "type": "boolean"
+                },
+                "muted_until": {
+                    "description": "Notifications of the chat are muted until the time, null unmutes them",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.Contact": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "display_name": {
+                    "type": "string"
+                },
+                "is_mutual": {
+                    "type": "boolean"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.ContactCreate": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "display_name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "user_id": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "v1.ContactImport": {
+            "type": "object",
+            "required": [
+                "emails",
+                "usernames"
+            ],
+            "properties": {
+                "emails": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "usernames": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "v1.ContactImportResult": {
+            "type": "object",
+            "properties": {
+                "query": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/entity.ContactImportStatus"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.ContactImportResultList": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ContactImportResult"
+                    }
+                },
+                "total": {
+                    "type": "integer"
                 }
             }
         },
-        "http.TokenPair": {
+        "v1.ContactList": {
             "type": "object",
             "properties": {
-                "access_token": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.Contact"
+                    }
                 },
-                "refresh_token": {
-                    "type": "string"
+                "total": {
+                    "type": "integer"
                 }
             }
         },
-        "httputil.Error": {
+        "v1.ContactUpdate": {
             "type": "object",
             "properties": {
-                "code": {
-                    "type": "string"
-                },
-                "data": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    }
-                },
-                "message": {
-                    "type": "string"
+                "display_name": {
+                    "type": "string",
+                    "maxLength": 100
                 }
             }
         },
@@ -1363,6 +4781,9 @@ const docTemplate = `{
                 "is_blocked": {
                     "type": "boolean"
                 },
+                "is_request": {
+                    "type": "boolean"
+                },
                 "partner": {
                     "$ref": "#/definitions/v1.DialogPartner"
                 }
@@ -1422,6 +4843,30 @@ const docTemplate = `{
                 }
             }
         },
+        "v1.FolderChat": {
+            "type": "object",
+            "required": [
+                "id",
+                "type"
+            ],
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "type": {
+                    "enum": [
+                        "dialog",
+                        "group"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ChatType"
+                        }
+                    ]
+                }
+            }
+        },
         "v1.Group": {
             "type": "object",
             "properties": {
@@ -1436,6 +4881,51 @@ const docTemplate = `{
                 },
                 "name": {
                     "type": "string"
+                },
+                "uname": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.GroupAuditEntry": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "$ref": "#/definitions/entity.GroupAuditAction"
+                },
+                "actor_id": {
+                    "type": "integer"
+                },
+                "after": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "before": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "target_user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.GroupAuditLog": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.GroupAuditEntry"
+                    }
+                },
+                "total": {
+                    "type": "integer"
                 }
             }
         },
@@ -1452,6 +4942,94 @@ const docTemplate = `{
                 "name": {
                     "type": "string",
                     "maxLength": 255
+                },
+                "uname": {
+                    "type": "string",
+                    "maxLength": 32,
+                    "minLength": 5
+                }
+            }
+        },
+        "v1.GroupInviteLink": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "creator_id": {
+                    "type": "integer"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "is_revoked": {
+                    "type": "boolean"
+                },
+                "max_uses": {
+                    "type": "integer"
+                },
+                "requires_approval": {
+                    "type": "boolean"
+                },
+                "token": {
+                    "type": "string"
+                },
+                "uses": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.GroupInviteLinkCreate": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "string"
+                },
+                "max_uses": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "requires_approval": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "v1.GroupInviteLinkList": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.GroupInviteLink"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.GroupInviteResult": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "$ref": "#/definitions/entity.GroupInviteStatus"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.GroupInviteResultList": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.GroupInviteResult"
+                    }
+                },
+                "total": {
+                    "type": "integer"
                 }
             }
         },
@@ -1472,8 +5050,11 @@ const docTemplate = `{
         "v1.GroupParticipant": {
             "type": "object",
             "properties": {
-                "is_admin": {
-                    "type": "boolean"
+                "restricted_until": {
+                    "type": "string"
+                },
+                "role": {
+                    "$ref": "#/definitions/entity.GroupRole"
                 },
                 "status": {
                     "$ref": "#/definitions/entity.GroupParticipantStatus"
@@ -1483,6 +5064,22 @@ const docTemplate = `{
                 }
             }
         },
+        "v1.GroupParticipantBulkInvite": {
+            "type": "object",
+            "required": [
+                "user_ids"
+            ],
+            "properties": {
+                "user_ids": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
         "v1.GroupParticipantList": {
             "type": "object",
             "properties": {
@@ -1497,19 +5094,72 @@ const docTemplate = `{
                 }
             }
         },
+        "v1.GroupParticipantRoleUpdate": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "admin",
+                        "moderator",
+                        "member"
+                    ]
+                }
+            }
+        },
         "v1.GroupParticipantUpdate": {
             "type": "object",
             "properties": {
+                "restricted_until": {
+                    "type": "string"
+                },
                 "status": {
                     "type": "string",
                     "enum": [
                         "joined",
                         "left",
-                        "kicked"
+                        "kicked",
+                        "muted",
+                        "banned"
                     ]
                 }
             }
         },
+        "v1.GroupSettings": {
+            "type": "object",
+            "properties": {
+                "members_can_invite": {
+                    "type": "boolean"
+                },
+                "only_admins_can_post": {
+                    "type": "boolean"
+                },
+                "slow_mode_interval": {
+                    "description": "Minimal interval in seconds between messages of a participant, 0 disables slow mode",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.GroupSettingsUpdate": {
+            "type": "object",
+            "properties": {
+                "members_can_invite": {
+                    "type": "boolean"
+                },
+                "only_admins_can_post": {
+                    "type": "boolean"
+                },
+                "slow_mode_interval": {
+                    "description": "Minimal interval in seconds between messages of a participant, 0 disables slow mode",
+                    "type": "integer",
+                    "maximum": 86400,
+                    "minimum": 0
+                }
+            }
+        },
         "v1.GroupUpdate": {
             "type": "object",
             "required": [
@@ -1523,6 +5173,11 @@ const docTemplate = `{
                 "name": {
                     "type": "string",
                     "maxLength": 255
+                },
+                "uname": {
+                    "type": "string",
+                    "maxLength": 32,
+                    "minLength": 5
                 }
             }
         },
@@ -1533,11 +5188,14 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "content_type": {
-                    "type": "string"
+                    "$ref": "#/definitions/entity.ContentType"
                 },
                 "delivered_at": {
                     "type": "string"
                 },
+                "forwarded_from_id": {
+                    "type": "integer"
+                },
                 "id": {
                     "type": "integer"
                 },
@@ -1549,6 +5207,9 @@ const docTemplate = `{
                 },
                 "sent_at": {
                     "type": "string"
+                },
+                "service_action": {
+                    "$ref": "#/definitions/v1.ServiceAction"
                 }
             }
         },
@@ -1564,14 +5225,42 @@ const docTemplate = `{
                     "maxLength": 2000
                 },
                 "content_type": {
-                    "type": "string",
                     "enum": [
                         "text",
                         "image"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ContentType"
+                        }
                     ]
                 }
             }
         },
+        "v1.MessageForward": {
+            "type": "object",
+            "required": [
+                "from_chat_id",
+                "from_chat_type",
+                "message_id"
+            ],
+            "properties": {
+                "from_chat_id": {
+                    "type": "integer"
+                },
+                "from_chat_type": {
+                    "type": "string",
+                    "enum": [
+                        "dialog",
+                        "group",
+                        "channel"
+                    ]
+                },
+                "message_id": {
+                    "type": "integer"
+                }
+            }
+        },
         "v1.MessageList": {
             "type": "object",
             "properties": {
@@ -1586,6 +5275,20 @@ const docTemplate = `{
                 }
             }
         },
+        "v1.ServiceAction": {
+            "type": "object",
+            "properties": {
+                "params": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "type": {
+                    "$ref": "#/definitions/entity.ServiceActionType"
+                }
+            }
+        },
         "v1.User": {
             "type": "object",
             "properties": {
@@ -1595,6 +5298,9 @@ const docTemplate = `{
                 "birth_date": {
                     "type": "string"
                 },
+                "contact": {
+                    "$ref": "#/definitions/v1.UserContact"
+                },
                 "email": {
                     "type": "string"
                 },
@@ -1612,6 +5318,42 @@ const docTemplate = `{
                 }
             }
         },
+        "v1.UserBlock": {
+            "type": "object",
+            "properties": {
+                "blocked_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.UserBlockList": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.UserBlock"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.UserContact": {
+            "type": "object",
+            "properties": {
+                "display_name": {
+                    "type": "string"
+                },
+                "is_mutual": {
+                    "type": "boolean"
+                }
+            }
+        },
         "v1.UserCreate": {
             "type": "object",
             "required": [
@@ -1659,6 +5401,10 @@ const docTemplate = `{
                         "$ref": "#/definitions/v1.User"
                     }
                 },
+                "next_cursor": {
+                    "description": "NextCursor is set if there may be more users after the listed ones.",
+                    "type": "string"
+                },
                 "total": {
                     "type": "integer"
                 }
@@ -1714,6 +5460,18 @@ const docTemplate = `{
                     "minLength": 8
                 }
             }
+        },
+        "v1.UserVerifyEmail": {
+            "type": "object",
+            "required": [
+                "token"
+            ],
+            "properties": {
+                "token": {
+                    "type": "string",
+                    "maxLength": 64
+                }
+            }
         }
     },
     "securityDefinitions": {
@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP INDEX IF EXISTS sessions__user_id__idx;
+
+DROP TABLE IF EXISTS sessions;
+
+DROP INDEX IF EXISTS sysbus_outbox__created_at__idx;
+
+DROP TABLE IF EXISTS sysbus_outbox;
+
+COMMIT;
@@ -0,0 +1,27 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS sysbus_outbox
+(
+    id         BIGSERIAL PRIMARY KEY,
+    channel    VARCHAR(63)              NOT NULL,
+    payload    BYTEA                    NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS sysbus_outbox__created_at__idx
+    ON sysbus_outbox (created_at);
+
+CREATE TABLE IF NOT EXISTS sessions
+(
+    refresh_token VARCHAR(255) PRIMARY KEY,
+    user_id       VARCHAR(255)             NOT NULL,
+    fingerprint   VARCHAR(255)             NOT NULL,
+    ip            VARCHAR(45)              NOT NULL,
+    expires_at    TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at    TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS sessions__user_id__idx
+    ON sessions (user_id);
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+
+ALTER TABLE group_participants
+    ADD COLUMN IF NOT EXISTS is_admin BOOLEAN DEFAULT FALSE;
+
+UPDATE group_participants
+SET is_admin = TRUE
+WHERE role IN ('owner', 'admin');
+
+ALTER TABLE group_participants
+    DROP COLUMN IF EXISTS role;
+
+DROP TYPE IF EXISTS group_participant_role;
+
+COMMIT;
@@ -0,0 +1,27 @@
+BEGIN;
+
+DO
+$$
+    BEGIN
+        IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'group_participant_role') THEN
+            CREATE TYPE group_participant_role as ENUM (
+                'owner',
+                'admin',
+                'moderator',
+                'member');
+        END IF;
+    END
+$$;
+
+ALTER TABLE group_participants
+    ADD COLUMN IF NOT EXISTS role group_participant_role NOT NULL DEFAULT 'member';
+
+-- Before roles were introduced only the creator of a group was its admin.
+UPDATE group_participants
+SET role = 'owner'
+WHERE is_admin IS TRUE;
+
+ALTER TABLE group_participants
+    DROP COLUMN IF EXISTS is_admin;
+
+COMMIT;
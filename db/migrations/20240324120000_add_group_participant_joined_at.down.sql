@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE group_participants
+    DROP COLUMN IF EXISTS joined_at;
+
+COMMIT;
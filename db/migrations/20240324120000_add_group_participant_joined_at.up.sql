@@ -0,0 +1,25 @@
+BEGIN;
+
+ALTER TABLE group_participants
+    ADD COLUMN IF NOT EXISTS joined_at TIMESTAMPTZ NULL;
+
+-- The owner is the former admin who created the group, so they joined it along with its creation.
+-- Others are considered to have joined by their first message, or along with the group otherwise.
+UPDATE group_participants gp
+SET joined_at = CASE
+                    WHEN gp.role = 'owner' THEN c.created_at
+                    ELSE COALESCE((SELECT MIN(m.sent_at)
+                                   FROM messages m
+                                   WHERE m.chat_id = gp.chat_id
+                                     AND m.chat_type = 'group'
+                                     AND m.sender_id = gp.user_id), c.created_at)
+    END
+FROM chats c
+WHERE c.id = gp.chat_id
+  AND gp.joined_at IS NULL;
+
+ALTER TABLE group_participants
+    ALTER COLUMN joined_at SET DEFAULT now(),
+    ALTER COLUMN joined_at SET NOT NULL;
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS group_invite_links;
+
+COMMIT;
@@ -0,0 +1,20 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS group_invite_links
+(
+    token      VARCHAR(64) PRIMARY KEY,
+    chat_id    BIGINT                   NOT NULL
+        REFERENCES chats (id) ON DELETE CASCADE,
+    creator_id BIGINT                   NOT NULL
+        REFERENCES users (id),
+    max_uses   INTEGER                  NULL,
+    uses       INTEGER                  NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP WITH TIME ZONE NULL,
+    revoked_at TIMESTAMP WITH TIME ZONE NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS group_invite_links__chat_id__idx
+    ON group_invite_links (chat_id);
+
+COMMIT;
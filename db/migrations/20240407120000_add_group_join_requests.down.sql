@@ -0,0 +1,24 @@
+BEGIN;
+
+DELETE
+FROM group_participants
+WHERE status = 'pending';
+
+ALTER TABLE group_invite_links
+    DROP COLUMN IF EXISTS requires_approval;
+
+ALTER TYPE group_participant_status RENAME TO group_participant_status_old;
+
+CREATE TYPE group_participant_status as ENUM (
+    'joined',
+    'left',
+    'kicked');
+
+ALTER TABLE group_participants
+    ALTER COLUMN status DROP DEFAULT,
+    ALTER COLUMN status TYPE group_participant_status USING status::text::group_participant_status,
+    ALTER COLUMN status SET DEFAULT 'joined';
+
+DROP TYPE group_participant_status_old;
+
+COMMIT;
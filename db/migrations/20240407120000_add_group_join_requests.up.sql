@@ -0,0 +1,6 @@
+-- Enum values can't be used in the transaction which added them,
+-- so the new status is only added here.
+ALTER TYPE group_participant_status ADD VALUE IF NOT EXISTS 'pending';
+
+ALTER TABLE group_invite_links
+    ADD COLUMN IF NOT EXISTS requires_approval BOOLEAN NOT NULL DEFAULT FALSE;
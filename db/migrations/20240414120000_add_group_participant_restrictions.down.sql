@@ -0,0 +1,31 @@
+BEGIN;
+
+DROP INDEX IF EXISTS group_participants__restricted_until__idx;
+
+ALTER TABLE group_participants
+    DROP COLUMN IF EXISTS restricted_until;
+
+UPDATE group_participants
+SET status = 'joined'
+WHERE status = 'muted';
+
+UPDATE group_participants
+SET status = 'kicked'
+WHERE status = 'banned';
+
+ALTER TYPE group_participant_status RENAME TO group_participant_status_old;
+
+CREATE TYPE group_participant_status as ENUM (
+    'joined',
+    'left',
+    'kicked',
+    'pending');
+
+ALTER TABLE group_participants
+    ALTER COLUMN status DROP DEFAULT,
+    ALTER COLUMN status TYPE group_participant_status USING status::text::group_participant_status,
+    ALTER COLUMN status SET DEFAULT 'joined';
+
+DROP TYPE group_participant_status_old;
+
+COMMIT;
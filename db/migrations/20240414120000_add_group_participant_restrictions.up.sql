@@ -0,0 +1,11 @@
+-- Enum values can't be used in the transaction which added them,
+-- so the new statuses are only added here.
+ALTER TYPE group_participant_status ADD VALUE IF NOT EXISTS 'muted';
+ALTER TYPE group_participant_status ADD VALUE IF NOT EXISTS 'banned';
+
+ALTER TABLE group_participants
+    ADD COLUMN IF NOT EXISTS restricted_until TIMESTAMP WITH TIME ZONE NULL;
+
+CREATE INDEX IF NOT EXISTS group_participants__restricted_until__idx
+    ON group_participants (restricted_until)
+    WHERE restricted_until IS NOT NULL;
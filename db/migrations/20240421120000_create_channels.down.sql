@@ -0,0 +1,23 @@
+BEGIN;
+
+DROP TABLE IF EXISTS channel_subscribers;
+
+DELETE
+FROM chats
+WHERE type = 'channel';
+
+ALTER TYPE chat_type RENAME TO chat_type_old;
+
+CREATE TYPE chat_type as ENUM (
+    'dialog',
+    'group');
+
+ALTER TABLE chats
+    ALTER COLUMN type TYPE chat_type USING type::text::chat_type;
+
+ALTER TABLE messages
+    ALTER COLUMN chat_type TYPE chat_type USING chat_type::text::chat_type;
+
+DROP TYPE chat_type_old;
+
+COMMIT;
@@ -0,0 +1,22 @@
+-- Enum values can't be used in the transaction which added them,
+-- so the new chat type is only added here.
+ALTER TYPE chat_type ADD VALUE IF NOT EXISTS 'channel';
+
+CREATE TABLE IF NOT EXISTS channel_subscribers
+(
+    chat_id       BIGINT                   NOT NULL
+        REFERENCES chats (id) ON DELETE CASCADE,
+    user_id       BIGINT                   NOT NULL
+        REFERENCES users (id),
+    is_admin      BOOLEAN                  NOT NULL DEFAULT FALSE,
+    subscribed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (chat_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS channel_subscribers__user_id__idx
+    ON channel_subscribers (user_id);
+
+CREATE INDEX IF NOT EXISTS channel_subscribers__chat_id__admins__idx
+    ON channel_subscribers (chat_id)
+    WHERE is_admin;
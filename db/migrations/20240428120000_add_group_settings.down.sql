@@ -0,0 +1,10 @@
+BEGIN;
+
+DROP INDEX IF EXISTS messages__chat_id__sender_id__sent_at__idx;
+
+ALTER TABLE chats
+    DROP COLUMN IF EXISTS only_admins_can_post,
+    DROP COLUMN IF EXISTS slow_mode_interval,
+    DROP COLUMN IF EXISTS members_can_invite;
+
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN;
+
+ALTER TABLE chats
+    ADD COLUMN IF NOT EXISTS only_admins_can_post BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS slow_mode_interval   INTEGER NOT NULL DEFAULT 0 CHECK (slow_mode_interval >= 0),
+    ADD COLUMN IF NOT EXISTS members_can_invite   BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX IF NOT EXISTS messages__chat_id__sender_id__sent_at__idx
+    ON messages (chat_id, sender_id, sent_at DESC)
+    WHERE NOT is_service;
+
+COMMIT;
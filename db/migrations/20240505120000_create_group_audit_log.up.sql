@@ -0,0 +1,22 @@
+BEGIN;
+
+-- Entries aren't bound to chats by a foreign key,
+-- so the history outlives the group.
+CREATE TABLE IF NOT EXISTS group_audit_log
+(
+    id             BIGSERIAL PRIMARY KEY,
+    chat_id        BIGINT                   NOT NULL,
+    actor_id       BIGINT                   NOT NULL
+        REFERENCES users (id),
+    target_user_id BIGINT                   NULL
+        REFERENCES users (id),
+    action         VARCHAR(64)              NOT NULL,
+    before         JSONB                    NULL,
+    after          JSONB                    NULL,
+    created_at     TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS group_audit_log__chat_id__id__idx
+    ON group_audit_log (chat_id, id);
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+DELETE FROM chats WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS chats__deleted_at__idx;
+
+ALTER TABLE chats
+    DROP COLUMN IF EXISTS deleted_at;
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+
+-- Deleted groups are kept until the retention period is over, so the owner can restore them.
+ALTER TABLE chats
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE NULL;
+
+CREATE INDEX IF NOT EXISTS chats__deleted_at__idx
+    ON chats (deleted_at)
+    WHERE deleted_at IS NOT NULL;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE messages
+    DROP COLUMN IF EXISTS service_action,
+    DROP COLUMN IF EXISTS service_params;
+
+COMMIT;
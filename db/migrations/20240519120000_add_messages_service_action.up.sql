@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE messages
+    ADD COLUMN IF NOT EXISTS service_action VARCHAR(64) NULL,
+    ADD COLUMN IF NOT EXISTS service_params JSONB       NULL;
+
+COMMIT;
@@ -0,0 +1,17 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS user_blocks
+(
+    user_id         BIGINT                   NOT NULL
+        REFERENCES users (id) ON DELETE CASCADE,
+    blocked_user_id BIGINT                   NOT NULL
+        REFERENCES users (id) ON DELETE CASCADE,
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (user_id, blocked_user_id)
+);
+
+CREATE INDEX IF NOT EXISTS user_blocks__blocked_user_id__idx
+    ON user_blocks (blocked_user_id);
+
+COMMIT;
@@ -0,0 +1,18 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS contacts
+(
+    user_id         BIGINT                   NOT NULL
+        REFERENCES users (id) ON DELETE CASCADE,
+    contact_user_id BIGINT                   NOT NULL
+        REFERENCES users (id) ON DELETE CASCADE,
+    display_name    VARCHAR(100)             NOT NULL DEFAULT '',
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (user_id, contact_user_id)
+);
+
+CREATE INDEX IF NOT EXISTS contacts__contact_user_id__idx
+    ON contacts (contact_user_id);
+
+COMMIT;
@@ -0,0 +1,19 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS dialog_requests
+(
+    chat_id      BIGINT PRIMARY KEY
+        REFERENCES chats (id) ON DELETE CASCADE,
+    sender_id    BIGINT                   NOT NULL
+        REFERENCES users (id) ON DELETE CASCADE,
+    recipient_id BIGINT                   NOT NULL
+        REFERENCES users (id) ON DELETE CASCADE,
+    status       VARCHAR(16)              NOT NULL DEFAULT 'pending',
+    created_at   TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at   TIMESTAMP WITH TIME ZONE NULL
+);
+
+CREATE INDEX IF NOT EXISTS dialog_requests__recipient_id__status__idx
+    ON dialog_requests (recipient_id, status);
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE dialog_participants
+    DROP COLUMN IF EXISTS cleared_before_message_id,
+    DROP COLUMN IF EXISTS hidden;
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+-- Messages up to the one with cleared_before_message_id are cleared for the participant,
+-- hidden dialogs are shown again once a new message arrives after the clear point.
+ALTER TABLE dialog_participants
+    ADD COLUMN IF NOT EXISTS cleared_before_message_id BIGINT  NULL,
+    ADD COLUMN IF NOT EXISTS hidden                    BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMIT;
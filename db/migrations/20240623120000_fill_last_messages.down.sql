@@ -0,0 +1,5 @@
+BEGIN;
+
+DELETE FROM last_messages;
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+INSERT INTO last_messages (chat_id, message_id)
+SELECT chat_id, MAX(id)
+FROM messages
+GROUP BY chat_id
+ON CONFLICT (chat_id) DO UPDATE
+    SET message_id = EXCLUDED.message_id;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS chat_folder_chats;
+DROP TABLE IF EXISTS chat_folders;
+DROP TABLE IF EXISTS user_chat_settings;
+
+COMMIT;
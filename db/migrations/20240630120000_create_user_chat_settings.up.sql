@@ -0,0 +1,48 @@
+BEGIN;
+
+-- Settings of a chat which are personal for the user, chats without a row have the default ones.
+-- The pinned chat with the greater pinned_order is listed first.
+CREATE TABLE IF NOT EXISTS user_chat_settings
+(
+    user_id      BIGINT                   NOT NULL
+        REFERENCES users (id) ON DELETE CASCADE,
+    chat_id      BIGINT                   NOT NULL
+        REFERENCES chats (id) ON DELETE CASCADE,
+    muted_until  TIMESTAMP WITH TIME ZONE NULL,
+    pinned_order INT                      NULL,
+    archived     BOOLEAN                  NOT NULL DEFAULT FALSE,
+    updated_at   TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (user_id, chat_id)
+);
+
+CREATE TABLE IF NOT EXISTS chat_folders
+(
+    id               BIGSERIAL PRIMARY KEY,
+    user_id          BIGINT                   NOT NULL
+        REFERENCES users (id) ON DELETE CASCADE,
+    name             VARCHAR(64)              NOT NULL,
+    include_dialogs  BOOLEAN                  NOT NULL DEFAULT FALSE,
+    include_groups   BOOLEAN                  NOT NULL DEFAULT FALSE,
+    exclude_muted    BOOLEAN                  NOT NULL DEFAULT FALSE,
+    exclude_archived BOOLEAN                  NOT NULL DEFAULT FALSE,
+    created_at       TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at       TIMESTAMP WITH TIME ZONE NULL
+);
+
+CREATE INDEX IF NOT EXISTS chat_folders__user_id__idx
+    ON chat_folders (user_id);
+
+-- Chats which are explicitly included into or excluded from the folder regardless of its rules.
+CREATE TABLE IF NOT EXISTS chat_folder_chats
+(
+    folder_id BIGINT  NOT NULL
+        REFERENCES chat_folders (id) ON DELETE CASCADE,
+    chat_id   BIGINT  NOT NULL
+        REFERENCES chats (id) ON DELETE CASCADE,
+    excluded  BOOLEAN NOT NULL DEFAULT FALSE,
+
+    PRIMARY KEY (folder_id, chat_id)
+);
+
+COMMIT;
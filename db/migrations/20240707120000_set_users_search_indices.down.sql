@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP INDEX IF EXISTS users__full_name_trgm__idx;
+DROP INDEX IF EXISTS users__username_trgm__idx;
+
+DROP EXTENSION IF EXISTS pg_trgm;
+
+COMMIT;
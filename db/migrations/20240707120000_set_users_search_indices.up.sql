@@ -0,0 +1,14 @@
+BEGIN;
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Trigram indexes serve both the username prefix and the name substring search of the user directory.
+CREATE INDEX IF NOT EXISTS users__username_trgm__idx
+    ON users USING GIN (username gin_trgm_ops)
+    WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS users__full_name_trgm__idx
+    ON users USING GIN ((COALESCE(first_name, '') || ' ' || COALESCE(last_name, '')) gin_trgm_ops)
+    WHERE deleted_at IS NULL;
+
+COMMIT;
@@ -0,0 +1,20 @@
+BEGIN;
+
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS email_verified_at TIMESTAMP WITH TIME ZONE NULL;
+
+-- A new token of the user replaces the previous ones.
+CREATE TABLE IF NOT EXISTS email_verifications
+(
+    token      VARCHAR(64) PRIMARY KEY,
+    user_id    BIGINT                   NOT NULL
+        REFERENCES users (id) ON DELETE CASCADE,
+    email      VARCHAR(255)             NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS email_verifications__user_id__idx
+    ON email_verifications (user_id);
+
+COMMIT;
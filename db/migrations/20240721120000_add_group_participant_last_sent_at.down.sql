@@ -0,0 +1,10 @@
+BEGIN;
+
+CREATE INDEX IF NOT EXISTS messages__chat_id__sender_id__sent_at__idx
+    ON messages (chat_id, sender_id, sent_at DESC)
+    WHERE NOT is_service;
+
+ALTER TABLE group_participants
+    DROP COLUMN IF EXISTS last_sent_at;
+
+COMMIT;
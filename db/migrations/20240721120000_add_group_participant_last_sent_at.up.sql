@@ -0,0 +1,21 @@
+BEGIN;
+
+-- Slow mode is enforced by updating the time of the last post under a guard,
+-- so concurrent messages of the same participant can't slip through it.
+ALTER TABLE group_participants
+    ADD COLUMN IF NOT EXISTS last_sent_at TIMESTAMP WITH TIME ZONE NULL;
+
+UPDATE group_participants gp
+SET last_sent_at = m.sent_at
+FROM (SELECT chat_id, sender_id, MAX(sent_at) AS sent_at
+      FROM messages
+      WHERE chat_type = 'group'
+        AND NOT is_service
+        AND chat_id IN (SELECT id FROM chats WHERE type = 'group' AND slow_mode_interval > 0)
+      GROUP BY chat_id, sender_id) m
+WHERE gp.chat_id = m.chat_id
+  AND gp.user_id = m.sender_id;
+
+DROP INDEX IF EXISTS messages__chat_id__sender_id__sent_at__idx;
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE messages
+    DROP COLUMN IF EXISTS forwarded_from_id;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+-- Forwarded messages keep the author of the original message.
+ALTER TABLE messages
+    ADD COLUMN IF NOT EXISTS forwarded_from_id BIGINT NULL REFERENCES users (id);
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+-- Hashed tokens can't be restored, so they are invalidated.
+DELETE FROM email_verifications;
+
+ALTER TABLE email_verifications
+    RENAME COLUMN token_hash TO token;
+
+COMMIT;
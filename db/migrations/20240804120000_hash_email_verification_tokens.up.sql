@@ -0,0 +1,11 @@
+BEGIN;
+
+-- Only SHA-256 hashes of verification tokens are stored,
+-- so the tokens can't be used if the table leaks.
+ALTER TABLE email_verifications
+    RENAME COLUMN token TO token_hash;
+
+UPDATE email_verifications
+SET token_hash = encode(sha256(convert_to(token_hash, 'UTF8')), 'hex');
+
+COMMIT;
@@ -0,0 +1,2 @@
+-- Backfilled users can't be told apart from the ones who have verified their email,
+-- so the backfill isn't reverted.
@@ -0,0 +1,11 @@
+BEGIN;
+
+-- Users signed up before email verification was introduced have never got a token,
+-- so they are considered verified. Otherwise enabling of the login block would lock
+-- all of them out at once.
+UPDATE users u
+SET email_verified_at = u.created_at
+WHERE u.email_verified_at IS NULL
+  AND NOT EXISTS(SELECT 1 FROM email_verifications ev WHERE ev.user_id = u.id);
+
+COMMIT;
@@ -1,6 +1,9 @@
 package app
 
 import (
+	"context"
+	"errors"
+	"expvar"
 	"fmt"
 	"io"
 	"os"
@@ -9,14 +12,23 @@ import (
 	"syscall"
 
 	"github.com/Chatyx/backend/internal/config"
+	"github.com/Chatyx/backend/internal/entity"
 	cachepostgres "github.com/Chatyx/backend/internal/infrastructure/cache/postgres"
+	cacheredis "github.com/Chatyx/backend/internal/infrastructure/cache/redis"
+	mailfile "github.com/Chatyx/backend/internal/infrastructure/mail/file"
+	mailsmtp "github.com/Chatyx/backend/internal/infrastructure/mail/smtp"
 	"github.com/Chatyx/backend/internal/infrastructure/repository/postgres"
+	"github.com/Chatyx/backend/internal/infrastructure/sysbus/codec"
+	sysbusmemory "github.com/Chatyx/backend/internal/infrastructure/sysbus/memory"
+	sysbuspostgres "github.com/Chatyx/backend/internal/infrastructure/sysbus/postgres"
 	sysbusredis "github.com/Chatyx/backend/internal/infrastructure/sysbus/redis"
 	"github.com/Chatyx/backend/internal/service"
 	inhttp "github.com/Chatyx/backend/internal/transport/http"
 	v1 "github.com/Chatyx/backend/internal/transport/http/v1"
 	"github.com/Chatyx/backend/internal/transport/websocket"
 	"github.com/Chatyx/backend/pkg/auth"
+	"github.com/Chatyx/backend/pkg/auth/storage/memory"
+	authpostgres "github.com/Chatyx/backend/pkg/auth/storage/postgres"
 	"github.com/Chatyx/backend/pkg/auth/storage/redis"
 	auhttp "github.com/Chatyx/backend/pkg/auth/transport/http"
 	"github.com/Chatyx/backend/pkg/httputil/middleware"
@@ -24,6 +36,7 @@ import (
 	"github.com/Chatyx/backend/pkg/validator"
 
 	"github.com/ilyakaznacheev/cleanenv"
+	goredis "github.com/redis/go-redis/v9"
 )
 
 type CloserAdapter func()
@@ -33,6 +46,28 @@ func (c CloserAdapter) Close() error {
 	return nil
 }
 
+type messagePublishSubscriber interface {
+	service.MessagePublisher
+	service.MessageSubscriber
+}
+
+type messageInboxPublishSubscriber interface {
+	service.MessageInboxPublisher
+	service.MessageInboxSubscriber
+}
+
+type participantEventProduceConsumer interface {
+	service.GroupParticipantEventProducer
+	service.ParticipantEventConsumer
+	cachepostgres.ParticipantEventConsumer
+}
+
+type sessionStorage interface {
+	auth.SessionStorage
+	service.SessionRepository
+	io.Closer
+}
+
 type Runner interface {
 	Run()
 }
@@ -69,54 +104,258 @@ func NewApp(confPath string) *App {
 	}
 	closers = append(closers, CloserAdapter(pgPool.Close))
 
-	redisCli, err := sysbusredis.NewRedisConn(conf.Redis)
-	if err != nil {
-		log.WithError(err).Fatal("Failed to init redis client")
-	}
-	closers = append(closers, redisCli)
-
 	txm := postgres.NewTransactionManager(pgPool)
 	userRepo := postgres.NewUserRepository(pgPool)
+	emailVerificationRepo := postgres.NewEmailVerificationRepository(pgPool)
 	groupRepo := postgres.NewGroupRepository(pgPool)
 	dialogRepo := postgres.NewDialogRepository(pgPool)
 	groupParticipantRepo := postgres.NewGroupParticipantRepository(pgPool)
-	participantChecker := cachepostgres.NewParticipantChecker(pgPool)
+	groupInviteLinkRepo := postgres.NewGroupInviteLinkRepository(pgPool)
+	groupAuditLogRepo := postgres.NewGroupAuditLogRepository(pgPool)
+	channelRepo := postgres.NewChannelRepository(pgPool)
+	channelSubscriberRepo := postgres.NewChannelSubscriberRepository(pgPool)
 	messageRepo := postgres.NewMessageRepository(pgPool)
-	messagePubSub := sysbusredis.NewMessagePublishSubscriber(redisCli)
-	chatProdCons := sysbusredis.NewParticipantEventProduceConsumer(redisCli)
-
-	authStorageDBNum, _ := strconv.Atoi(conf.Redis.Database)
-	authStorage, err := redis.NewStorage(redis.Config{
-		Host:        conf.Redis.Host,
-		Port:        conf.Redis.Port,
-		Username:    conf.Redis.User,
-		Password:    conf.Redis.Password,
-		DB:          authStorageDBNum,
-		ConnTimeout: conf.Redis.Timeout,
+	userBlockRepo := postgres.NewUserBlockRepository(pgPool)
+	contactRepo := postgres.NewContactRepository(pgPool)
+	chatRepo := postgres.NewChatRepository(pgPool)
+	chatSettingsRepo := postgres.NewChatSettingsRepository(pgPool)
+	chatFolderRepo := postgres.NewChatFolderRepository(pgPool)
+
+	var (
+		messagePubSub messagePublishSubscriber
+		inboxPubSub   messageInboxPublishSubscriber
+		chatProdCons  participantEventProduceConsumer
+		authStorage   sessionStorage
+	)
+
+	sysbusCodec, err := codec.New(codec.Config{
+		Encoding:      codec.Encoding(conf.Sysbus.Encoding),
+		SchemaVersion: conf.Sysbus.SchemaVersion,
 	})
 	if err != nil {
-		log.WithError(err).Fatal("Failed to establish redis connection")
+		log.WithError(err).Fatal("Failed to init sysbus codec")
+	}
+
+	var redisCli *goredis.Client
+	if conf.Sysbus.Driver == config.RedisSysbusDriver || conf.Cache.Participants.Redis {
+		redisCli, err = sysbusredis.NewRedisConn(conf.Redis)
+		if err != nil {
+			log.WithError(err).Fatal("Failed to init redis client")
+		}
+		closers = append(closers, redisCli)
+	}
+
+	switch conf.Sysbus.Driver {
+	case config.RedisSysbusDriver:
+		messagePubSub = sysbusredis.NewMessagePublishSubscriber(redisCli, sysbusCodec)
+		inboxPubSub = sysbusredis.NewMessageInboxPublishSubscriber(redisCli, sysbusCodec)
+		chatProdCons = sysbusredis.NewParticipantEventProduceConsumer(redisCli, sysbusCodec)
+
+		authStorageDBNum, _ := strconv.Atoi(conf.Redis.Database)
+		redisStorage, redisErr := redis.NewStorage(redis.Config{
+			Host:        conf.Redis.Host,
+			Port:        conf.Redis.Port,
+			Username:    conf.Redis.User,
+			Password:    conf.Redis.Password,
+			DB:          authStorageDBNum,
+			ConnTimeout: conf.Redis.Timeout,
+		})
+		if redisErr != nil {
+			log.WithError(redisErr).Fatal("Failed to establish redis connection")
+		}
+		authStorage = redisStorage
+	case config.MemorySysbusDriver:
+		broker := sysbusmemory.NewBroker()
+		closers = append(closers, broker)
+
+		messagePubSub = sysbusmemory.NewMessagePublishSubscriber(broker)
+		inboxPubSub = sysbusmemory.NewMessageInboxPublishSubscriber(broker)
+		chatProdCons = sysbusmemory.NewParticipantEventProduceConsumer(broker)
+		authStorage = memory.NewStorage()
+	case config.PostgresSysbusDriver:
+		listener := sysbuspostgres.NewListener(pgPool, sysbuspostgres.ListenerConfig{
+			OutboxRetention: conf.Sysbus.OutboxRetention,
+		})
+		runners = append(runners, listener)
+		closers = append(closers, listener)
+
+		messagePubSub = sysbuspostgres.NewMessagePublishSubscriber(pgPool, listener, sysbusCodec)
+		inboxPubSub = sysbuspostgres.NewMessageInboxPublishSubscriber(pgPool, listener, sysbusCodec)
+		chatProdCons = sysbuspostgres.NewParticipantEventProduceConsumer(pgPool, listener, sysbusCodec)
+		authStorage = authpostgres.NewStorage(pgPool)
+	default:
+		log.Fatalf("Unknown sysbus driver %q", conf.Sysbus.Driver)
 	}
 	closers = append(closers, authStorage)
 
+	var participantCacheStorage cachepostgres.ParticipantCacheStorage
+	if conf.Cache.Participants.Redis {
+		participantCacheStorage = cacheredis.NewParticipantStorage(redisCli, conf.Cache.Participants.TTL)
+	}
+
+	participantLister := cachepostgres.NewParticipantLister(pgPool, conf.Sysbus.ParticipantsCacheTTL)
+	groupSettingsCache := cachepostgres.NewGroupSettingsCache(pgPool, conf.Cache.Participants.Size, conf.Cache.Participants.TTL)
+	participantChecker := cachepostgres.NewParticipantChecker(pgPool, cachepostgres.ParticipantCheckerConfig{
+		Size:          conf.Cache.Participants.Size,
+		TTL:           conf.Cache.Participants.TTL,
+		Storage:       participantCacheStorage,
+		Lister:        participantLister,
+		GroupSettings: groupSettingsCache,
+		EventConsumer: chatProdCons,
+	})
+	runners = append(runners, participantChecker)
+	closers = append(closers, participantChecker)
+	expvar.Publish("participant_checker", expvar.Func(func() any {
+		return participantChecker.Stats()
+	}))
+
+	// Channels are always fanned out per chat regardless of the strategy,
+	// since delivering to the inbox of every subscriber doesn't scale.
+	broadcastPubSub := messagePubSub
+
+	switch conf.Sysbus.FanOut {
+	case config.ChatFanOut:
+	case config.UserFanOut:
+		messagePubSub = service.NewInboxFanOut(service.InboxFanOutConfig{
+			ParticipantLister: participantLister,
+			Publisher:         inboxPubSub,
+			Subscriber:        inboxPubSub,
+		})
+	default:
+		log.Fatalf("Unknown sysbus fan-out strategy %q", conf.Sysbus.FanOut)
+	}
+
+	channelFanOut := service.NewChannelFanOut(service.ChannelFanOutConfig{
+		Publisher:           messagePubSub,
+		Subscriber:          messagePubSub,
+		BroadcastPublisher:  broadcastPubSub,
+		BroadcastSubscriber: broadcastPubSub,
+	})
+	closers = append(closers, channelFanOut)
+	messagePubSub = channelFanOut
+
+	var mailer service.Mailer
+	switch conf.Mail.Driver {
+	case config.SMTPMailDriver:
+		mailer = mailsmtp.NewMailer(conf.Mail)
+	case config.FileMailDriver:
+		mailer, err = mailfile.NewMailer(conf.Mail)
+		if err != nil {
+			log.WithError(err).Fatal("Failed to init file mailer")
+		}
+	default:
+		log.Fatalf("Unknown mail driver %q", conf.Mail.Driver)
+	}
+
+	switch conf.EmailVerification.Block {
+	case config.NoEmailVerificationBlock, config.LoginEmailVerificationBlock, config.MessagingEmailVerificationBlock:
+	default:
+		log.Fatalf("Unknown email verification block %q", conf.EmailVerification.Block)
+	}
+
 	userService := service.NewUser(service.UserConfig{
-		UserRepository:    userRepo,
-		SessionRepository: authStorage,
+		UserRepository:         userRepo,
+		SessionRepository:      authStorage,
+		VerificationRepository: emailVerificationRepo,
+		TxManager:              txm,
+		Mailer:                 mailer,
+		VerificationTokenTTL:   conf.EmailVerification.TokenTTL,
+		RequireVerifiedEmail:   conf.EmailVerification.Block == config.LoginEmailVerificationBlock,
 	})
-	groupService := service.NewGroup(groupRepo, chatProdCons)
-	dialogService := service.NewDialog(dialogRepo, chatProdCons)
-	groupParticipantService := service.NewGroupParticipant(service.GroupParticipantConfig{
+	channelService := service.NewChannel(channelRepo, chatProdCons)
+	channelSubscriberService := service.NewChannelSubscriber(service.ChannelSubscriberConfig{
 		TxManager:     txm,
+		Repository:    channelSubscriberRepo,
+		EventProducer: chatProdCons,
+	})
+	messageService := service.NewMessage(service.MessageConfig{
+		TxManager:             txm,
+		Repository:            messageRepo,
+		Publisher:             messagePubSub,
+		Checker:               participantChecker,
+		GroupSettings:         groupSettingsCache,
+		ParticipantRepository: groupParticipantRepo,
+		VerificationChecker:   userRepo,
+		RequireVerifiedEmail:  conf.EmailVerification.Block == config.MessagingEmailVerificationBlock,
+	})
+	chatService := service.NewChat(chatRepo, chatFolderRepo)
+	chatSettingsService := service.NewChatSettings(service.ChatSettingsConfig{
+		TxManager:     txm,
+		Repository:    chatSettingsRepo,
+		EventProducer: chatProdCons,
+	})
+	chatFolderService := service.NewChatFolder(service.ChatFolderConfig{
+		TxManager:     txm,
+		Repository:    chatFolderRepo,
+		EventProducer: chatProdCons,
+	})
+	contactService := service.NewContact(service.ContactConfig{
+		Repository:     contactRepo,
+		UserRepository: userRepo,
+	})
+	dialogService := service.NewDialog(service.DialogConfig{
+		TxManager:      txm,
+		Repository:     dialogRepo,
+		EventProducer:  chatProdCons,
+		MessageCreator: messageService,
+		BlockChecker:   userBlockRepo,
+		ContactChecker: contactRepo,
+		UserBlocker:    userBlockRepo,
+	})
+	userBlockService := service.NewUserBlock(service.UserBlockConfig{
+		TxManager:     txm,
+		Repository:    userBlockRepo,
+		DialogBlocker: dialogService,
+	})
+	groupService := service.NewGroup(service.GroupConfig{
+		Repository:            groupRepo,
+		ParticipantRepository: groupParticipantRepo,
+		EventProducer:         chatProdCons,
+		MessageCreator:        messageService,
+		AuditLogRepository:    groupAuditLogRepo,
+		TxManager:             txm,
+		RetentionPeriod:       conf.Groups.DeletedRetentionPeriod,
+	})
+	groupParticipantService := service.NewGroupParticipant(service.GroupParticipantConfig{
+		TxManager:            txm,
+		Repository:           groupParticipantRepo,
+		GroupRepository:      groupRepo,
+		SettingsRepository:   groupRepo,
+		InviteLinkRepository: groupInviteLinkRepo,
+		EventProducer:        chatProdCons,
+		MessageCreator:       messageService,
+		AuditLogRepository:   groupAuditLogRepo,
+		BlockChecker:         userBlockRepo,
+		MaxParticipants:      conf.Groups.MaxParticipants,
+	})
+	groupAuditLogService := service.NewGroupAuditLog(groupAuditLogRepo, groupParticipantRepo)
+	groupInviteLinkService := service.NewGroupInviteLink(service.GroupInviteLinkConfig{
+		TxManager:             txm,
+		Repository:            groupInviteLinkRepo,
+		ParticipantRepository: groupParticipantRepo,
+	})
+	restrictionLifter := service.NewRestrictionLifter(service.RestrictionLifterConfig{
+		Interval:      conf.Groups.RestrictionLiftInterval,
 		Repository:    groupParticipantRepo,
 		EventProducer: chatProdCons,
 	})
-	messageService := service.NewMessage(messageRepo, messagePubSub, participantChecker)
+	runners = append(runners, restrictionLifter)
+	closers = append(closers, restrictionLifter)
+	groupPurger := service.NewGroupPurger(service.GroupPurgerConfig{
+		Interval:        conf.Groups.PurgeInterval,
+		RetentionPeriod: conf.Groups.DeletedRetentionPeriod,
+		Repository:      groupRepo,
+	})
+	runners = append(runners, groupPurger)
+	closers = append(closers, groupPurger)
 	messageServeManager := service.NewMessageServeManager(service.MessageServeManagerConfig{
-		Service:          messageService,
-		EventConsumer:    chatProdCons,
-		Subscriber:       messagePubSub,
-		GroupRepository:  groupRepo,
-		DialogRepository: dialogRepo,
+		Service:            messageService,
+		EventConsumer:      chatProdCons,
+		Subscriber:         messagePubSub,
+		GroupRepository:    groupRepo,
+		DialogRepository:   dialogRepo,
+		ChannelRepository:  channelRepo,
+		SettingsRepository: chatSettingsRepo,
 	})
 	authService := auth.NewService(
 		authStorage,
@@ -125,17 +364,48 @@ func NewApp(confPath string) *App {
 		auth.WithAccessTokenTTL(conf.Auth.AccessTokenTTL),
 		auth.WithRefreshTokenTTL(conf.Auth.RefreshTokenTTL),
 		auth.WithLogger(log.With("service", "auth")),
-		auth.WithCheckPassword(userService.CheckPassword),
+		auth.WithCheckPassword(func(ctx context.Context, username, password string) (string, bool, error) {
+			userID, ok, err := userService.CheckPassword(ctx, username, password)
+			if errors.Is(err, entity.ErrEmailNotVerified) {
+				return "", false, fmt.Errorf("%w: %v", auth.ErrLoginForbidden, err)
+			}
+			return userID, ok, err
+		}),
 	)
 
 	authorizeMiddleware := middleware.Authorize([]byte(conf.Auth.SignKey))
 
 	vld := validator.NewValidator()
 	userController := v1.NewUserController(v1.UserControllerConfig{
-		Service:   userService,
+		Service:        userService,
+		ContactService: contactService,
+		Authorize:      authorizeMiddleware,
+		Validator:      vld,
+	})
+	contactController := v1.NewContactController(v1.ContactControllerConfig{
+		Service:   contactService,
+		Authorize: authorizeMiddleware,
+		Validator: vld,
+	})
+	chatController := v1.NewChatController(v1.ChatControllerConfig{
+		Service:   chatService,
 		Authorize: authorizeMiddleware,
 		Validator: vld,
 	})
+	chatSettingsController := v1.NewChatSettingsController(v1.ChatSettingsControllerConfig{
+		Service:   chatSettingsService,
+		Authorize: authorizeMiddleware,
+		Validator: vld,
+	})
+	chatFolderController := v1.NewChatFolderController(v1.ChatFolderControllerConfig{
+		Service:   chatFolderService,
+		Authorize: authorizeMiddleware,
+		Validator: vld,
+	})
+	userBlockController := v1.NewUserBlockController(v1.UserBlockControllerConfig{
+		Service:   userBlockService,
+		Authorize: authorizeMiddleware,
+	})
 	groupController := v1.NewGroupController(v1.GroupControllerConfig{
 		Service:   groupService,
 		Authorize: authorizeMiddleware,
@@ -151,6 +421,26 @@ func NewApp(confPath string) *App {
 		Authorize: authorizeMiddleware,
 		Validator: vld,
 	})
+	groupInviteLinkController := v1.NewGroupInviteLinkController(v1.GroupInviteLinkControllerConfig{
+		Service:   groupInviteLinkService,
+		Authorize: authorizeMiddleware,
+		Validator: vld,
+	})
+	groupAuditLogController := v1.NewGroupAuditLogController(v1.GroupAuditLogControllerConfig{
+		Service:   groupAuditLogService,
+		Authorize: authorizeMiddleware,
+		Validator: vld,
+	})
+	channelController := v1.NewChannelController(v1.ChannelControllerConfig{
+		Service:   channelService,
+		Authorize: authorizeMiddleware,
+		Validator: vld,
+	})
+	channelSubscriberController := v1.NewChannelSubscriberController(v1.ChannelSubscriberControllerConfig{
+		Service:   channelSubscriberService,
+		Authorize: authorizeMiddleware,
+		Validator: vld,
+	})
 	messageController := v1.NewMessageController(v1.MessageControllerConfig{
 		Service:   messageService,
 		Authorize: authorizeMiddleware,
@@ -171,9 +461,18 @@ func NewApp(confPath string) *App {
 		},
 		authController,
 		userController,
+		userBlockController,
+		contactController,
+		chatController,
+		chatSettingsController,
+		chatFolderController,
 		groupController,
 		dialogController,
 		groupParticipantController,
+		groupInviteLinkController,
+		groupAuditLogController,
+		channelController,
+		channelSubscriberController,
 		messageController,
 	)
 	runners = append(runners, apiServer)
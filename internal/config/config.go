@@ -47,14 +47,87 @@ type Redis struct {
 	Conn `yaml:"conn"`
 }
 
+const (
+	RedisSysbusDriver    = "redis"
+	MemorySysbusDriver   = "memory"
+	PostgresSysbusDriver = "postgres"
+)
+
+const (
+	ChatFanOut = "chat"
+	UserFanOut = "user"
+)
+
+type Sysbus struct {
+	Driver               string        `env:"DRIVER"         env-default:"redis"    yaml:"driver"`
+	FanOut               string        `env:"FAN_OUT"        env-default:"chat"     yaml:"fan_out"`
+	Encoding             string        `env:"ENCODING"       env-default:"protobuf" yaml:"encoding"`
+	SchemaVersion        uint32        `env:"SCHEMA_VERSION" env-default:"1"        yaml:"schema_version"`
+	ParticipantsCacheTTL time.Duration `env-default:"10s" yaml:"participants_cache_ttl"`
+	OutboxRetention      time.Duration `env-default:"1h"  yaml:"outbox_retention"`
+}
+
+type ParticipantsCache struct {
+	Size  int           `env-default:"10000" yaml:"size"`
+	TTL   time.Duration `env-default:"1m"    yaml:"ttl"`
+	Redis bool          `env:"REDIS"         yaml:"redis"`
+}
+
+type Cache struct {
+	Participants ParticipantsCache `env-prefix:"PARTICIPANTS_" yaml:"participants"`
+}
+
+type Groups struct {
+	RestrictionLiftInterval time.Duration `env-default:"1m"   yaml:"restriction_lift_interval"`
+	MaxParticipants         int           `env-default:"100"  yaml:"max_participants"`
+	DeletedRetentionPeriod  time.Duration `env-default:"720h" yaml:"deleted_retention_period"`
+	PurgeInterval           time.Duration `env-default:"1h"   yaml:"purge_interval"`
+}
+
+const (
+	SMTPMailDriver = "smtp"
+	FileMailDriver = "file"
+)
+
+type SMTP struct {
+	Host     string        `env:"HOST"        env-default:"localhost" yaml:"host"`
+	Port     string        `env:"PORT"        env-default:"587"       yaml:"port"`
+	Username string        `env:"USERNAME"    yaml:"username"`
+	Password string        `env:"PASSWORD"    yaml:"password"`
+	Timeout  time.Duration `env-default:"10s" yaml:"timeout"`
+}
+
+type Mail struct {
+	Driver string `env:"DRIVER"       env-default:"file"                 yaml:"driver"`
+	From   string `env:"FROM"         env-default:"noreply@chatyx.local" yaml:"from"`
+	Dir    string `env:"DIR"          yaml:"dir"`
+	SMTP   SMTP   `env-prefix:"SMTP_" yaml:"smtp"`
+}
+
+const (
+	NoEmailVerificationBlock        = "none"
+	LoginEmailVerificationBlock     = "login"
+	MessagingEmailVerificationBlock = "messaging"
+)
+
+type EmailVerification struct {
+	TokenTTL time.Duration `env-default:"24h" yaml:"token_ttl"`
+	Block    string        `env:"BLOCK"       env-default:"none" yaml:"block"`
+}
+
 type Config struct {
-	Domain   string   `env-default:"localhost" yaml:"domain"`
-	Debug    bool     `yaml:"debug"`
-	Log      Log      `yaml:"log"`
-	API      Server   `env-prefix:"API_"       yaml:"api"`
-	Chat     Server   `env-prefix:"CHAT_"      yaml:"chat"`
-	Cors     Cors     `yaml:"cors"`
-	Auth     Auth     `yaml:"auth"`
-	Postgres Postgres `env-prefix:"POSTGRES_"  yaml:"postgres"`
-	Redis    Redis    `env-prefix:"REDIS_"     yaml:"redis"`
+	Domain            string            `env-default:"localhost"          yaml:"domain"`
+	Debug             bool              `yaml:"debug"`
+	Log               Log               `yaml:"log"`
+	API               Server            `env-prefix:"API_"                yaml:"api"`
+	Chat              Server            `env-prefix:"CHAT_"               yaml:"chat"`
+	Cors              Cors              `yaml:"cors"`
+	Auth              Auth              `yaml:"auth"`
+	Postgres          Postgres          `env-prefix:"POSTGRES_"           yaml:"postgres"`
+	Redis             Redis             `env-prefix:"REDIS_"              yaml:"redis"`
+	Sysbus            Sysbus            `env-prefix:"SYSBUS_"             yaml:"sysbus"`
+	Cache             Cache             `env-prefix:"CACHE_"              yaml:"cache"`
+	Groups            Groups            `yaml:"groups"`
+	Mail              Mail              `env-prefix:"MAIL_"               yaml:"mail"`
+	EmailVerification EmailVerification `env-prefix:"EMAIL_VERIFICATION_" yaml:"email_verification"`
 }
@@ -0,0 +1,14 @@
+package dto
+
+type ChannelCreate struct {
+	Uname       string
+	Name        string
+	Description string
+}
+
+type ChannelUpdate struct {
+	ID          int
+	Uname       string
+	Name        string
+	Description string
+}
@@ -0,0 +1,50 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/Chatyx/backend/internal/entity"
+)
+
+// ChatListCursor points to the last chat of the previous page of the chat list.
+type ChatListCursor struct {
+	PinnedOrder    int
+	LastActivityAt time.Time
+	ChatID         int
+}
+
+type ChatList struct {
+	Cursor *ChatListCursor
+	// Archived lists archived chats instead of the others, it's ignored if FolderID is set.
+	Archived bool
+	FolderID *int
+	Limit    int
+}
+
+type ChatSettingsUpdate struct {
+	ChatID     entity.ChatID
+	MutedUntil *time.Time
+	IsPinned   bool
+	IsArchived bool
+}
+
+type ChatFolderCreate struct {
+	Name            string
+	IncludeDialogs  bool
+	IncludeGroups   bool
+	ExcludeMuted    bool
+	ExcludeArchived bool
+	IncludedChats   []entity.ChatID
+	ExcludedChats   []entity.ChatID
+}
+
+type ChatFolderUpdate struct {
+	ID              int
+	Name            string
+	IncludeDialogs  bool
+	IncludeGroups   bool
+	ExcludeMuted    bool
+	ExcludeArchived bool
+	IncludedChats   []entity.ChatID
+	ExcludedChats   []entity.ChatID
+}
@@ -0,0 +1,17 @@
+package dto
+
+type ContactCreate struct {
+	ContactUserID int
+	DisplayName   string
+}
+
+type ContactUpdate struct {
+	ContactUserID int
+	DisplayName   string
+}
+
+// ContactImport lists usernames and emails of users to add to the contacts.
+type ContactImport struct {
+	Usernames []string
+	Emails    []string
+}
@@ -8,3 +8,8 @@ type DialogUpdate struct {
 	ID               int
 	PartnerIsBlocked *bool
 }
+
+type DialogDelete struct {
+	ID      int
+	ForBoth bool
+}
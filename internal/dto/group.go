@@ -1,12 +1,52 @@
 package dto
 
+import (
+	"time"
+
+	"github.com/Chatyx/backend/internal/entity"
+)
+
 type GroupCreate struct {
+	Uname       string
 	Name        string
 	Description string
 }
 
 type GroupUpdate struct {
 	ID          int
+	Uname       string
 	Name        string
 	Description string
 }
+
+type GroupSettingsUpdate struct {
+	GroupID           int
+	OnlyAdminsCanPost bool
+	SlowModeInterval  time.Duration
+	MembersCanInvite  bool
+}
+
+type GroupInviteLinkCreate struct {
+	GroupID          int
+	MaxUses          *int
+	RequiresApproval bool
+	ExpiresAt        *time.Time
+}
+
+type GroupParticipantStatusUpdate struct {
+	GroupID int
+	UserID  int
+	Status  entity.GroupParticipantStatus
+	// RestrictedUntil limits the duration of mute or ban, it's ignored for other statuses.
+	RestrictedUntil *time.Time
+}
+
+type GroupAuditLogList struct {
+	GroupID      int
+	Action       entity.GroupAuditAction
+	ActorID      int
+	TargetUserID int
+	IDAfter      int
+	Limit        int
+	Sort         Sort
+}
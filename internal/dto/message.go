@@ -25,3 +25,9 @@ type MessageCreate struct {
 	Content     string
 	ContentType entity.ContentType
 }
+
+type MessageForward struct {
+	ChatID     entity.ChatID
+	FromChatID entity.ChatID
+	MessageID  int
+}
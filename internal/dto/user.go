@@ -2,6 +2,15 @@ package dto
 
 import "time"
 
+type UserList struct {
+	// Search matches users whose username starts with it or whose name contains it.
+	Search string
+	// UsernameAfter is the username of the last user of the previous page,
+	// users are sorted by their usernames.
+	UsernameAfter string
+	Limit         int
+}
+
 type UserCreate struct {
 	Username  string
 	Password  string
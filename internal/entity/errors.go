@@ -3,9 +3,20 @@ package entity
 import "errors"
 
 var (
-	ErrUserNotFound          = errors.New("user is not found")
-	ErrSuchUserAlreadyExists = errors.New("user with such username or email already exists")
-	ErrWrongCurrentPassword  = errors.New("wrong current password")
+	ErrUserNotFound                 = errors.New("user is not found")
+	ErrSuchUserAlreadyExists        = errors.New("user with such username or email already exists")
+	ErrWrongCurrentPassword         = errors.New("wrong current password")
+	ErrUserBlockNotFound            = errors.New("user block is not found")
+	ErrSuchUserBlockAlreadyExists   = errors.New("such a user block already exists")
+	ErrBlockYourself                = errors.New("blocking yourself")
+	ErrBlockNonExistentUser         = errors.New("blocking a non-existent user")
+	ErrUserBlocked                  = errors.New("interaction with the user is blocked")
+	ErrContactNotFound              = errors.New("contact is not found")
+	ErrSuchContactAlreadyExists     = errors.New("such a contact already exists")
+	ErrAddYourselfToContacts        = errors.New("adding yourself to contacts")
+	ErrAddNonExistentUserToContacts = errors.New("adding a non-existent user to contacts")
+	ErrEmailNotVerified             = errors.New("email of the user isn't verified")
+	ErrInvalidVerificationToken     = errors.New("email verification token is invalid or expired")
 
 	ErrGroupNotFound                          = errors.New("group is not found")
 	ErrGroupParticipantNotFound               = errors.New("group participant is not found")
@@ -13,8 +24,27 @@ var (
 	ErrSuchDialogAlreadyExists                = errors.New("such a dialog already exists")
 	ErrCreateDialogWithYourself               = errors.New("creating a dialog with yourself")
 	ErrCreateDialogWithNonExistentUser        = errors.New("creating a dialog with a non-existent user")
+	ErrDialogRequestNotFound                  = errors.New("dialog request is not found")
 	ErrIncorrectGroupParticipantStatusTransit = errors.New("incorrect group participant status transit")
 	ErrSuchGroupParticipantAlreadyExists      = errors.New("such a group participant already exists")
 	ErrAddNonExistentUserToGroup              = errors.New("addition non-existent user to group")
+	ErrIncorrectGroupParticipantRoleChange    = errors.New("incorrect group participant role change")
+	ErrLastGroupOwnerLeaving                  = errors.New("the last group owner can't leave without transferring ownership")
+	ErrSuchGroupUnameAlreadyExists            = errors.New("group with such uname already exists")
+	ErrGroupInviteLinkNotFound                = errors.New("group invite link is not found")
+	ErrInactiveGroupInviteLink                = errors.New("group invite link is revoked, expired or exhausted")
+	ErrGroupParticipantLimitReached           = errors.New("group participant limit is reached")
+	ErrRestrictedGroupParticipant             = errors.New("group participant is restricted to send messages")
+	ErrChannelNotFound                        = errors.New("channel is not found")
+	ErrChannelSubscriberNotFound              = errors.New("channel subscriber is not found")
+	ErrSuchChannelSubscriberAlreadyExists     = errors.New("such a channel subscriber already exists")
+	ErrSuchChannelUnameAlreadyExists          = errors.New("channel with such uname already exists")
+	ErrLastChannelAdminLeaving                = errors.New("the last channel admin can't unsubscribe or be revoked")
+	ErrGroupSlowModeActive                    = errors.New("slow mode is enabled in the group, wait before posting")
+	ErrChatNotFound                           = errors.New("chat is not found")
+	ErrChatFolderNotFound                     = errors.New("chat folder is not found")
+	ErrChatFolderLimitReached                 = errors.New("chat folder limit is reached")
+	ErrMessageNotFound                        = errors.New("message is not found")
+	ErrMessageConsumerOverflow                = errors.New("message consumer doesn't keep up with messages")
 	ErrForbiddenPerformAction                 = errors.New("it's forbidden to perform this action")
 )
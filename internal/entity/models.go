@@ -8,10 +8,74 @@ func (gps GroupParticipantStatus) String() string {
 	return string(gps)
 }
 
+// IsInGroup reports whether the participant with such status has access to the group chat.
+func (gps GroupParticipantStatus) IsInGroup() bool {
+	return gps == JoinedStatus || gps == MutedStatus
+}
+
+// IsRestriction reports whether the status is a restriction which can be temporary.
+func (gps GroupParticipantStatus) IsRestriction() bool {
+	return gps == MutedStatus || gps == BannedStatus
+}
+
+const (
+	JoinedStatus  GroupParticipantStatus = "joined"
+	KickedStatus  GroupParticipantStatus = "kicked"
+	LeftStatus    GroupParticipantStatus = "left"
+	PendingStatus GroupParticipantStatus = "pending"
+	// MutedStatus is set for a participant who can read the group chat but can't post.
+	MutedStatus GroupParticipantStatus = "muted"
+	// BannedStatus is set for a participant who is kicked and can't join the group until the ban is lifted.
+	BannedStatus GroupParticipantStatus = "banned"
+)
+
+type GroupRole string
+
+func (gr GroupRole) String() string {
+	return string(gr)
+}
+
 const (
-	JoinedStatus GroupParticipantStatus = "joined"
-	KickedStatus GroupParticipantStatus = "kicked"
-	LeftStatus   GroupParticipantStatus = "left"
+	OwnerRole     GroupRole = "owner"
+	AdminRole     GroupRole = "admin"
+	ModeratorRole GroupRole = "moderator"
+	MemberRole    GroupRole = "member"
+)
+
+// IsHigherThan reports whether the role is above the other one
+// in the hierarchy owner > admin > moderator > member.
+func (gr GroupRole) IsHigherThan(other GroupRole) bool {
+	return gr.rank() > other.rank()
+}
+
+func (gr GroupRole) rank() int {
+	switch gr {
+	case OwnerRole:
+		return 4
+	case AdminRole:
+		return 3
+	case ModeratorRole:
+		return 2
+	case MemberRole:
+		return 1
+	}
+	return 0
+}
+
+type GroupPermission string
+
+func (gp GroupPermission) String() string {
+	return string(gp)
+}
+
+const (
+	InvitePermission         GroupPermission = "invite"
+	KickPermission           GroupPermission = "kick"
+	EditInfoPermission       GroupPermission = "edit_info"
+	PinPermission            GroupPermission = "pin"
+	DeleteMessagesPermission GroupPermission = "delete_messages"
+	PromotePermission        GroupPermission = "promote"
+	DeleteGroupPermission    GroupPermission = "delete_group"
 )
 
 type ChatType string
@@ -23,6 +87,17 @@ func (ct ChatType) String() string {
 const (
 	DialogChatType ChatType = "dialog"
 	GroupChatType  ChatType = "group"
+	// ChannelChatType is a broadcast chat where only admins post and subscribers read.
+	ChannelChatType ChatType = "channel"
+)
+
+// ChatAccess is a level of access of a user to a chat.
+type ChatAccess int
+
+const (
+	NoChatAccess ChatAccess = iota
+	ReadChatAccess
+	WriteChatAccess
 )
 
 type ContentType string
@@ -45,6 +120,19 @@ func (et ParticipantEventType) String() string {
 const (
 	AddedParticipant   ParticipantEventType = "added"
 	RemovedParticipant ParticipantEventType = "removed"
+	// JoinRequested notifies the user who can approve a join request of the initiator.
+	JoinRequested ParticipantEventType = "join_requested"
+	// RestrictedParticipant notifies that access of the participant to the chat
+	// is changed while the participant remains in the chat or out of it, e.g. muted or unmuted.
+	RestrictedParticipant ParticipantEventType = "restricted"
+	// ChatUpdated notifies the participant that settings of the chat are changed.
+	ChatUpdated ParticipantEventType = "chat_updated"
+	// ChatSettingsUpdated notifies devices of the user that personal settings
+	// of the chat are changed by one of them.
+	ChatSettingsUpdated ParticipantEventType = "chat_settings_updated"
+	// ChatFoldersUpdated notifies devices of the user that chat folders
+	// are changed by one of them, the event has no chat.
+	ChatFoldersUpdated ParticipantEventType = "chat_folders_updated"
 )
 
 type User struct {
@@ -56,25 +144,210 @@ type User struct {
 	LastName  string
 	BirthDate *time.Time
 	Bio       string
+	// EmailVerifiedAt is reset when the email is changed.
+	EmailVerifiedAt *time.Time
+	CreatedAt       time.Time
+}
+
+func (u User) IsEmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
+// EmailVerification is a token sent to the email of the user, the email is verified
+// when the token is confirmed. The token is only valid for the email it's sent to.
+type EmailVerification struct {
+	Token     string
+	UserID    int
+	Email     string
+	ExpiresAt time.Time
 	CreatedAt time.Time
 }
 
+func (ev EmailVerification) IsExpired(now time.Time) bool {
+	return !now.Before(ev.ExpiresAt)
+}
+
+// Mail is a plain text email message.
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// UserBlock means that the user has blocked another user account-wide,
+// so the blocked user can't write to the user, invite to groups or start dialogs.
+type UserBlock struct {
+	UserID        int
+	BlockedUserID int
+	CreatedAt     time.Time
+}
+
+// Contact is a user added to the contacts of another user. The contact is mutual
+// if the contact user has also added the user to their contacts.
+type Contact struct {
+	UserID        int
+	ContactUserID int
+	DisplayName   string
+	IsMutual      bool
+	CreatedAt     time.Time
+}
+
+type ContactImportStatus string
+
+func (cis ContactImportStatus) String() string {
+	return string(cis)
+}
+
+const (
+	AddedContactImportStatus         ContactImportStatus = "added"
+	AlreadyExistsContactImportStatus ContactImportStatus = "already_exists"
+	NotFoundContactImportStatus      ContactImportStatus = "not_found"
+	SelfContactImportStatus          ContactImportStatus = "self"
+)
+
+// ContactImportResult is an outcome of importing the user found by the username
+// or email into the contacts.
+type ContactImportResult struct {
+	Query  string
+	UserID int
+	Status ContactImportStatus
+}
+
 type Group struct {
 	ID          int
+	Uname       string
 	Name        string
 	Description string
 	CreatedAt   time.Time
 }
 
+// IsPublic reports whether anyone can join the group by its public handle.
+func (g Group) IsPublic() bool {
+	return g.Uname != ""
+}
+
+// GroupSettings restricts what participants below admins can do in the group.
+type GroupSettings struct {
+	OnlyAdminsCanPost bool
+	// SlowModeInterval is the minimal interval between messages of a participant,
+	// zero means slow mode is disabled.
+	SlowModeInterval time.Duration
+	// MembersCanInvite allows members to invite even though their role doesn't have the permission.
+	MembersCanInvite bool
+}
+
+type GroupInviteLink struct {
+	Token            string
+	GroupID          int
+	CreatorID        int
+	MaxUses          *int
+	Uses             int
+	RequiresApproval bool
+	ExpiresAt        *time.Time
+	RevokedAt        *time.Time
+	CreatedAt        time.Time
+}
+
+// IsActive reports whether somebody else can join the group by the link.
+func (l GroupInviteLink) IsActive(now time.Time) bool {
+	if l.RevokedAt != nil {
+		return false
+	}
+	if l.ExpiresAt != nil && !now.Before(*l.ExpiresAt) {
+		return false
+	}
+	return l.MaxUses == nil || l.Uses < *l.MaxUses
+}
+
 type GroupParticipant struct {
-	GroupID int
-	UserID  int
-	IsAdmin bool
-	Status  GroupParticipantStatus
+	GroupID  int
+	UserID   int
+	Role     GroupRole
+	Status   GroupParticipantStatus
+	JoinedAt time.Time
+	// RestrictedUntil is the time when the restriction is lifted,
+	// nil means the restriction is permanent.
+	RestrictedUntil *time.Time
 }
 
 func (p GroupParticipant) IsInGroup() bool {
-	return p.Status == JoinedStatus
+	return p.Status.IsInGroup()
+}
+
+func (p GroupParticipant) Can(permission GroupPermission) bool {
+	return MxRolePermissions.Has(p.Role, permission)
+}
+
+// IsAdmin reports whether the participant is an admin or the owner of the group.
+func (p GroupParticipant) IsAdmin() bool {
+	return !AdminRole.IsHigherThan(p.Role)
+}
+
+type GroupInviteStatus string
+
+func (gis GroupInviteStatus) String() string {
+	return string(gis)
+}
+
+const (
+	AddedInviteStatus           GroupInviteStatus = "added"
+	AlreadyExistsInviteStatus   GroupInviteStatus = "already_exists"
+	NonExistentUserInviteStatus GroupInviteStatus = "non_existent"
+	LimitReachedInviteStatus    GroupInviteStatus = "limit_reached"
+	BlockedInviteStatus         GroupInviteStatus = "blocked"
+)
+
+// GroupInviteResult is an outcome of inviting the user within a bulk invite.
+type GroupInviteResult struct {
+	UserID int
+	Status GroupInviteStatus
+}
+
+type GroupAuditAction string
+
+func (a GroupAuditAction) String() string {
+	return string(a)
+}
+
+const (
+	GroupUpdatedAuditAction             GroupAuditAction = "group_updated"
+	GroupDeletedAuditAction             GroupAuditAction = "group_deleted"
+	GroupRestoredAuditAction            GroupAuditAction = "group_restored"
+	GroupSettingsUpdatedAuditAction     GroupAuditAction = "settings_updated"
+	ParticipantInvitedAuditAction       GroupAuditAction = "participant_invited"
+	ParticipantStatusChangedAuditAction GroupAuditAction = "participant_status_changed"
+	ParticipantRoleChangedAuditAction   GroupAuditAction = "participant_role_changed"
+)
+
+// GroupAuditEntry is a record of an administrative action in the group.
+type GroupAuditEntry struct {
+	ID      int
+	GroupID int
+	ActorID int
+	// TargetUserID is set if the action is performed on a participant.
+	TargetUserID *int
+	Action       GroupAuditAction
+	// Before and After keep the changed values, one of them is nil
+	// if something is created or deleted.
+	Before    map[string]any
+	After     map[string]any
+	CreatedAt time.Time
+}
+
+type Channel struct {
+	ID          int
+	Uname       string
+	Name        string
+	Description string
+	CreatedAt   time.Time
+}
+
+type ChannelSubscriber struct {
+	ChannelID int
+	UserID    int
+	// IsAdmin is set for a subscriber who can post to the channel and manage it.
+	IsAdmin      bool
+	SubscribedAt time.Time
 }
 
 type DialogPartner struct {
@@ -85,28 +358,151 @@ type DialogPartner struct {
 type Dialog struct {
 	ID        int
 	IsBlocked bool
+	// IsRequest is set for the recipient of a message request which isn't accepted yet.
+	IsRequest bool
 	Partner   DialogPartner
 	CreatedAt time.Time
 }
 
+// DialogRequestStatus is the state of a dialog started by a user who isn't
+// in the contacts of the partner.
+type DialogRequestStatus string
+
+const (
+	PendingDialogRequestStatus  DialogRequestStatus = "pending"
+	AcceptedDialogRequestStatus DialogRequestStatus = "accepted"
+	DeclinedDialogRequestStatus DialogRequestStatus = "declined"
+	ReportedDialogRequestStatus DialogRequestStatus = "reported"
+)
+
+type DialogRequest struct {
+	DialogID    int
+	SenderID    int
+	RecipientID int
+	Status      DialogRequestStatus
+	CreatedAt   time.Time
+}
+
 type ChatID struct {
 	ID   int
 	Type ChatType
 }
 
-type ParticipantEvent struct {
-	Type   ParticipantEventType
+// ChatListItem is an entry of the unified list of dialogs and groups of the user.
+type ChatListItem struct {
 	ChatID ChatID
-	UserID int
+	// Name is set for groups only.
+	Name string
+	// PartnerUserID is set for dialogs only.
+	PartnerUserID int
+	// LastMessage is a preview of the last message, its content may be truncated.
+	LastMessage *Message
+	UnreadCount int
+	IsMuted     bool
+	// PinnedOrder is set for pinned chats only, see ChatSettings.
+	PinnedOrder    int
+	IsArchived     bool
+	CreatedAt      time.Time
+	LastActivityAt time.Time
 }
 
-type Message struct {
-	ID          int
+// ChatSettings are settings of a dialog or a group which are personal for the user.
+type ChatSettings struct {
+	ChatID     ChatID
+	MutedUntil *time.Time
+	// PinnedOrder is set for pinned chats only, the chat pinned later has the greater order
+	// and is listed before the others.
+	PinnedOrder *int
+	IsArchived  bool
+}
+
+// IsMuted reports whether notifications of the chat are muted at the moment.
+func (s ChatSettings) IsMuted(now time.Time) bool {
+	return s.MutedUntil != nil && s.MutedUntil.After(now)
+}
+
+// ChatFolder groups chats of the user by include and exclude rules. A chat is in the folder
+// if it's included explicitly or it matches the rules and it isn't excluded explicitly.
+type ChatFolder struct {
+	ID              int
+	UserID          int
+	Name            string
+	IncludeDialogs  bool
+	IncludeGroups   bool
+	ExcludeMuted    bool
+	ExcludeArchived bool
+	IncludedChats   []ChatID
+	ExcludedChats   []ChatID
+	CreatedAt       time.Time
+}
+
+type ParticipantEvent struct {
+	Type        ParticipantEventType
 	ChatID      ChatID
-	SenderID    int
-	Content     string
-	ContentType ContentType
-	IsService   bool
-	SentAt      time.Time
-	DeliveredAt *time.Time
+	UserID      int
+	InitiatorID int
+}
+
+type ServiceActionType string
+
+func (t ServiceActionType) String() string {
+	return string(t)
+}
+
+const (
+	ParticipantInvitedServiceAction   ServiceActionType = "participant_invited"
+	ParticipantJoinedServiceAction    ServiceActionType = "participant_joined"
+	ParticipantReturnedServiceAction  ServiceActionType = "participant_returned"
+	ParticipantLeftServiceAction      ServiceActionType = "participant_left"
+	ParticipantKickedServiceAction    ServiceActionType = "participant_kicked"
+	ParticipantMutedServiceAction     ServiceActionType = "participant_muted"
+	ParticipantUnmutedServiceAction   ServiceActionType = "participant_unmuted"
+	ParticipantBannedServiceAction    ServiceActionType = "participant_banned"
+	ParticipantUnbannedServiceAction  ServiceActionType = "participant_unbanned"
+	JoinRequestedServiceAction        ServiceActionType = "join_requested"
+	JoinRequestApprovedServiceAction  ServiceActionType = "join_request_approved"
+	OwnershipTransferredServiceAction ServiceActionType = "ownership_transferred"
+	OwnershipPassedServiceAction      ServiceActionType = "ownership_passed"
+	GroupRenamedServiceAction         ServiceActionType = "group_renamed"
+	GroupSettingChangedServiceAction  ServiceActionType = "group_setting_changed"
+	DialogBlockedServiceAction        ServiceActionType = "dialog_blocked"
+	DialogUnblockedServiceAction      ServiceActionType = "dialog_unblocked"
+	ChatSettingsChangedServiceAction  ServiceActionType = "chat_settings_changed"
+	ChatFoldersChangedServiceAction   ServiceActionType = "chat_folders_changed"
+)
+
+// Parameters of service actions. Values of parameters are always strings,
+// e.g. user identities are formatted in decimal.
+const (
+	UserIDServiceParam       = "user_id"
+	UserIDsServiceParam      = "user_ids"
+	UntilServiceParam        = "until"
+	NameServiceParam         = "name"
+	PrevNameServiceParam     = "prev_name"
+	SettingServiceParam      = "setting"
+	SettingValueServiceParam = "value"
+)
+
+// ServiceAction is a machine-readable description of the action
+// which a service message informs about. The sender of the message is the actor.
+type ServiceAction struct {
+	Type   ServiceActionType
+	Params map[string]string
+}
+
+type Message struct {
+	ID            int
+	ChatID        ChatID
+	SenderID      int
+	Content       string
+	ContentType   ContentType
+	IsService     bool
+	ServiceAction *ServiceAction
+	// ForwardedFromID is the author of the original message if the message is forwarded.
+	ForwardedFromID *int
+	SentAt          time.Time
+	DeliveredAt     *time.Time
+	// Muted is set on delivery of messages of chats muted by the recipient,
+	// so clients don't notify about them. It isn't stored.
+	Muted bool
 }
@@ -1,5 +1,7 @@
 package entity
 
+import "sort"
+
 type StatusSet map[GroupParticipantStatus]bool
 
 func newStatusSet(statuses ...GroupParticipantStatus) StatusSet {
@@ -25,11 +27,70 @@ func (mx StatusMatrix) IsCorrectTransit(from, to GroupParticipantStatus) bool {
 //nolint:exhaustive // these aren't enum switch statements
 var (
 	MxActionOnSomeone = StatusMatrix{
-		JoinedStatus: newStatusSet(KickedStatus),
-		KickedStatus: newStatusSet(JoinedStatus),
+		JoinedStatus:  newStatusSet(KickedStatus, MutedStatus, BannedStatus),
+		KickedStatus:  newStatusSet(JoinedStatus, BannedStatus),
+		LeftStatus:    newStatusSet(BannedStatus),
+		PendingStatus: newStatusSet(JoinedStatus, LeftStatus),
+		MutedStatus:   newStatusSet(JoinedStatus, MutedStatus, KickedStatus, BannedStatus),
+		BannedStatus:  newStatusSet(JoinedStatus, LeftStatus, BannedStatus),
 	}
+	// A muted participant can't leave, otherwise the restriction
+	// could be bypassed by joining the group again.
 	MxActionOnOneself = StatusMatrix{
-		JoinedStatus: newStatusSet(LeftStatus),
-		LeftStatus:   newStatusSet(JoinedStatus),
+		JoinedStatus:  newStatusSet(LeftStatus),
+		LeftStatus:    newStatusSet(JoinedStatus),
+		PendingStatus: newStatusSet(LeftStatus),
 	}
 )
+
+type PermissionSet map[GroupPermission]bool
+
+func newPermissionSet(permissions ...GroupPermission) PermissionSet {
+	set := make(PermissionSet, len(permissions))
+	for _, permission := range permissions {
+		set[permission] = true
+	}
+
+	return set
+}
+
+type PermissionMatrix map[GroupRole]PermissionSet
+
+func (mx PermissionMatrix) Has(role GroupRole, permission GroupPermission) bool {
+	set, ok := mx[role]
+	if !ok {
+		return false
+	}
+
+	return set[permission]
+}
+
+// RolesWith returns all roles having the permission.
+func (mx PermissionMatrix) RolesWith(permission GroupPermission) []GroupRole {
+	var roles []GroupRole
+	for role, set := range mx {
+		if set[permission] {
+			roles = append(roles, role)
+		}
+	}
+
+	sort.Slice(roles, func(i, j int) bool {
+		return roles[i].IsHigherThan(roles[j])
+	})
+	return roles
+}
+
+var MxRolePermissions = PermissionMatrix{
+	OwnerRole: newPermissionSet(
+		InvitePermission, KickPermission, EditInfoPermission, PinPermission,
+		DeleteMessagesPermission, PromotePermission, DeleteGroupPermission,
+	),
+	AdminRole: newPermissionSet(
+		InvitePermission, KickPermission, EditInfoPermission, PinPermission,
+		DeleteMessagesPermission, PromotePermission,
+	),
+	ModeratorRole: newPermissionSet(
+		InvitePermission, KickPermission, PinPermission, DeleteMessagesPermission,
+	),
+	MemberRole: newPermissionSet(),
+}
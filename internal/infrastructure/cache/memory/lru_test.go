@@ -0,0 +1,46 @@
+package memory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLRU(t *testing.T) {
+	cache := NewLRU[string, int](2, time.Minute)
+
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+
+	// "a" becomes the most recently used one, so "b" is evicted.
+	value, ok := cache.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+
+	cache.Set("c", 3)
+	_, ok = cache.Get("b")
+	assert.False(t, ok)
+	assert.Equal(t, 2, cache.Len())
+
+	cache.Set("a", 10)
+	value, ok = cache.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 10, value)
+
+	cache.Delete("a")
+	_, ok = cache.Get("a")
+	assert.False(t, ok)
+	assert.Equal(t, 1, cache.Len())
+}
+
+func TestLRU_Expiration(t *testing.T) {
+	cache := NewLRU[string, int](2, time.Millisecond)
+
+	cache.Set("a", 1)
+	time.Sleep(5 * time.Millisecond)
+
+	_, ok := cache.Get("a")
+	assert.False(t, ok)
+	assert.Equal(t, 0, cache.Len())
+}
@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/internal/infrastructure/cache/memory"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// GroupSettingsCache caches settings of groups in the process memory, so posting
+// to a group doesn't query them every time. Cached settings are invalidated
+// by chat-updated events, the TTL only protects from missed ones.
+type GroupSettingsCache struct {
+	pool  *pgxpool.Pool
+	local *memory.LRU[int, entity.GroupSettings]
+
+	// generation is bumped on every invalidation, so settings which were
+	// queried concurrently with it aren't cached, since they may be stale.
+	generation atomic.Uint64
+}
+
+func NewGroupSettingsCache(pool *pgxpool.Pool, size int, ttl time.Duration) *GroupSettingsCache {
+	if size == 0 {
+		size = defaultParticipantCheckerSize
+	}
+	if ttl == 0 {
+		ttl = defaultParticipantCheckerTTL
+	}
+
+	return &GroupSettingsCache{
+		pool:  pool,
+		local: memory.NewLRU[int, entity.GroupSettings](size, ttl),
+	}
+}
+
+// GetSettings gets settings of the group. It doesn't check whether the current user
+// participates in the group, so it must be checked before.
+func (c *GroupSettingsCache) GetSettings(ctx context.Context, groupID int) (entity.GroupSettings, error) {
+	if settings, ok := c.local.Get(groupID); ok {
+		return settings, nil
+	}
+
+	generation := c.generation.Load()
+
+	settings, err := c.query(ctx, groupID)
+	if err != nil {
+		return entity.GroupSettings{}, err
+	}
+
+	if generation == c.generation.Load() {
+		c.local.Set(groupID, settings)
+	}
+	return settings, nil
+}
+
+// Invalidate drops the cached settings of the group.
+func (c *GroupSettingsCache) Invalidate(groupID int) {
+	c.generation.Add(1)
+	c.local.Delete(groupID)
+}
+
+func (c *GroupSettingsCache) query(ctx context.Context, groupID int) (entity.GroupSettings, error) {
+	var (
+		settings         entity.GroupSettings
+		slowModeInterval int
+	)
+
+	query := `SELECT only_admins_can_post, slow_mode_interval, members_can_invite
+	FROM chats
+	WHERE id = $1 AND type = 'group' AND deleted_at IS NULL`
+
+	err := c.pool.QueryRow(ctx, query, groupID).Scan(
+		&settings.OnlyAdminsCanPost, &slowModeInterval, &settings.MembersCanInvite,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return settings, fmt.Errorf("%w: %v", entity.ErrGroupNotFound, err)
+		}
+
+		return settings, fmt.Errorf("exec query to select group settings: %v", err)
+	}
+
+	settings.SlowModeInterval = time.Duration(slowModeInterval) * time.Second
+	return settings, nil
+}
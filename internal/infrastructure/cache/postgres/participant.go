@@ -3,8 +3,13 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/internal/infrastructure/cache/memory"
+	"github.com/Chatyx/backend/pkg/log"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -12,41 +17,395 @@ import (
 
 var builder = sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 
+const (
+	defaultParticipantCheckerSize = 10000
+	defaultParticipantCheckerTTL  = time.Minute
+)
+
+type ParticipantCacheStorage interface {
+	Get(ctx context.Context, chatID entity.ChatID, userID int) (access entity.ChatAccess, found bool, err error)
+	Set(ctx context.Context, chatID entity.ChatID, userID int, access entity.ChatAccess) error
+	Delete(ctx context.Context, chatID entity.ChatID, userID int) error
+}
+
+type ParticipantEventConsumer interface {
+	BeginConsumeAll(ctx context.Context) (<-chan entity.ParticipantEvent, <-chan error)
+}
+
+type ParticipantCheckerConfig struct {
+	Size          int
+	TTL           time.Duration
+	Storage       ParticipantCacheStorage // optional shared cache, e.g. redis
+	Lister        *ParticipantLister      // optional, invalidated by the same events
+	GroupSettings *GroupSettingsCache     // optional, invalidated by chat-updated events
+	EventConsumer ParticipantEventConsumer
+}
+
+type ParticipantCheckerStats struct {
+	Hits   int64 `json:"hits"`
+	Misses int64 `json:"misses"`
+}
+
+type participantKey struct {
+	chatID entity.ChatID
+	userID int
+}
+
+// ParticipantChecker caches access levels of participants to chats in the process memory
+// and, optionally, in the shared storage. Cached results are invalidated by
+// participant events, the TTL only protects from missed ones.
 type ParticipantChecker struct {
-	pool *pgxpool.Pool
+	pool    *pgxpool.Pool
+	local   *memory.LRU[participantKey, entity.ChatAccess]
+	storage ParticipantCacheStorage
+	lister  *ParticipantLister
+	groups  *GroupSettingsCache
+	cons    ParticipantEventConsumer
+
+	hits   atomic.Int64
+	misses atomic.Int64
+
+	// generation is bumped on every invalidation, so results which were
+	// queried concurrently with it aren't cached, since they may be stale.
+	generation atomic.Uint64
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
 }
 
-func NewParticipantChecker(pool *pgxpool.Pool) *ParticipantChecker {
-	return &ParticipantChecker{pool: pool}
+func NewParticipantChecker(pool *pgxpool.Pool, conf ParticipantCheckerConfig) *ParticipantChecker {
+	if conf.Size == 0 {
+		conf.Size = defaultParticipantCheckerSize
+	}
+	if conf.TTL == 0 {
+		conf.TTL = defaultParticipantCheckerTTL
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &ParticipantChecker{
+		pool:    pool,
+		local:   memory.NewLRU[participantKey, entity.ChatAccess](conf.Size, conf.TTL),
+		storage: conf.Storage,
+		lister:  conf.Lister,
+		groups:  conf.GroupSettings,
+		cons:    conf.EventConsumer,
+		ctx:     ctx,
+		cancel:  cancel,
+	}
 }
 
-func (c ParticipantChecker) Check(ctx context.Context, chatID entity.ChatID, userID int) error {
-	b := builder.Select("1").
-		Prefix("SELECT EXISTS (").
-		Suffix(")")
-	if chatID.Type == entity.GroupChatType {
-		b = b.From("group_participants").Where(sq.Eq{"status": entity.JoinedStatus})
-	} else {
-		b = b.From("dialog_participants").Where(sq.Eq{"is_blocked": false})
+func (c *ParticipantChecker) Run() {
+	c.wg.Add(1)
+	go c.invalidate()
+}
+
+func (c *ParticipantChecker) Close() error {
+	c.cancel()
+	c.wg.Wait()
+	return nil
+}
+
+func (c *ParticipantChecker) Stats() ParticipantCheckerStats {
+	return ParticipantCheckerStats{
+		Hits:   c.hits.Load(),
+		Misses: c.misses.Load(),
+	}
+}
+
+// Check checks whether the user can read the chat.
+func (c *ParticipantChecker) Check(ctx context.Context, chatID entity.ChatID, userID int) error {
+	access, err := c.access(ctx, chatID, userID)
+	if err != nil {
+		return err
 	}
 
-	query, args, err := b.Where(sq.Eq{"chat_id": chatID.ID}).
+	if access == entity.NoChatAccess {
+		return chatNotFoundError(chatID)
+	}
+	return nil
+}
+
+// CheckWrite checks whether the user can post to the chat.
+func (c *ParticipantChecker) CheckWrite(ctx context.Context, chatID entity.ChatID, userID int) error {
+	access, err := c.access(ctx, chatID, userID)
+	if err != nil {
+		return err
+	}
+
+	switch access {
+	case entity.NoChatAccess:
+		return chatNotFoundError(chatID)
+	case entity.ReadChatAccess:
+		if chatID.Type == entity.ChannelChatType {
+			return fmt.Errorf("%w: only admins can post to the channel", entity.ErrForbiddenPerformAction)
+		}
+		if chatID.Type == entity.DialogChatType {
+			return fmt.Errorf("%w: the message request is declined", entity.ErrForbiddenPerformAction)
+		}
+		return entity.ErrRestrictedGroupParticipant
+	}
+	return nil
+}
+
+func chatNotFoundError(chatID entity.ChatID) error {
+	switch chatID.Type {
+	case entity.GroupChatType:
+		return entity.ErrGroupNotFound
+	case entity.ChannelChatType:
+		return entity.ErrChannelNotFound
+	}
+	return entity.ErrDialogNotFound
+}
+
+func (c *ParticipantChecker) access(ctx context.Context, chatID entity.ChatID, userID int) (entity.ChatAccess, error) {
+	key := participantKey{chatID: chatID, userID: userID}
+	if access, ok := c.local.Get(key); ok {
+		c.hits.Add(1)
+		return access, nil
+	}
+
+	logger := log.FromContext(ctx)
+
+	if c.storage != nil {
+		access, found, err := c.storage.Get(ctx, chatID, userID)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to get participant from the cache storage")
+		}
+		if found {
+			c.hits.Add(1)
+			c.local.Set(key, access)
+			return access, nil
+		}
+	}
+
+	c.misses.Add(1)
+
+	generation := c.generation.Load()
+
+	access, err := c.query(ctx, chatID, userID)
+	if err != nil {
+		return entity.NoChatAccess, err
+	}
+
+	if generation != c.generation.Load() {
+		return access, nil
+	}
+
+	c.local.Set(key, access)
+	if c.storage != nil {
+		if err = c.storage.Set(ctx, chatID, userID, access); err != nil {
+			logger.WithError(err).Warn("Failed to set participant to the cache storage")
+		}
+	}
+
+	return access, nil
+}
+
+var (
+	groupAccessColumn = fmt.Sprintf(
+		"CASE status WHEN '%s' THEN %d WHEN '%s' THEN %d ELSE %d END",
+		entity.JoinedStatus, entity.WriteChatAccess,
+		entity.MutedStatus, entity.ReadChatAccess,
+		entity.NoChatAccess,
+	)
+	// Senders of declined message requests can only read the dialog.
+	dialogAccessColumn = fmt.Sprintf(
+		"CASE WHEN is_blocked THEN %d "+
+			"WHEN EXISTS (SELECT 1 FROM dialog_requests dr WHERE dr.chat_id = dialog_participants.chat_id "+
+			"AND dr.sender_id = dialog_participants.user_id AND dr.status IN ('%s', '%s')) THEN %d "+
+			"ELSE %d END",
+		entity.NoChatAccess,
+		entity.DeclinedDialogRequestStatus, entity.ReportedDialogRequestStatus, entity.ReadChatAccess,
+		entity.WriteChatAccess,
+	)
+	channelAccessColumn = fmt.Sprintf(
+		"CASE WHEN is_admin THEN %d ELSE %d END",
+		entity.WriteChatAccess, entity.ReadChatAccess,
+	)
+)
+
+func (c *ParticipantChecker) query(ctx context.Context, chatID entity.ChatID, userID int) (entity.ChatAccess, error) {
+	var b sq.SelectBuilder
+	switch chatID.Type {
+	case entity.GroupChatType:
+		b = builder.Select(groupAccessColumn).From("group_participants").
+			Where("NOT EXISTS (SELECT 1 FROM chats WHERE id = group_participants.chat_id AND deleted_at IS NOT NULL)")
+	case entity.ChannelChatType:
+		b = builder.Select(channelAccessColumn).From("channel_subscribers")
+	default:
+		b = builder.Select(dialogAccessColumn).From("dialog_participants")
+	}
+
+	query, args, err := b.Prefix("SELECT COALESCE((").
+		Where(sq.Eq{"chat_id": chatID.ID}).
 		Where(sq.Eq{"user_id": userID}).
+		Suffix(fmt.Sprintf("), %d)", entity.NoChatAccess)).
 		ToSql()
 	if err != nil {
-		return fmt.Errorf("build query to check existance of participant: %v", err)
+		return entity.NoChatAccess, fmt.Errorf("build query to check access of participant: %v", err)
 	}
 
-	var exist bool
-	if err = c.pool.QueryRow(ctx, query, args...).Scan(&exist); err != nil {
-		return fmt.Errorf("scan existance of participant result: %v", err)
+	var access entity.ChatAccess
+	if err = c.pool.QueryRow(ctx, query, args...).Scan(&access); err != nil {
+		return entity.NoChatAccess, fmt.Errorf("scan access of participant result: %v", err)
 	}
+	return access, nil
+}
 
-	if !exist {
-		if chatID.Type == entity.GroupChatType {
-			return entity.ErrGroupNotFound
+func (c *ParticipantChecker) invalidate() {
+	defer c.wg.Done()
+
+	eventCh, errCh := c.cons.BeginConsumeAll(c.ctx)
+	for {
+		select {
+		case event, ok := <-eventCh:
+			if !ok {
+				return
+			}
+
+			c.generation.Add(1)
+			c.local.Delete(participantKey{chatID: event.ChatID, userID: event.UserID})
+			if c.lister != nil {
+				c.lister.Invalidate(event.ChatID)
+			}
+			if c.groups != nil && event.Type == entity.ChatUpdated && event.ChatID.Type == entity.GroupChatType {
+				c.groups.Invalidate(event.ChatID.ID)
+			}
+			if c.storage == nil {
+				continue
+			}
+
+			if err := c.storage.Delete(c.ctx, event.ChatID, event.UserID); err != nil {
+				log.WithError(err).Warn("Failed to delete participant from the cache storage")
+			}
+		case err, ok := <-errCh:
+			if !ok {
+				return
+			}
+
+			log.WithError(err).Error("Failed to consume participant event")
 		}
-		return entity.ErrDialogNotFound
 	}
-	return nil
+}
+
+const defaultParticipantListTTL = 10 * time.Second
+
+type participantListEntry struct {
+	userIDs   []int
+	expiresAt time.Time
+}
+
+// ParticipantLister caches active chat participants for a short time,
+// so publishing a burst of messages doesn't query postgres every time.
+type ParticipantLister struct {
+	pool *pgxpool.Pool
+	ttl  time.Duration
+
+	mu      sync.RWMutex
+	entries map[entity.ChatID]participantListEntry
+
+	// generation is bumped on every invalidation, so lists which were
+	// queried concurrently with it aren't cached, since they may be stale.
+	generation atomic.Uint64
+}
+
+func NewParticipantLister(pool *pgxpool.Pool, ttl time.Duration) *ParticipantLister {
+	if ttl == 0 {
+		ttl = defaultParticipantListTTL
+	}
+
+	return &ParticipantLister{
+		pool:    pool,
+		ttl:     ttl,
+		entries: make(map[entity.ChatID]participantListEntry),
+	}
+}
+
+func (l *ParticipantLister) ListActiveUserIDs(ctx context.Context, chatID entity.ChatID) ([]int, error) {
+	l.mu.RLock()
+	entry, ok := l.entries[chatID]
+	l.mu.RUnlock()
+
+	if ok && time.Now().Before(entry.expiresAt) {
+		return entry.userIDs, nil
+	}
+
+	generation := l.generation.Load()
+
+	userIDs, err := l.list(ctx, chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if generation != l.generation.Load() {
+		return userIDs, nil
+	}
+
+	now := time.Now()
+	for id, e := range l.entries {
+		if now.After(e.expiresAt) {
+			delete(l.entries, id)
+		}
+	}
+
+	l.entries[chatID] = participantListEntry{
+		userIDs:   userIDs,
+		expiresAt: now.Add(l.ttl),
+	}
+	return userIDs, nil
+}
+
+// Invalidate drops the cached participants of the chat.
+func (l *ParticipantLister) Invalidate(chatID entity.ChatID) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.generation.Add(1)
+	delete(l.entries, chatID)
+}
+
+func (l *ParticipantLister) list(ctx context.Context, chatID entity.ChatID) ([]int, error) {
+	b := builder.Select("user_id")
+	switch chatID.Type {
+	case entity.GroupChatType:
+		b = b.From("group_participants").Where(sq.Eq{"status": []entity.GroupParticipantStatus{
+			entity.JoinedStatus, entity.MutedStatus,
+		}})
+	case entity.ChannelChatType:
+		b = b.From("channel_subscribers")
+	default:
+		b = b.From("dialog_participants").Where(sq.Eq{"is_blocked": false})
+	}
+
+	query, args, err := b.Where(sq.Eq{"chat_id": chatID.ID}).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query to select active participants: %v", err)
+	}
+
+	rows, err := l.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec query to select active participants: %v", err)
+	}
+	defer rows.Close()
+
+	var userIDs []int
+
+	for rows.Next() {
+		var userID int
+		if err = rows.Scan(&userID); err != nil {
+			return nil, fmt.Errorf("scan active participant row: %v", err)
+		}
+
+		userIDs = append(userIDs, userID)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("reading active participant rows: %v", err)
+	}
+	return userIDs, nil
 }
@@ -0,0 +1,54 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Chatyx/backend/internal/entity"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ParticipantStorage shares access levels of participants between application nodes.
+type ParticipantStorage struct {
+	cli *redis.Client
+	ttl time.Duration
+}
+
+func NewParticipantStorage(cli *redis.Client, ttl time.Duration) *ParticipantStorage {
+	return &ParticipantStorage{
+		cli: cli,
+		ttl: ttl,
+	}
+}
+
+func (s *ParticipantStorage) Get(ctx context.Context, chatID entity.ChatID, userID int) (access entity.ChatAccess, found bool, err error) {
+	value, err := s.cli.Get(ctx, participantKey(chatID, userID)).Int()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return entity.NoChatAccess, false, nil
+		}
+		return entity.NoChatAccess, false, fmt.Errorf("get participant: %v", err)
+	}
+	return entity.ChatAccess(value), true, nil
+}
+
+func (s *ParticipantStorage) Set(ctx context.Context, chatID entity.ChatID, userID int, access entity.ChatAccess) error {
+	if err := s.cli.Set(ctx, participantKey(chatID, userID), int(access), s.ttl).Err(); err != nil {
+		return fmt.Errorf("set participant: %v", err)
+	}
+	return nil
+}
+
+func (s *ParticipantStorage) Delete(ctx context.Context, chatID entity.ChatID, userID int) error {
+	if err := s.cli.Del(ctx, participantKey(chatID, userID)).Err(); err != nil {
+		return fmt.Errorf("delete participant: %v", err)
+	}
+	return nil
+}
+
+func participantKey(chatID entity.ChatID, userID int) string {
+	return fmt.Sprintf("participant_access:%s:%d:%d", chatID.Type, chatID.ID, userID)
+}
@@ -0,0 +1,51 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/Chatyx/backend/internal/config"
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/internal/infrastructure/mail"
+	"github.com/Chatyx/backend/pkg/log"
+)
+
+// Mailer is used for local development, it writes mails to files
+// of the directory or logs them if the directory isn't specified.
+type Mailer struct {
+	dir  string
+	from string
+}
+
+func NewMailer(conf config.Mail) (*Mailer, error) {
+	if conf.Dir != "" {
+		if err := os.MkdirAll(conf.Dir, 0o755); err != nil {
+			return nil, fmt.Errorf("create mail directory: %v", err)
+		}
+	}
+
+	return &Mailer{
+		dir:  conf.Dir,
+		from: conf.From,
+	}, nil
+}
+
+func (m *Mailer) Send(ctx context.Context, msg entity.Mail) error {
+	logger := log.FromContext(ctx).With("to", msg.To, "subject", msg.Subject)
+	if m.dir == "" {
+		logger.Infof("Mail is sent:\n%s", msg.Body)
+		return nil
+	}
+
+	path := filepath.Join(m.dir, strconv.FormatInt(time.Now().UnixNano(), 10)+".eml")
+	if err := os.WriteFile(path, mail.Message(m.from, msg), 0o600); err != nil {
+		return fmt.Errorf("write mail to file: %v", err)
+	}
+
+	logger.Infof("Mail is written to %s", path)
+	return nil
+}
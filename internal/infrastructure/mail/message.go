@@ -0,0 +1,27 @@
+package mail
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/Chatyx/backend/internal/entity"
+)
+
+var headerReplacer = strings.NewReplacer("\r", "", "\n", "")
+
+// Message formats the mail as a plain text message of RFC 5322.
+// Line breaks are stripped from the headers, so they can't be injected.
+func Message(from string, mail entity.Mail) []byte {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "From: %s\r\n", headerReplacer.Replace(from))
+	fmt.Fprintf(&buf, "To: %s\r\n", headerReplacer.Replace(mail.To))
+	fmt.Fprintf(&buf, "Subject: %s\r\n", headerReplacer.Replace(mail.Subject))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.ReplaceAll(mail.Body, "\n", "\r\n"))
+
+	return buf.Bytes()
+}
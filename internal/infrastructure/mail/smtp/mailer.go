@@ -0,0 +1,111 @@
+package smtp
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"time"
+
+	"github.com/Chatyx/backend/internal/config"
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/internal/infrastructure/mail"
+)
+
+const defaultTimeout = 10 * time.Second
+
+// Mailer sends mails through the SMTP server, the connection is upgraded
+// with STARTTLS if the server supports it. The whole session with the server
+// is limited by the timeout, so a stuck server doesn't hang the caller.
+type Mailer struct {
+	addr    string
+	host    string
+	from    string
+	auth    smtp.Auth
+	timeout time.Duration
+}
+
+func NewMailer(conf config.Mail) *Mailer {
+	m := &Mailer{
+		addr:    net.JoinHostPort(conf.SMTP.Host, conf.SMTP.Port),
+		host:    conf.SMTP.Host,
+		from:    conf.From,
+		timeout: conf.SMTP.Timeout,
+	}
+	if conf.SMTP.Username != "" {
+		m.auth = smtp.PlainAuth("", conf.SMTP.Username, conf.SMTP.Password, conf.SMTP.Host)
+	}
+	if m.timeout == 0 {
+		m.timeout = defaultTimeout
+	}
+
+	return m
+}
+
+func (m *Mailer) Send(ctx context.Context, msg entity.Mail) error {
+	if err := m.send(ctx, msg); err != nil {
+		return fmt.Errorf("send mail via smtp: %v", err)
+	}
+	return nil
+}
+
+func (m *Mailer) send(ctx context.Context, msg entity.Mail) error {
+	ctx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, "tcp", m.addr)
+	if err != nil {
+		return fmt.Errorf("dial: %v", err)
+	}
+	defer conn.Close()
+
+	deadline, _ := ctx.Deadline()
+	if err = conn.SetDeadline(deadline); err != nil {
+		return fmt.Errorf("set deadline: %v", err)
+	}
+
+	client, err := smtp.NewClient(conn, m.host)
+	if err != nil {
+		return fmt.Errorf("create client: %v", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err = client.StartTLS(&tls.Config{ServerName: m.host, MinVersion: tls.VersionTLS12}); err != nil {
+			return fmt.Errorf("start tls: %v", err)
+		}
+	}
+
+	if m.auth != nil {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return errors.New("server doesn't support AUTH")
+		}
+		if err = client.Auth(m.auth); err != nil {
+			return fmt.Errorf("auth: %v", err)
+		}
+	}
+
+	if err = client.Mail(m.from); err != nil {
+		return fmt.Errorf("mail: %v", err)
+	}
+	if err = client.Rcpt(msg.To); err != nil {
+		return fmt.Errorf("rcpt: %v", err)
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("data: %v", err)
+	}
+	if _, err = w.Write(mail.Message(m.from, msg)); err != nil {
+		return fmt.Errorf("write message: %v", err)
+	}
+	if err = w.Close(); err != nil {
+		return fmt.Errorf("close message: %v", err)
+	}
+
+	return client.Quit()
+}
@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/Chatyx/backend/internal/dto"
+	"github.com/Chatyx/backend/internal/entity"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type GroupAuditLogRepository struct {
+	pool   *pgxpool.Pool
+	getter dbClientGetter
+}
+
+func NewGroupAuditLogRepository(pool *pgxpool.Pool) *GroupAuditLogRepository {
+	return &GroupAuditLogRepository{
+		pool:   pool,
+		getter: dbClientGetter{pool: pool},
+	}
+}
+
+func (r *GroupAuditLogRepository) List(ctx context.Context, obj dto.GroupAuditLogList) ([]entity.GroupAuditEntry, error) {
+	b := builder.Select("id", "chat_id", "actor_id", "target_user_id",
+		"action", "before", "after", "created_at").
+		From("group_audit_log").
+		Where(sq.Eq{"chat_id": obj.GroupID})
+
+	if obj.Action != "" {
+		b = b.Where(sq.Eq{"action": obj.Action})
+	}
+	if obj.ActorID != 0 {
+		b = b.Where(sq.Eq{"actor_id": obj.ActorID})
+	}
+	if obj.TargetUserID != 0 {
+		b = b.Where(sq.Eq{"target_user_id": obj.TargetUserID})
+	}
+
+	if obj.Sort == dto.DescSort {
+		if obj.IDAfter == 0 {
+			obj.IDAfter = math.MaxInt64
+		}
+		b = b.Where(sq.Lt{"id": obj.IDAfter}).OrderBy("id DESC")
+	} else {
+		b = b.Where(sq.Gt{"id": obj.IDAfter}).OrderBy("id ASC")
+	}
+
+	query, args, err := b.Limit(uint64(obj.Limit)).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build select group audit log query: %v", err)
+	}
+
+	rows, err := r.getter.Get(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec query to select group audit log: %v", err)
+	}
+	defer rows.Close()
+
+	var entries []entity.GroupAuditEntry
+
+	for rows.Next() {
+		var entry entity.GroupAuditEntry
+
+		err = rows.Scan(
+			&entry.ID, &entry.GroupID, &entry.ActorID, &entry.TargetUserID,
+			&entry.Action, &entry.Before, &entry.After, &entry.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scan group audit entry row: %v", err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("reading group audit entry rows: %v", err)
+	}
+	return entries, nil
+}
+
+func (r *GroupAuditLogRepository) Create(ctx context.Context, entry *entity.GroupAuditEntry) error {
+	query := `INSERT INTO group_audit_log
+		(chat_id, actor_id, target_user_id, action, before, after, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+	RETURNING id`
+
+	err := r.getter.Get(ctx).QueryRow(ctx, query,
+		entry.GroupID, entry.ActorID, entry.TargetUserID,
+		entry.Action, entry.Before, entry.After, entry.CreatedAt,
+	).Scan(&entry.ID)
+	if err != nil {
+		return fmt.Errorf("exec query to insert group audit entry: %v", err)
+	}
+	return nil
+}
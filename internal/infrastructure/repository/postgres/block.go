@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Chatyx/backend/internal/entity"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type UserBlockRepository struct {
+	pool   *pgxpool.Pool
+	getter dbClientGetter
+}
+
+func NewUserBlockRepository(pool *pgxpool.Pool) *UserBlockRepository {
+	return &UserBlockRepository{
+		pool:   pool,
+		getter: dbClientGetter{pool: pool},
+	}
+}
+
+func (r *UserBlockRepository) List(ctx context.Context, userID int) ([]entity.UserBlock, error) {
+	query := `SELECT user_id, blocked_user_id, created_at
+	FROM user_blocks
+	WHERE user_id = $1
+	ORDER BY created_at DESC`
+
+	rows, err := r.getter.Get(ctx).Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("exec query to select user blocks: %v", err)
+	}
+	defer rows.Close()
+
+	var blocks []entity.UserBlock
+
+	for rows.Next() {
+		var block entity.UserBlock
+
+		if err = rows.Scan(&block.UserID, &block.BlockedUserID, &block.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan user block row: %v", err)
+		}
+
+		blocks = append(blocks, block)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("reading user block rows: %v", err)
+	}
+	return blocks, nil
+}
+
+func (r *UserBlockRepository) Create(ctx context.Context, block *entity.UserBlock) error {
+	query := `INSERT INTO user_blocks
+		(user_id, blocked_user_id, created_at)
+	VALUES ($1, $2, $3)`
+
+	_, err := r.getter.Get(ctx).Exec(ctx, query, block.UserID, block.BlockedUserID, block.CreatedAt)
+	if err != nil {
+		pgErr := &pgconn.PgError{}
+		if errors.As(err, &pgErr) {
+			if isUserBlockUniqueViolation(pgErr) {
+				return fmt.Errorf("%w: %v", entity.ErrSuchUserBlockAlreadyExists, pgErr.Message)
+			}
+			if isUserBlockBlockedUserViolation(pgErr) {
+				return fmt.Errorf("%w: %v", entity.ErrBlockNonExistentUser, pgErr.Message)
+			}
+		}
+
+		return fmt.Errorf("exec query to insert user block: %v", err)
+	}
+	return nil
+}
+
+func (r *UserBlockRepository) Delete(ctx context.Context, userID, blockedUserID int) error {
+	query := `DELETE FROM user_blocks
+	WHERE user_id = $1
+	  AND blocked_user_id = $2`
+
+	execRes, err := r.getter.Get(ctx).Exec(ctx, query, userID, blockedUserID)
+	if err != nil {
+		return fmt.Errorf("exec query to delete user block: %v", err)
+	}
+
+	if execRes.RowsAffected() == 0 {
+		return fmt.Errorf("%w: there aren't affected rows", entity.ErrUserBlockNotFound)
+	}
+	return nil
+}
+
+// Exists reports whether the user has blocked another user.
+func (r *UserBlockRepository) Exists(ctx context.Context, userID, blockedUserID int) (bool, error) {
+	query := `SELECT EXISTS(
+		SELECT 1 FROM user_blocks
+		WHERE user_id = $1
+		  AND blocked_user_id = $2)`
+
+	var exists bool
+	if err := r.getter.Get(ctx).QueryRow(ctx, query, userID, blockedUserID).Scan(&exists); err != nil {
+		return false, fmt.Errorf("exec query to check user block: %v", err)
+	}
+	return exists, nil
+}
+
+func isUserBlockUniqueViolation(pgErr *pgconn.PgError) bool {
+	return pgErr.Code == uniqueViolationCode && pgErr.ConstraintName == "user_blocks_pkey"
+}
+
+func isUserBlockBlockedUserViolation(pgErr *pgconn.PgError) bool {
+	return pgErr.Code == foreignKeyViolationCode && pgErr.ConstraintName == "user_blocks_blocked_user_id_fkey"
+}
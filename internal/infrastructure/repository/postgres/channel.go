@@ -0,0 +1,215 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/ctxutil"
+	"github.com/Chatyx/backend/pkg/log"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ChannelRepository struct {
+	pool   *pgxpool.Pool
+	getter dbClientGetter
+}
+
+func NewChannelRepository(pool *pgxpool.Pool) *ChannelRepository {
+	return &ChannelRepository{
+		pool:   pool,
+		getter: dbClientGetter{pool: pool},
+	}
+}
+
+// List lists channels the current user is subscribed to.
+func (r *ChannelRepository) List(ctx context.Context) ([]entity.Channel, error) {
+	userID := ctxutil.UserIDFromContext(ctx).ToInt()
+	query := `SELECT c.id,
+		COALESCE(c.uname, ''),
+		c.name,
+		c.description,
+		c.created_at
+	FROM chats c
+		INNER JOIN channel_subscribers cs
+			ON c.id = cs.chat_id
+	WHERE cs.user_id = $1 AND c.type = 'channel'`
+
+	rows, err := r.getter.Get(ctx).Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("exec query to select channels: %v", err)
+	}
+	defer rows.Close()
+
+	var channels []entity.Channel
+
+	for rows.Next() {
+		var channel entity.Channel
+
+		err = rows.Scan(
+			&channel.ID, &channel.Uname, &channel.Name,
+			&channel.Description, &channel.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scan channel row: %v", err)
+		}
+
+		channels = append(channels, channel)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("reading channel rows: %v", err)
+	}
+	return channels, nil
+}
+
+// Create creates a channel and subscribes the current user to it as an admin.
+func (r *ChannelRepository) Create(ctx context.Context, channel *entity.Channel) error {
+	var (
+		hasExternalTx bool
+		err           error
+	)
+
+	tx, ok := r.getter.Get(ctx).(pgx.Tx)
+	if ok {
+		hasExternalTx = true
+	}
+
+	if !hasExternalTx {
+		tx, err = r.pool.Begin(ctx)
+		if err != nil {
+			return fmt.Errorf("begin transaction: %v", err)
+		}
+		defer func() {
+			if err = tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+				log.FromContext(ctx).WithError(err).Error("Rollback transaction")
+			}
+		}()
+	}
+
+	query := `INSERT INTO chats
+		(uname, name, type, description, created_at)
+	VALUES (NULLIF($1, ''), $2, $3, $4, $5)
+	RETURNING id`
+
+	err = tx.QueryRow(ctx, query,
+		channel.Uname, channel.Name, entity.ChannelChatType,
+		channel.Description, channel.CreatedAt,
+	).Scan(&channel.ID)
+	if err != nil {
+		pgErr := &pgconn.PgError{}
+		if errors.As(err, &pgErr) && isChatUnameUniqueViolation(pgErr) {
+			return fmt.Errorf("%w: %s", entity.ErrSuchChannelUnameAlreadyExists, pgErr.Message)
+		}
+
+		return fmt.Errorf("exec query to insert channel: %v", err)
+	}
+
+	userID := ctxutil.UserIDFromContext(ctx).ToInt()
+	query = `INSERT INTO channel_subscribers
+		(chat_id, user_id, is_admin)
+	VALUES ($1, $2, TRUE)`
+
+	if _, err = tx.Exec(ctx, query, channel.ID, userID); err != nil {
+		return fmt.Errorf("exec query to insert channel subscriber: %v", err)
+	}
+
+	if !hasExternalTx {
+		if err = tx.Commit(ctx); err != nil {
+			return fmt.Errorf("commit transaction: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// GetByID gets a channel regardless of the current user subscription,
+// since anyone can find a channel and subscribe to it.
+func (r *ChannelRepository) GetByID(ctx context.Context, channelID int) (entity.Channel, error) {
+	var channel entity.Channel
+
+	query := `SELECT c.id,
+		COALESCE(c.uname, ''),
+		c.name,
+		c.description,
+		c.created_at
+	FROM chats c
+	WHERE c.id = $1
+	  AND c.type = 'channel'`
+
+	err := r.getter.Get(ctx).QueryRow(ctx, query, channelID).Scan(
+		&channel.ID, &channel.Uname, &channel.Name,
+		&channel.Description, &channel.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return channel, fmt.Errorf("%w: %v", entity.ErrChannelNotFound, err)
+		}
+
+		return channel, fmt.Errorf("exec query to select channel: %v", err)
+	}
+
+	return channel, nil
+}
+
+func (r *ChannelRepository) Update(ctx context.Context, channel *entity.Channel) error {
+	userID := ctxutil.UserIDFromContext(ctx).ToInt()
+	query := `UPDATE chats AS c
+	SET	uname       = NULLIF($6, ''),
+		name        = $3,
+		description = $4,
+		updated_at  = $5
+	FROM channel_subscribers AS cs
+	WHERE c.id = cs.chat_id
+	  AND c.id = $1
+	  AND c.type = 'channel'
+	  AND cs.user_id = $2
+	  AND cs.is_admin
+	RETURNING created_at`
+
+	err := r.getter.Get(ctx).QueryRow(ctx, query,
+		channel.ID, userID,
+		channel.Name, channel.Description, time.Now(),
+		channel.Uname,
+	).Scan(&channel.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%w: %v", entity.ErrChannelNotFound, err)
+		}
+
+		pgErr := &pgconn.PgError{}
+		if errors.As(err, &pgErr) && isChatUnameUniqueViolation(pgErr) {
+			return fmt.Errorf("%w: %s", entity.ErrSuchChannelUnameAlreadyExists, pgErr.Message)
+		}
+		return fmt.Errorf("exec query to update channel: %v", err)
+	}
+
+	return nil
+}
+
+func (r *ChannelRepository) Delete(ctx context.Context, channelID int) error {
+	userID := ctxutil.UserIDFromContext(ctx).ToInt()
+	query := `DELETE
+	FROM chats AS c
+		USING channel_subscribers cs
+	WHERE c.id = cs.chat_id
+	  AND c.id = $1
+	  AND c.type = 'channel'
+	  AND cs.user_id = $2
+	  AND cs.is_admin`
+
+	execRes, err := r.getter.Get(ctx).Exec(ctx, query, channelID, userID)
+	if err != nil {
+		return fmt.Errorf("exec query to delete channel: %v", err)
+	}
+
+	if execRes.RowsAffected() == 0 {
+		return fmt.Errorf("%w: there aren't affected rows", entity.ErrChannelNotFound)
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Chatyx/backend/internal/dto"
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/ctxutil"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// chatPreviewLength is the max number of characters of the last message preview.
+const chatPreviewLength = 100
+
+type ChatRepository struct {
+	pool   *pgxpool.Pool
+	getter dbClientGetter
+}
+
+func NewChatRepository(pool *pgxpool.Pool) *ChatRepository {
+	return &ChatRepository{
+		pool:   pool,
+		getter: dbClientGetter{pool: pool},
+	}
+}
+
+// List lists dialogs and groups of the current user, pinned chats go first and the others
+// are sorted by the time of their last message, chats without messages are sorted by the time
+// of creation. Groups are listed for joined and muted participants only. Dialogs are listed
+// on the same terms as in DialogRepository.List, the last message and the unread count of a dialog
+// take its cleared history into account. Unread messages are counted for the page only.
+func (r *ChatRepository) List(ctx context.Context, obj dto.ChatList) ([]entity.ChatListItem, error) {
+	userID := ctxutil.UserIDFromContext(ctx).ToInt()
+	query := `WITH user_chats AS (
+		SELECT	c.id,
+				c.type,
+				COALESCE(c.name, '') AS name,
+				0                    AS partner_user_id,
+				0                    AS cleared_before_message_id,
+				c.created_at
+		FROM chats c
+			INNER JOIN group_participants gp
+				ON c.id = gp.chat_id
+		WHERE gp.user_id = $1
+		  AND gp.status IN ('joined', 'muted')
+		  AND c.type = 'group'
+		  AND c.deleted_at IS NULL
+		UNION ALL
+		SELECT	c.id,
+				c.type,
+				'',
+				COALESCE(p.user_id, $1),
+				COALESCE(dp.cleared_before_message_id, 0),
+				c.created_at
+		FROM chats c
+			INNER JOIN dialog_participants dp
+				ON c.id = dp.chat_id
+			LEFT JOIN dialog_participants p
+				ON c.id = p.chat_id
+				AND p.user_id != $1
+			LEFT JOIN dialog_requests dr
+				ON c.id = dr.chat_id
+				AND dr.recipient_id = $1
+			LEFT JOIN last_messages lm
+				ON c.id = lm.chat_id
+		WHERE dp.user_id = $1
+		  AND c.type = 'dialog'
+		  AND (dr.status IS NULL OR dr.status = 'accepted')
+		  AND (NOT dp.hidden OR lm.message_id > COALESCE(dp.cleared_before_message_id, 0))),
+	user_chat_items AS (
+		SELECT	uc.*,
+				COALESCE(s.muted_until > now(), FALSE) AS is_muted,
+				COALESCE(s.pinned_order, 0)           AS pinned_order,
+				COALESCE(s.archived, FALSE)           AS is_archived
+		FROM user_chats uc
+			LEFT JOIN user_chat_settings s
+				ON uc.id = s.chat_id
+				AND s.user_id = $1),
+	page AS (
+		SELECT	uci.*,
+				m.id                          AS message_id,
+				m.sender_id,
+				LEFT(m.content, $5)           AS content,
+				m.content_type,
+				COALESCE(m.is_service, FALSE) AS is_service,
+				m.service_action,
+				m.service_params,
+				m.sent_at,
+				COALESCE(m.sent_at, uci.created_at) AS last_activity_at
+		FROM user_chat_items uci
+			LEFT JOIN last_messages lm
+				ON uci.id = lm.chat_id
+			LEFT JOIN messages m
+				ON lm.message_id = m.id
+				AND m.id > uci.cleared_before_message_id
+		WHERE %s
+		  AND ($3::TIMESTAMPTZ IS NULL
+		   OR (uci.pinned_order, COALESCE(m.sent_at, uci.created_at), uci.id) < ($2, $3, $4))
+		ORDER BY pinned_order DESC, last_activity_at DESC, uci.id DESC
+		LIMIT $6)
+	SELECT	page.id, page.type, page.name, page.partner_user_id,
+			page.message_id, page.sender_id, page.content, page.content_type,
+			page.is_service, page.service_action, page.service_params, page.sent_at,
+			unread.unread_count, page.is_muted, page.pinned_order, page.is_archived,
+			page.created_at, page.last_activity_at
+	FROM page
+		LEFT JOIN read_messages rm
+			ON page.id = rm.chat_id
+			AND rm.user_id = $1
+		CROSS JOIN LATERAL (
+			SELECT COUNT(*) AS unread_count FROM messages um
+			WHERE um.chat_id = page.id
+			  AND um.chat_type = page.type
+			  AND um.sender_id != $1
+			  AND um.id > GREATEST(COALESCE(rm.message_id, 0), page.cleared_before_message_id)) unread
+	ORDER BY page.pinned_order DESC, page.last_activity_at DESC, page.id DESC`
+
+	// A chat is in the folder if it's included explicitly or it matches the rules
+	// of the folder and it isn't excluded explicitly.
+	cond, condArg := "uci.is_archived = $7", any(obj.Archived)
+	if obj.FolderID != nil {
+		cond, condArg = `EXISTS(
+			SELECT 1 FROM chat_folders f
+			WHERE f.id = $7
+			  AND f.user_id = $1
+			  AND (EXISTS(
+					SELECT 1 FROM chat_folder_chats fc
+					WHERE fc.folder_id = f.id AND fc.chat_id = uci.id AND NOT fc.excluded)
+				OR NOT EXISTS(
+					SELECT 1 FROM chat_folder_chats fc
+					WHERE fc.folder_id = f.id AND fc.chat_id = uci.id AND fc.excluded)
+				AND ((uci.type = 'dialog' AND f.include_dialogs) OR (uci.type = 'group' AND f.include_groups))
+				AND NOT (f.exclude_muted AND uci.is_muted)
+				AND NOT (f.exclude_archived AND uci.is_archived)))`, *obj.FolderID
+	}
+
+	var (
+		cursorPinnedOrder int
+		cursorActivityAt  *time.Time
+		cursorChatID      int
+	)
+	if obj.Cursor != nil {
+		cursorPinnedOrder, cursorActivityAt, cursorChatID = obj.Cursor.PinnedOrder, &obj.Cursor.LastActivityAt, obj.Cursor.ChatID
+	}
+
+	rows, err := r.getter.Get(ctx).Query(ctx, fmt.Sprintf(query, cond),
+		userID, cursorPinnedOrder, cursorActivityAt, cursorChatID, chatPreviewLength, obj.Limit, condArg,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("exec query to select chats: %v", err)
+	}
+	defer rows.Close()
+
+	var items []entity.ChatListItem
+
+	for rows.Next() {
+		var (
+			item          entity.ChatListItem
+			messageID     *int
+			senderID      *int
+			content       *string
+			contentType   *entity.ContentType
+			isService     bool
+			serviceAction *entity.ServiceActionType
+			serviceParams map[string]string
+			sentAt        *time.Time
+		)
+
+		err = rows.Scan(
+			&item.ChatID.ID, &item.ChatID.Type, &item.Name, &item.PartnerUserID,
+			&messageID, &senderID, &content, &contentType,
+			&isService, &serviceAction, &serviceParams, &sentAt,
+			&item.UnreadCount, &item.IsMuted, &item.PinnedOrder, &item.IsArchived,
+			&item.CreatedAt, &item.LastActivityAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scan chat row: %v", err)
+		}
+
+		if messageID != nil {
+			message := entity.Message{
+				ID:          *messageID,
+				ChatID:      item.ChatID,
+				SenderID:    *senderID,
+				Content:     *content,
+				ContentType: *contentType,
+				IsService:   isService,
+				SentAt:      *sentAt,
+			}
+			if serviceAction != nil {
+				message.ServiceAction = &entity.ServiceAction{
+					Type:   *serviceAction,
+					Params: serviceParams,
+				}
+			}
+			item.LastMessage = &message
+		}
+
+		items = append(items, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("reading chat rows: %v", err)
+	}
+	return items, nil
+}
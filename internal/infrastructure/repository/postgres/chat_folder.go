@@ -0,0 +1,300 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/ctxutil"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ChatFolderRepository struct {
+	pool   *pgxpool.Pool
+	getter dbClientGetter
+}
+
+func NewChatFolderRepository(pool *pgxpool.Pool) *ChatFolderRepository {
+	return &ChatFolderRepository{
+		pool:   pool,
+		getter: dbClientGetter{pool: pool},
+	}
+}
+
+// List lists chat folders of the current user in the order of their creation.
+func (r *ChatFolderRepository) List(ctx context.Context) ([]entity.ChatFolder, error) {
+	userID := ctxutil.UserIDFromContext(ctx).ToInt()
+	query := `SELECT id, user_id, name,
+		include_dialogs, include_groups, exclude_muted, exclude_archived,
+		created_at
+	FROM chat_folders
+	WHERE user_id = $1
+	ORDER BY id`
+
+	rows, err := r.getter.Get(ctx).Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("exec query to select chat folders: %v", err)
+	}
+	defer rows.Close()
+
+	var folders []entity.ChatFolder
+
+	for rows.Next() {
+		var folder entity.ChatFolder
+
+		err = rows.Scan(
+			&folder.ID, &folder.UserID, &folder.Name,
+			&folder.IncludeDialogs, &folder.IncludeGroups, &folder.ExcludeMuted, &folder.ExcludeArchived,
+			&folder.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scan chat folder row: %v", err)
+		}
+
+		folders = append(folders, folder)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("reading chat folder rows: %v", err)
+	}
+
+	if err = r.loadChats(ctx, folders); err != nil {
+		return nil, err
+	}
+	return folders, nil
+}
+
+// Count counts chat folders of the current user. The lock prevents creating folders
+// of the user concurrently until the end of the transaction.
+func (r *ChatFolderRepository) Count(ctx context.Context, withLock bool) (int, error) {
+	userID := ctxutil.UserIDFromContext(ctx).ToInt()
+	if withLock {
+		query := "SELECT id FROM users WHERE id = $1 FOR UPDATE"
+
+		var id int
+		if err := r.getter.Get(ctx).QueryRow(ctx, query, userID).Scan(&id); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return 0, fmt.Errorf("%w: %v", entity.ErrUserNotFound, err)
+			}
+
+			return 0, fmt.Errorf("exec query to lock user: %v", err)
+		}
+	}
+
+	query := "SELECT count(*) FROM chat_folders WHERE user_id = $1"
+
+	var count int
+	if err := r.getter.Get(ctx).QueryRow(ctx, query, userID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("exec query to count chat folders: %v", err)
+	}
+	return count, nil
+}
+
+// GetByID gets the chat folder of the current user.
+func (r *ChatFolderRepository) GetByID(ctx context.Context, id int) (entity.ChatFolder, error) {
+	userID := ctxutil.UserIDFromContext(ctx).ToInt()
+	query := `SELECT id, user_id, name,
+		include_dialogs, include_groups, exclude_muted, exclude_archived,
+		created_at
+	FROM chat_folders
+	WHERE id = $1
+	  AND user_id = $2`
+
+	var folder entity.ChatFolder
+
+	err := r.getter.Get(ctx).QueryRow(ctx, query, id, userID).Scan(
+		&folder.ID, &folder.UserID, &folder.Name,
+		&folder.IncludeDialogs, &folder.IncludeGroups, &folder.ExcludeMuted, &folder.ExcludeArchived,
+		&folder.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.ChatFolder{}, fmt.Errorf("%w: %v", entity.ErrChatFolderNotFound, err)
+		}
+
+		return entity.ChatFolder{}, fmt.Errorf("exec query to select chat folder: %v", err)
+	}
+
+	folders := []entity.ChatFolder{folder}
+	if err = r.loadChats(ctx, folders); err != nil {
+		return entity.ChatFolder{}, err
+	}
+	return folders[0], nil
+}
+
+// Create creates the chat folder. Explicitly included and excluded chats
+// which the user isn't in are skipped.
+func (r *ChatFolderRepository) Create(ctx context.Context, folder *entity.ChatFolder) error {
+	query := `INSERT INTO chat_folders
+		(user_id, name, include_dialogs, include_groups, exclude_muted, exclude_archived, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+	RETURNING id`
+
+	err := r.getter.Get(ctx).QueryRow(ctx, query,
+		folder.UserID, folder.Name,
+		folder.IncludeDialogs, folder.IncludeGroups, folder.ExcludeMuted, folder.ExcludeArchived,
+		folder.CreatedAt,
+	).Scan(&folder.ID)
+	if err != nil {
+		return fmt.Errorf("exec query to insert chat folder: %v", err)
+	}
+
+	return r.setChats(ctx, folder)
+}
+
+// Update updates the chat folder of the current user and replaces its explicitly
+// included and excluded chats, the ones which the user isn't in are skipped.
+func (r *ChatFolderRepository) Update(ctx context.Context, folder *entity.ChatFolder) error {
+	query := `UPDATE chat_folders
+	SET	name             = $3,
+		include_dialogs  = $4,
+		include_groups   = $5,
+		exclude_muted    = $6,
+		exclude_archived = $7,
+		updated_at       = $8
+	WHERE id = $1
+	  AND user_id = $2
+	RETURNING created_at`
+
+	err := r.getter.Get(ctx).QueryRow(ctx, query,
+		folder.ID, folder.UserID, folder.Name,
+		folder.IncludeDialogs, folder.IncludeGroups, folder.ExcludeMuted, folder.ExcludeArchived,
+		time.Now(),
+	).Scan(&folder.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%w: %v", entity.ErrChatFolderNotFound, err)
+		}
+
+		return fmt.Errorf("exec query to update chat folder: %v", err)
+	}
+
+	if _, err = r.getter.Get(ctx).Exec(ctx, `DELETE FROM chat_folder_chats WHERE folder_id = $1`, folder.ID); err != nil {
+		return fmt.Errorf("exec query to delete chat folder chats: %v", err)
+	}
+
+	return r.setChats(ctx, folder)
+}
+
+// Delete deletes the chat folder of the current user, chats of the folder remain.
+func (r *ChatFolderRepository) Delete(ctx context.Context, id int) error {
+	userID := ctxutil.UserIDFromContext(ctx).ToInt()
+	query := `DELETE FROM chat_folders
+	WHERE id = $1
+	  AND user_id = $2`
+
+	execRes, err := r.getter.Get(ctx).Exec(ctx, query, id, userID)
+	if err != nil {
+		return fmt.Errorf("exec query to delete chat folder: %v", err)
+	}
+
+	if execRes.RowsAffected() == 0 {
+		return fmt.Errorf("%w: there aren't affected rows", entity.ErrChatFolderNotFound)
+	}
+	return nil
+}
+
+// setChats inserts explicitly included and excluded chats of the folder and reloads them,
+// so only the inserted ones are left. A chat which is both included and excluded is excluded.
+func (r *ChatFolderRepository) setChats(ctx context.Context, folder *entity.ChatFolder) error {
+	chats := make(map[entity.ChatID]bool, len(folder.IncludedChats)+len(folder.ExcludedChats))
+	for _, chatID := range folder.IncludedChats {
+		chats[chatID] = false
+	}
+	for _, chatID := range folder.ExcludedChats {
+		chats[chatID] = true
+	}
+
+	folder.IncludedChats, folder.ExcludedChats = nil, nil
+	if len(chats) == 0 {
+		return nil
+	}
+
+	var (
+		chatIDs   = make([]int, 0, len(chats))
+		chatTypes = make([]string, 0, len(chats))
+		excluded  = make([]bool, 0, len(chats))
+	)
+	for chatID, isExcluded := range chats {
+		chatIDs = append(chatIDs, chatID.ID)
+		chatTypes = append(chatTypes, chatID.Type.String())
+		excluded = append(excluded, isExcluded)
+	}
+
+	query := `INSERT INTO chat_folder_chats (folder_id, chat_id, excluded)
+	SELECT $2, c.id, fc.excluded
+	FROM unnest($3::BIGINT[], $4::TEXT[], $5::BOOLEAN[]) AS fc(chat_id, chat_type, excluded)
+		INNER JOIN chats c
+			ON c.id = fc.chat_id
+			AND c.type::text = fc.chat_type
+	WHERE ` + userInChatExpr
+
+	if _, err := r.getter.Get(ctx).Exec(ctx, query, folder.UserID, folder.ID, chatIDs, chatTypes, excluded); err != nil {
+		return fmt.Errorf("exec query to insert chat folder chats: %v", err)
+	}
+
+	folders := []entity.ChatFolder{*folder}
+	if err := r.loadChats(ctx, folders); err != nil {
+		return err
+	}
+
+	*folder = folders[0]
+	return nil
+}
+
+// loadChats loads explicitly included and excluded chats of the folders.
+func (r *ChatFolderRepository) loadChats(ctx context.Context, folders []entity.ChatFolder) error {
+	if len(folders) == 0 {
+		return nil
+	}
+
+	folderIDs := make([]int, len(folders))
+	for i, folder := range folders {
+		folderIDs[i] = folder.ID
+	}
+
+	query := `SELECT fc.folder_id, c.id, c.type, fc.excluded
+	FROM chat_folder_chats fc
+		INNER JOIN chats c
+			ON c.id = fc.chat_id
+	WHERE fc.folder_id = ANY ($1)
+	ORDER BY fc.folder_id, c.id`
+
+	rows, err := r.getter.Get(ctx).Query(ctx, query, folderIDs)
+	if err != nil {
+		return fmt.Errorf("exec query to select chat folder chats: %v", err)
+	}
+	defer rows.Close()
+
+	indexes := make(map[int]int, len(folders))
+	for i, folder := range folders {
+		indexes[folder.ID] = i
+	}
+
+	for rows.Next() {
+		var (
+			folderID int
+			chatID   entity.ChatID
+			excluded bool
+		)
+		if err = rows.Scan(&folderID, &chatID.ID, &chatID.Type, &excluded); err != nil {
+			return fmt.Errorf("scan chat folder chat row: %v", err)
+		}
+
+		folder := &folders[indexes[folderID]]
+		if excluded {
+			folder.ExcludedChats = append(folder.ExcludedChats, chatID)
+		} else {
+			folder.IncludedChats = append(folder.IncludedChats, chatID)
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("reading chat folder chat rows: %v", err)
+	}
+	return nil
+}
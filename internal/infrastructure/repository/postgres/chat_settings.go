@@ -0,0 +1,150 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/ctxutil"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// userInChatExpr checks whether the user of the first parameter is in the chat c
+// on the same terms as the chat is listed by ChatRepository.List.
+const userInChatExpr = `(EXISTS(
+		SELECT 1 FROM group_participants gp
+		WHERE gp.chat_id = c.id
+		  AND gp.user_id = $1
+		  AND c.type = 'group'
+		  AND c.deleted_at IS NULL)
+	OR EXISTS(
+		SELECT 1 FROM dialog_participants dp
+		WHERE dp.chat_id = c.id
+		  AND dp.user_id = $1
+		  AND c.type = 'dialog'))`
+
+type ChatSettingsRepository struct {
+	pool   *pgxpool.Pool
+	getter dbClientGetter
+}
+
+func NewChatSettingsRepository(pool *pgxpool.Pool) *ChatSettingsRepository {
+	return &ChatSettingsRepository{
+		pool:   pool,
+		getter: dbClientGetter{pool: pool},
+	}
+}
+
+// Get gets settings of the chat which the current user is in,
+// the chat has the default settings if the user hasn't changed them.
+func (r *ChatSettingsRepository) Get(ctx context.Context, chatID entity.ChatID) (entity.ChatSettings, error) {
+	userID := ctxutil.UserIDFromContext(ctx).ToInt()
+	query := `SELECT s.muted_until, s.pinned_order, COALESCE(s.archived, FALSE)
+	FROM chats c
+		LEFT JOIN user_chat_settings s
+			ON c.id = s.chat_id
+			AND s.user_id = $1
+	WHERE c.id = $2
+	  AND c.type::text = $3
+	  AND ` + userInChatExpr
+
+	settings := entity.ChatSettings{ChatID: chatID}
+
+	err := r.getter.Get(ctx).QueryRow(ctx, query, userID, chatID.ID, chatID.Type).Scan(
+		&settings.MutedUntil, &settings.PinnedOrder, &settings.IsArchived,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.ChatSettings{}, fmt.Errorf("%w: %v", entity.ErrChatNotFound, err)
+		}
+
+		return entity.ChatSettings{}, fmt.Errorf("exec query to select chat settings: %v", err)
+	}
+	return settings, nil
+}
+
+// ListMuted lists settings of the chats which the current user has muted at the moment.
+func (r *ChatSettingsRepository) ListMuted(ctx context.Context) ([]entity.ChatSettings, error) {
+	userID := ctxutil.UserIDFromContext(ctx).ToInt()
+	query := `SELECT c.id, c.type, s.muted_until, s.pinned_order, s.archived
+	FROM user_chat_settings s
+		INNER JOIN chats c
+			ON c.id = s.chat_id
+	WHERE s.user_id = $1
+	  AND s.muted_until > now()`
+
+	rows, err := r.getter.Get(ctx).Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("exec query to select muted chat settings: %v", err)
+	}
+	defer rows.Close()
+
+	var settingsList []entity.ChatSettings
+
+	for rows.Next() {
+		var settings entity.ChatSettings
+
+		err = rows.Scan(
+			&settings.ChatID.ID, &settings.ChatID.Type,
+			&settings.MutedUntil, &settings.PinnedOrder, &settings.IsArchived,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scan chat settings row: %v", err)
+		}
+
+		settingsList = append(settingsList, settings)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("reading chat settings rows: %v", err)
+	}
+	return settingsList, nil
+}
+
+// MaxPinnedOrder returns the greatest order of the chats pinned by the current user
+// or zero if there are no pinned chats.
+func (r *ChatSettingsRepository) MaxPinnedOrder(ctx context.Context) (int, error) {
+	userID := ctxutil.UserIDFromContext(ctx).ToInt()
+	query := `SELECT COALESCE(MAX(pinned_order), 0)
+	FROM user_chat_settings
+	WHERE user_id = $1`
+
+	var order int
+	if err := r.getter.Get(ctx).QueryRow(ctx, query, userID).Scan(&order); err != nil {
+		return 0, fmt.Errorf("exec query to select max pinned order: %v", err)
+	}
+	return order, nil
+}
+
+// Update updates settings of the chat which the current user is in.
+func (r *ChatSettingsRepository) Update(ctx context.Context, settings entity.ChatSettings) error {
+	userID := ctxutil.UserIDFromContext(ctx).ToInt()
+	query := `INSERT INTO user_chat_settings (user_id, chat_id, muted_until, pinned_order, archived, updated_at)
+	SELECT $1, c.id, $4, $5, $6, $7
+	FROM chats c
+	WHERE c.id = $2
+	  AND c.type::text = $3
+	  AND ` + userInChatExpr + `
+	ON CONFLICT (user_id, chat_id) DO UPDATE
+	SET	muted_until  = excluded.muted_until,
+		pinned_order = excluded.pinned_order,
+		archived     = excluded.archived,
+		updated_at   = excluded.updated_at`
+
+	execRes, err := r.getter.Get(ctx).Exec(ctx, query,
+		userID, settings.ChatID.ID, settings.ChatID.Type,
+		settings.MutedUntil, settings.PinnedOrder, settings.IsArchived, time.Now(),
+	)
+	if err != nil {
+		return fmt.Errorf("exec query to upsert chat settings: %v", err)
+	}
+
+	if execRes.RowsAffected() == 0 {
+		return fmt.Errorf("%w: there aren't affected rows", entity.ErrChatNotFound)
+	}
+	return nil
+}
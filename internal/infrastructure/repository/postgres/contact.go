@@ -0,0 +1,179 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Chatyx/backend/internal/entity"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// contactIsMutualExpr checks whether the contact user has also added the user.
+const contactIsMutualExpr = `EXISTS(
+		SELECT 1 FROM contacts m
+		WHERE m.user_id = c.contact_user_id
+		  AND m.contact_user_id = c.user_id)`
+
+type ContactRepository struct {
+	pool   *pgxpool.Pool
+	getter dbClientGetter
+}
+
+func NewContactRepository(pool *pgxpool.Pool) *ContactRepository {
+	return &ContactRepository{
+		pool:   pool,
+		getter: dbClientGetter{pool: pool},
+	}
+}
+
+func (r *ContactRepository) List(ctx context.Context, userID int) ([]entity.Contact, error) {
+	query := `SELECT c.user_id, c.contact_user_id, c.display_name,
+		` + contactIsMutualExpr + `,
+		c.created_at
+	FROM contacts c
+		INNER JOIN users u
+			ON u.id = c.contact_user_id
+	WHERE c.user_id = $1
+	  AND u.deleted_at IS NULL
+	ORDER BY c.created_at DESC`
+
+	rows, err := r.getter.Get(ctx).Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("exec query to select contacts: %v", err)
+	}
+	defer rows.Close()
+
+	var contacts []entity.Contact
+
+	for rows.Next() {
+		var contact entity.Contact
+
+		err = rows.Scan(
+			&contact.UserID, &contact.ContactUserID, &contact.DisplayName,
+			&contact.IsMutual, &contact.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scan contact row: %v", err)
+		}
+
+		contacts = append(contacts, contact)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("reading contact rows: %v", err)
+	}
+	return contacts, nil
+}
+
+func (r *ContactRepository) Get(ctx context.Context, userID, contactUserID int) (entity.Contact, error) {
+	query := `SELECT c.user_id, c.contact_user_id, c.display_name,
+		` + contactIsMutualExpr + `,
+		c.created_at
+	FROM contacts c
+	WHERE c.user_id = $1
+	  AND c.contact_user_id = $2`
+
+	var contact entity.Contact
+
+	err := r.getter.Get(ctx).QueryRow(ctx, query, userID, contactUserID).Scan(
+		&contact.UserID, &contact.ContactUserID, &contact.DisplayName,
+		&contact.IsMutual, &contact.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Contact{}, fmt.Errorf("%w: %v", entity.ErrContactNotFound, err)
+		}
+
+		return entity.Contact{}, fmt.Errorf("exec query to select contact: %v", err)
+	}
+
+	return contact, nil
+}
+
+func (r *ContactRepository) Create(ctx context.Context, contact *entity.Contact) error {
+	query := `INSERT INTO contacts AS c
+		(user_id, contact_user_id, display_name, created_at)
+	VALUES ($1, $2, $3, $4)
+	RETURNING ` + contactIsMutualExpr
+
+	err := r.getter.Get(ctx).QueryRow(ctx, query,
+		contact.UserID, contact.ContactUserID,
+		contact.DisplayName, contact.CreatedAt,
+	).Scan(&contact.IsMutual)
+	if err != nil {
+		pgErr := &pgconn.PgError{}
+		if errors.As(err, &pgErr) {
+			if isContactUniqueViolation(pgErr) {
+				return fmt.Errorf("%w: %v", entity.ErrSuchContactAlreadyExists, pgErr.Message)
+			}
+			if isContactUserViolation(pgErr) {
+				return fmt.Errorf("%w: %v", entity.ErrAddNonExistentUserToContacts, pgErr.Message)
+			}
+		}
+
+		return fmt.Errorf("exec query to insert contact: %v", err)
+	}
+	return nil
+}
+
+func (r *ContactRepository) Update(ctx context.Context, contact *entity.Contact) error {
+	query := `UPDATE contacts AS c
+	SET display_name = $3
+	WHERE c.user_id = $1
+	  AND c.contact_user_id = $2
+	RETURNING ` + contactIsMutualExpr + `, c.created_at`
+
+	err := r.getter.Get(ctx).QueryRow(ctx, query,
+		contact.UserID, contact.ContactUserID, contact.DisplayName,
+	).Scan(&contact.IsMutual, &contact.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%w: %v", entity.ErrContactNotFound, err)
+		}
+
+		return fmt.Errorf("exec query to update contact: %v", err)
+	}
+	return nil
+}
+
+func (r *ContactRepository) Delete(ctx context.Context, userID, contactUserID int) error {
+	query := `DELETE FROM contacts
+	WHERE user_id = $1
+	  AND contact_user_id = $2`
+
+	execRes, err := r.getter.Get(ctx).Exec(ctx, query, userID, contactUserID)
+	if err != nil {
+		return fmt.Errorf("exec query to delete contact: %v", err)
+	}
+
+	if execRes.RowsAffected() == 0 {
+		return fmt.Errorf("%w: there aren't affected rows", entity.ErrContactNotFound)
+	}
+	return nil
+}
+
+// Exists reports whether the contact user is in the contacts of the user.
+func (r *ContactRepository) Exists(ctx context.Context, userID, contactUserID int) (bool, error) {
+	query := `SELECT EXISTS(
+		SELECT 1 FROM contacts
+		WHERE user_id = $1
+		  AND contact_user_id = $2)`
+
+	var exists bool
+	if err := r.getter.Get(ctx).QueryRow(ctx, query, userID, contactUserID).Scan(&exists); err != nil {
+		return false, fmt.Errorf("exec query to check contact: %v", err)
+	}
+	return exists, nil
+}
+
+func isContactUniqueViolation(pgErr *pgconn.PgError) bool {
+	return pgErr.Code == uniqueViolationCode && pgErr.ConstraintName == "contacts_pkey"
+}
+
+func isContactUserViolation(pgErr *pgconn.PgError) bool {
+	return pgErr.Code == foreignKeyViolationCode && pgErr.ConstraintName == "contacts_contact_user_id_fkey"
+}
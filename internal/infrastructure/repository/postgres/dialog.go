@@ -29,26 +29,58 @@ func NewDialogRepository(pool *pgxpool.Pool) *DialogRepository {
 	}
 }
 
-func (r *DialogRepository) List(ctx context.Context) ([]entity.Dialog, error) {
-	userID := ctxutil.UserIDFromContext(ctx).ToInt()
-	query := `WITH dialogs AS (
+// dialogListQuery selects dialogs of the user with $1 id which satisfy the request condition.
+// The saved messages dialog has the only participant, so the user is the partner there.
+const dialogListQuery = `WITH dialogs AS (
 		SELECT	c.id,
 				dp.is_blocked,
+				COALESCE(dr.status = 'pending', FALSE) AS is_request,
 				c.created_at
 		FROM chats c
 			INNER JOIN dialog_participants dp
 				ON c.id = dp.chat_id
+			LEFT JOIN dialog_requests dr
+				ON c.id = dr.chat_id
+				AND dr.recipient_id = dp.user_id
 			WHERE dp.user_id = $1
-				AND c.type = 'dialog')
+				AND c.type = 'dialog'
+				AND %s)
 	SELECT	dialogs.id,
-			dialogs.is_blocked AS "user_is_blocked",
-			dp.user_id         AS "partner_user_id",
-			dp.is_blocked      AS "partner_is_blocked",
+			dialogs.is_blocked             AS "user_is_blocked",
+			dialogs.is_request,
+			COALESCE(dp.user_id, $1)       AS "partner_user_id",
+			COALESCE(dp.is_blocked, FALSE) AS "partner_is_blocked",
 			dialogs.created_at
 	FROM dialogs
-		INNER JOIN dialog_participants dp
+		LEFT JOIN dialog_participants dp
 			ON dialogs.id = dp.chat_id
-	WHERE dp.user_id != $1`
+			AND dp.user_id != $1`
+
+// List lists dialogs of the current user except for message requests which the user
+// hasn't accepted and dialogs hidden by the user without new messages after that.
+func (r *DialogRepository) List(ctx context.Context) ([]entity.Dialog, error) {
+	return r.list(ctx, `(dr.status IS NULL OR dr.status = 'accepted')
+				AND (NOT dp.hidden OR EXISTS(
+					SELECT 1 FROM messages m
+					WHERE m.chat_id = c.id
+					  AND m.chat_type = 'dialog'
+					  AND m.id > COALESCE(dp.cleared_before_message_id, 0)))`)
+}
+
+// ListAll lists all dialogs of the current user including pending message requests
+// and hidden dialogs. Declined message requests aren't listed.
+func (r *DialogRepository) ListAll(ctx context.Context) ([]entity.Dialog, error) {
+	return r.list(ctx, "(dr.status IS NULL OR dr.status IN ('pending', 'accepted'))")
+}
+
+// ListRequests lists pending message requests sent to the current user.
+func (r *DialogRepository) ListRequests(ctx context.Context) ([]entity.Dialog, error) {
+	return r.list(ctx, "dr.status = 'pending'")
+}
+
+func (r *DialogRepository) list(ctx context.Context, requestCond string) ([]entity.Dialog, error) {
+	userID := ctxutil.UserIDFromContext(ctx).ToInt()
+	query := fmt.Sprintf(dialogListQuery, requestCond)
 
 	rows, err := r.getter.Get(ctx).Query(ctx, query, userID)
 	if err != nil {
@@ -62,7 +94,7 @@ func (r *DialogRepository) List(ctx context.Context) ([]entity.Dialog, error) {
 		var dialog entity.Dialog
 
 		err = rows.Scan(
-			&dialog.ID, &dialog.IsBlocked,
+			&dialog.ID, &dialog.IsBlocked, &dialog.IsRequest,
 			&dialog.Partner.UserID, &dialog.Partner.IsBlocked,
 			&dialog.CreatedAt,
 		)
@@ -82,9 +114,6 @@ func (r *DialogRepository) List(ctx context.Context) ([]entity.Dialog, error) {
 func (r *DialogRepository) Create(ctx context.Context, dialog *entity.Dialog) error {
 	userID := ctxutil.UserIDFromContext(ctx).ToInt()
 	partnerUserID := dialog.Partner.UserID
-	if userID == partnerUserID {
-		return entity.ErrCreateDialogWithYourself
-	}
 
 	var (
 		hasExternalTx bool
@@ -123,9 +152,11 @@ func (r *DialogRepository) Create(ctx context.Context, dialog *entity.Dialog) er
 		return fmt.Errorf("exec query to insert dialog: %v", err)
 	}
 
+	// The saved messages dialog of the user with themselves has the only participant.
 	query = `INSERT INTO dialog_participants
 		(chat_id, user_id)
-	VALUES ($1, $2), ($1, $3)`
+	VALUES ($1, $2), ($1, $3)
+	ON CONFLICT DO NOTHING`
 
 	if _, err = tx.Exec(ctx, query, dialog.ID, userID, partnerUserID); err != nil {
 		pgErr := &pgconn.PgError{}
@@ -151,25 +182,30 @@ func (r *DialogRepository) GetByID(ctx context.Context, dialogID int) (entity.Di
 	query := `WITH dialogs AS (
 		SELECT	c.id,
 				dp.is_blocked,
+				COALESCE(dr.status = 'pending', FALSE) AS is_request,
 				c.created_at
 		FROM chats c
 			INNER JOIN dialog_participants dp
 				ON c.id = dp.chat_id
+			LEFT JOIN dialog_requests dr
+				ON c.id = dr.chat_id
+				AND dr.recipient_id = dp.user_id
 			WHERE dp.chat_id = $1
 				AND dp.user_id = $2
 				AND c.type = 'dialog')
 	SELECT	dialogs.id,
-			dialogs.is_blocked AS "user_is_blocked",
-			dp.user_id         AS "partner_user_id",
-			dp.is_blocked      AS "partner_is_blocked",
+			dialogs.is_blocked             AS "user_is_blocked",
+			dialogs.is_request,
+			COALESCE(dp.user_id, $2)       AS "partner_user_id",
+			COALESCE(dp.is_blocked, FALSE) AS "partner_is_blocked",
 			dialogs.created_at
 	FROM dialogs
-		INNER JOIN dialog_participants dp
+		LEFT JOIN dialog_participants dp
 			ON dialogs.id = dp.chat_id
-	WHERE dp.user_id != $2`
+			AND dp.user_id != $2`
 
 	err := r.getter.Get(ctx).QueryRow(ctx, query, dialogID, userID).Scan(
-		&dialog.ID, &dialog.IsBlocked,
+		&dialog.ID, &dialog.IsBlocked, &dialog.IsRequest,
 		&dialog.Partner.UserID, &dialog.Partner.IsBlocked,
 		&dialog.CreatedAt,
 	)
@@ -184,6 +220,25 @@ func (r *DialogRepository) GetByID(ctx context.Context, dialogID int) (entity.Di
 	return dialog, nil
 }
 
+// GetByPartnerID gets the dialog of the current user with the partner.
+func (r *DialogRepository) GetByPartnerID(ctx context.Context, partnerUserID int) (entity.Dialog, error) {
+	userID := ctxutil.UserIDFromContext(ctx).ToInt()
+	query := `SELECT id FROM chats
+	WHERE uname = $1
+	  AND type = 'dialog'`
+
+	var dialogID int
+	if err := r.getter.Get(ctx).QueryRow(ctx, query, getUname(userID, partnerUserID)).Scan(&dialogID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Dialog{}, fmt.Errorf("%w: %v", entity.ErrDialogNotFound, err)
+		}
+
+		return entity.Dialog{}, fmt.Errorf("exec query to select dialog by partner: %v", err)
+	}
+
+	return r.GetByID(ctx, dialogID)
+}
+
 func (r *DialogRepository) Update(ctx context.Context, dialog *entity.Dialog) error {
 	userID := ctxutil.UserIDFromContext(ctx).ToInt()
 	query := `UPDATE dialog_participants
@@ -204,6 +259,87 @@ func (r *DialogRepository) Update(ctx context.Context, dialog *entity.Dialog) er
 	return nil
 }
 
+// ClearHistory clears messages of the dialog sent so far on the side of the current user.
+// The hidden dialog isn't listed until a new message arrives.
+func (r *DialogRepository) ClearHistory(ctx context.Context, dialogID int, hide bool) error {
+	userID := ctxutil.UserIDFromContext(ctx).ToInt()
+	query := `UPDATE dialog_participants
+	SET cleared_before_message_id = COALESCE((
+			SELECT MAX(id) FROM messages
+			WHERE chat_id = $1
+			  AND chat_type = 'dialog'), cleared_before_message_id),
+		hidden = $3
+	WHERE chat_id = $1
+	  AND user_id = $2`
+
+	execRes, err := r.getter.Get(ctx).Exec(ctx, query, dialogID, userID, hide)
+	if err != nil {
+		return fmt.Errorf("exec query to clear dialog history: %v", err)
+	}
+
+	if execRes.RowsAffected() == 0 {
+		return fmt.Errorf("%w: there aren't affected rows", entity.ErrDialogNotFound)
+	}
+	return nil
+}
+
+// Delete deletes the dialog of the current user together with its messages for both sides.
+func (r *DialogRepository) Delete(ctx context.Context, dialogID int) error {
+	userID := ctxutil.UserIDFromContext(ctx).ToInt()
+	query := `DELETE FROM chats c
+	USING dialog_participants dp
+	WHERE c.id = dp.chat_id
+	  AND c.id = $1
+	  AND c.type = 'dialog'
+	  AND dp.user_id = $2`
+
+	execRes, err := r.getter.Get(ctx).Exec(ctx, query, dialogID, userID)
+	if err != nil {
+		return fmt.Errorf("exec query to delete dialog: %v", err)
+	}
+
+	if execRes.RowsAffected() == 0 {
+		return fmt.Errorf("%w: there aren't affected rows", entity.ErrDialogNotFound)
+	}
+	return nil
+}
+
+func (r *DialogRepository) CreateRequest(ctx context.Context, request *entity.DialogRequest) error {
+	query := `INSERT INTO dialog_requests
+		(chat_id, sender_id, recipient_id, status, created_at)
+	VALUES ($1, $2, $3, $4, $5)`
+
+	_, err := r.getter.Get(ctx).Exec(ctx, query,
+		request.DialogID, request.SenderID, request.RecipientID,
+		request.Status, request.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("exec query to insert dialog request: %v", err)
+	}
+	return nil
+}
+
+// ResolveRequest sets the status of the pending message request
+// which was sent to the current user in the dialog.
+func (r *DialogRepository) ResolveRequest(ctx context.Context, dialogID int, status entity.DialogRequestStatus) error {
+	userID := ctxutil.UserIDFromContext(ctx).ToInt()
+	query := `UPDATE dialog_requests
+	SET status = $3, updated_at = now()
+	WHERE chat_id = $1
+	  AND recipient_id = $2
+	  AND status = 'pending'`
+
+	execRes, err := r.getter.Get(ctx).Exec(ctx, query, dialogID, userID, status)
+	if err != nil {
+		return fmt.Errorf("exec query to update dialog request: %v", err)
+	}
+
+	if execRes.RowsAffected() == 0 {
+		return fmt.Errorf("%w: there aren't affected rows", entity.ErrDialogRequestNotFound)
+	}
+	return nil
+}
+
 func getUname(ids ...int) string {
 	sort.Ints(ids)
 
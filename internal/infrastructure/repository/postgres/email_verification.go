@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Chatyx/backend/internal/entity"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type EmailVerificationRepository struct {
+	pool   *pgxpool.Pool
+	getter dbClientGetter
+}
+
+func NewEmailVerificationRepository(pool *pgxpool.Pool) *EmailVerificationRepository {
+	return &EmailVerificationRepository{
+		pool:   pool,
+		getter: dbClientGetter{pool: pool},
+	}
+}
+
+// Create creates the verification token replacing the previous tokens of the user.
+// Only the hash of the token is stored.
+func (r *EmailVerificationRepository) Create(ctx context.Context, verification entity.EmailVerification) error {
+	query := `DELETE FROM email_verifications
+	WHERE user_id = $1`
+
+	if _, err := r.getter.Get(ctx).Exec(ctx, query, verification.UserID); err != nil {
+		return fmt.Errorf("exec query to delete previous email verifications: %v", err)
+	}
+
+	query = `INSERT INTO email_verifications
+		(token_hash, user_id, email, expires_at, created_at)
+	VALUES ($1, $2, $3, $4, $5)`
+
+	_, err := r.getter.Get(ctx).Exec(ctx, query,
+		hashVerificationToken(verification.Token), verification.UserID, verification.Email,
+		verification.ExpiresAt, verification.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("exec query to insert email verification: %v", err)
+	}
+	return nil
+}
+
+// ExistsUnexpired checks whether the user has a verification token which isn't expired by the time.
+func (r *EmailVerificationRepository) ExistsUnexpired(ctx context.Context, userID int, now time.Time) (bool, error) {
+	query := `SELECT EXISTS(
+		SELECT 1 FROM email_verifications
+		WHERE user_id = $1 AND expires_at > $2)`
+
+	var exists bool
+	if err := r.getter.Get(ctx).QueryRow(ctx, query, userID, now).Scan(&exists); err != nil {
+		return false, fmt.Errorf("exec query to check unexpired email verification: %v", err)
+	}
+	return exists, nil
+}
+
+// GetWithDelete gets the verification by the token and deletes it, so the token can't be used twice.
+func (r *EmailVerificationRepository) GetWithDelete(ctx context.Context, token string) (entity.EmailVerification, error) {
+	query := `DELETE FROM email_verifications
+	WHERE token_hash = $1
+	RETURNING user_id, email, expires_at, created_at`
+
+	verification := entity.EmailVerification{Token: token}
+
+	err := r.getter.Get(ctx).QueryRow(ctx, query, hashVerificationToken(token)).Scan(
+		&verification.UserID, &verification.Email,
+		&verification.ExpiresAt, &verification.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.EmailVerification{}, fmt.Errorf("%w: %v", entity.ErrInvalidVerificationToken, err)
+		}
+
+		return entity.EmailVerification{}, fmt.Errorf("exec query to delete email verification: %v", err)
+	}
+	return verification, nil
+}
+
+func hashVerificationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/Chatyx/backend/pkg/log"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -29,15 +30,17 @@ func NewGroupRepository(pool *pgxpool.Pool) *GroupRepository {
 func (r *GroupRepository) List(ctx context.Context) ([]entity.Group, error) {
 	userID := ctxutil.UserIDFromContext(ctx).ToInt()
 	query := `SELECT c.id,
+		COALESCE(c.uname, ''),
 		c.name,
 		c.description,
 		c.created_at
 	FROM chats c
 		INNER JOIN group_participants gp
 			ON c.id = gp.chat_id
-	WHERE gp.user_id = $1 AND c.type = 'group'`
+	WHERE gp.user_id = $1 AND gp.status IN ($2, $3)
+		AND c.type = 'group' AND c.deleted_at IS NULL`
 
-	rows, err := r.getter.Get(ctx).Query(ctx, query, userID)
+	rows, err := r.getter.Get(ctx).Query(ctx, query, userID, entity.JoinedStatus, entity.MutedStatus)
 	if err != nil {
 		return nil, fmt.Errorf("exec query to select groups: %v", err)
 	}
@@ -49,7 +52,7 @@ func (r *GroupRepository) List(ctx context.Context) ([]entity.Group, error) {
 		var group entity.Group
 
 		err = rows.Scan(
-			&group.ID, &group.Name,
+			&group.ID, &group.Uname, &group.Name,
 			&group.Description, &group.CreatedAt,
 		)
 		if err != nil {
@@ -89,21 +92,29 @@ func (r *GroupRepository) Create(ctx context.Context, group *entity.Group) error
 	}
 
 	query := `INSERT INTO chats
-		(name, type, description, created_at)
-	VALUES ($1, $2, $3, $4)
+		(uname, name, type, description, created_at)
+	VALUES (NULLIF($1, ''), $2, $3, $4, $5)
 	RETURNING id`
 
-	err = tx.QueryRow(ctx, query, group.Name, "group", group.Description, group.CreatedAt).Scan(&group.ID)
+	err = tx.QueryRow(ctx, query,
+		group.Uname, group.Name, "group",
+		group.Description, group.CreatedAt,
+	).Scan(&group.ID)
 	if err != nil {
+		pgErr := &pgconn.PgError{}
+		if errors.As(err, &pgErr) && isChatUnameUniqueViolation(pgErr) {
+			return fmt.Errorf("%w: %s", entity.ErrSuchGroupUnameAlreadyExists, pgErr.Message)
+		}
+
 		return fmt.Errorf("exec query to insert group: %v", err)
 	}
 
 	userID := ctxutil.UserIDFromContext(ctx).ToInt()
 	query = `INSERT INTO group_participants
-		(chat_id, user_id, is_admin)
+		(chat_id, user_id, role)
 	VALUES ($1, $2, $3)`
 
-	if _, err = tx.Exec(ctx, query, group.ID, userID, true); err != nil {
+	if _, err = tx.Exec(ctx, query, group.ID, userID, entity.OwnerRole); err != nil {
 		return fmt.Errorf("exec query to insert group participant: %v", err)
 	}
 
@@ -116,11 +127,12 @@ func (r *GroupRepository) Create(ctx context.Context, group *entity.Group) error
 	return nil
 }
 
-func (r *GroupRepository) GetByID(ctx context.Context, groupID int) (entity.Group, error) {
+func (r *GroupRepository) GetByID(ctx context.Context, groupID int, withLock bool) (entity.Group, error) {
 	var group entity.Group
 
 	userID := ctxutil.UserIDFromContext(ctx).ToInt()
 	query := `SELECT c.id,
+		COALESCE(c.uname, ''),
 		c.name,
 		c.description,
 		c.created_at
@@ -129,10 +141,15 @@ func (r *GroupRepository) GetByID(ctx context.Context, groupID int) (entity.Grou
 			ON c.id = gp.chat_id
 	WHERE c.id = $1
 	  AND c.type = 'group'
+	  AND c.deleted_at IS NULL
 	  AND gp.user_id = $2`
 
+	if withLock {
+		query += " FOR UPDATE OF c"
+	}
+
 	err := r.getter.Get(ctx).QueryRow(ctx, query, groupID, userID).Scan(
-		&group.ID, &group.Name,
+		&group.ID, &group.Uname, &group.Name,
 		&group.Description, &group.CreatedAt,
 	)
 	if err != nil {
@@ -146,46 +163,162 @@ func (r *GroupRepository) GetByID(ctx context.Context, groupID int) (entity.Grou
 	return group, nil
 }
 
+// GetByUname gets a public group by its handle regardless of the current user membership.
+func (r *GroupRepository) GetByUname(ctx context.Context, uname string) (entity.Group, error) {
+	var group entity.Group
+
+	query := `SELECT c.id,
+		c.uname,
+		c.name,
+		c.description,
+		c.created_at
+	FROM chats c
+	WHERE c.uname = $1
+	  AND c.type = 'group'
+	  AND c.deleted_at IS NULL`
+
+	err := r.getter.Get(ctx).QueryRow(ctx, query, uname).Scan(
+		&group.ID, &group.Uname, &group.Name,
+		&group.Description, &group.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return group, fmt.Errorf("%w: %v", entity.ErrGroupNotFound, err)
+		}
+
+		return group, fmt.Errorf("exec query to select group by uname: %v", err)
+	}
+
+	return group, nil
+}
+
 func (r *GroupRepository) Update(ctx context.Context, group *entity.Group) error {
 	userID := ctxutil.UserIDFromContext(ctx).ToInt()
 	query := `UPDATE chats AS c
-	SET	name        = $3,
+	SET	uname       = NULLIF($7, ''),
+		name        = $3,
 		description = $4,
 		updated_at  = $5
 	FROM group_participants AS gp
 	WHERE c.id = gp.chat_id
 	  AND c.id = $1
 	  AND c.type = 'group'
+	  AND c.deleted_at IS NULL
 	  AND gp.user_id = $2
-	  AND gp.is_admin IS TRUE
+	  AND gp.status = 'joined'
+	  AND gp.role::text = ANY ($6::text[])
 	RETURNING created_at`
 
 	err := r.getter.Get(ctx).QueryRow(ctx, query,
 		group.ID, userID,
 		group.Name, group.Description, time.Now(),
+		rolesWith(entity.EditInfoPermission), group.Uname,
 	).Scan(&group.CreatedAt)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return fmt.Errorf("%w: %v", entity.ErrGroupNotFound, err)
 		}
+
+		pgErr := &pgconn.PgError{}
+		if errors.As(err, &pgErr) && isChatUnameUniqueViolation(pgErr) {
+			return fmt.Errorf("%w: %s", entity.ErrSuchGroupUnameAlreadyExists, pgErr.Message)
+		}
 		return fmt.Errorf("exec query to update group: %v", err)
 	}
 
 	return nil
 }
 
+// GetSettings gets settings of the group which the current user participates in.
+func (r *GroupRepository) GetSettings(ctx context.Context, groupID int, withLock bool) (entity.GroupSettings, error) {
+	var (
+		settings         entity.GroupSettings
+		slowModeInterval int
+	)
+
+	userID := ctxutil.UserIDFromContext(ctx).ToInt()
+	query := `SELECT c.only_admins_can_post,
+		c.slow_mode_interval,
+		c.members_can_invite
+	FROM chats c
+		INNER JOIN group_participants gp
+			ON c.id = gp.chat_id
+	WHERE c.id = $1
+	  AND c.type = 'group'
+	  AND c.deleted_at IS NULL
+	  AND gp.user_id = $2`
+
+	if withLock {
+		query += " FOR UPDATE OF c"
+	}
+
+	err := r.getter.Get(ctx).QueryRow(ctx, query, groupID, userID).Scan(
+		&settings.OnlyAdminsCanPost, &slowModeInterval, &settings.MembersCanInvite,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return settings, fmt.Errorf("%w: %v", entity.ErrGroupNotFound, err)
+		}
+
+		return settings, fmt.Errorf("exec query to select group settings: %v", err)
+	}
+
+	settings.SlowModeInterval = time.Duration(slowModeInterval) * time.Second
+	return settings, nil
+}
+
+// UpdateSettings updates settings of the group if the current user can edit its info.
+func (r *GroupRepository) UpdateSettings(ctx context.Context, groupID int, settings entity.GroupSettings) error {
+	userID := ctxutil.UserIDFromContext(ctx).ToInt()
+	query := `UPDATE chats AS c
+	SET	only_admins_can_post = $3,
+		slow_mode_interval   = $4,
+		members_can_invite   = $5,
+		updated_at           = $6
+	FROM group_participants AS gp
+	WHERE c.id = gp.chat_id
+	  AND c.id = $1
+	  AND c.type = 'group'
+	  AND c.deleted_at IS NULL
+	  AND gp.user_id = $2
+	  AND gp.status = 'joined'
+	  AND gp.role::text = ANY ($7::text[])`
+
+	execRes, err := r.getter.Get(ctx).Exec(ctx, query,
+		groupID, userID,
+		settings.OnlyAdminsCanPost, int(settings.SlowModeInterval/time.Second),
+		settings.MembersCanInvite, time.Now(),
+		rolesWith(entity.EditInfoPermission),
+	)
+	if err != nil {
+		return fmt.Errorf("exec query to update group settings: %v", err)
+	}
+
+	if execRes.RowsAffected() == 0 {
+		return fmt.Errorf("%w: there aren't affected rows", entity.ErrGroupNotFound)
+	}
+	return nil
+}
+
+// Delete marks the group as deleted. The group with its messages and participants
+// is kept until it's purged, so it can be restored in the meantime.
 func (r *GroupRepository) Delete(ctx context.Context, groupID int) error {
 	userID := ctxutil.UserIDFromContext(ctx).ToInt()
-	query := `DELETE
-	FROM chats AS c
-		USING group_participants gp
+	query := `UPDATE chats AS c
+	SET	deleted_at = $4
+	FROM group_participants AS gp
 	WHERE c.id = gp.chat_id
 	  AND c.id = $1
 	  AND c.type = 'group'
+	  AND c.deleted_at IS NULL
 	  AND gp.user_id = $2
-	  AND gp.is_admin IS TRUE`
+	  AND gp.status = 'joined'
+	  AND gp.role::text = ANY ($3::text[])`
 
-	execRes, err := r.getter.Get(ctx).Exec(ctx, query, groupID, userID)
+	execRes, err := r.getter.Get(ctx).Exec(ctx, query,
+		groupID, userID,
+		rolesWith(entity.DeleteGroupPermission), time.Now(),
+	)
 	if err != nil {
 		return fmt.Errorf("exec query to delete group: %v", err)
 	}
@@ -195,3 +328,70 @@ func (r *GroupRepository) Delete(ctx context.Context, groupID int) error {
 	}
 	return nil
 }
+
+// Restore restores the group deleted after the specified time if the current user is its owner.
+func (r *GroupRepository) Restore(ctx context.Context, groupID int, deletedAfter time.Time) (entity.Group, error) {
+	var group entity.Group
+
+	userID := ctxutil.UserIDFromContext(ctx).ToInt()
+	query := `UPDATE chats AS c
+	SET	deleted_at = NULL,
+		updated_at = $5
+	FROM group_participants AS gp
+	WHERE c.id = gp.chat_id
+	  AND c.id = $1
+	  AND c.type = 'group'
+	  AND c.deleted_at > $4
+	  AND gp.user_id = $2
+	  AND gp.status = 'joined'
+	  AND gp.role = $3
+	RETURNING c.id, COALESCE(c.uname, ''), c.name, c.description, c.created_at`
+
+	err := r.getter.Get(ctx).QueryRow(ctx, query,
+		groupID, userID, entity.OwnerRole,
+		deletedAfter, time.Now(),
+	).Scan(
+		&group.ID, &group.Uname, &group.Name,
+		&group.Description, &group.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return group, fmt.Errorf("%w: %v", entity.ErrGroupNotFound, err)
+		}
+
+		return group, fmt.Errorf("exec query to restore group: %v", err)
+	}
+
+	return group, nil
+}
+
+// PurgeDeleted deletes groups deleted before the specified time for good
+// along with their messages and participants.
+func (r *GroupRepository) PurgeDeleted(ctx context.Context, deletedBefore time.Time) (int, error) {
+	query := `DELETE
+	FROM chats
+	WHERE type = 'group'
+	  AND deleted_at < $1`
+
+	execRes, err := r.getter.Get(ctx).Exec(ctx, query, deletedBefore)
+	if err != nil {
+		return 0, fmt.Errorf("exec query to purge deleted groups: %v", err)
+	}
+	return int(execRes.RowsAffected()), nil
+}
+
+func isChatUnameUniqueViolation(pgErr *pgconn.PgError) bool {
+	return pgErr.Code == uniqueViolationCode && pgErr.ConstraintName == "chats_uname_key"
+}
+
+// rolesWith returns names of the roles having the permission,
+// so permissions are enforced by the same matrix the services use.
+func rolesWith(permission entity.GroupPermission) []string {
+	roles := entity.MxRolePermissions.RolesWith(permission)
+
+	names := make([]string, len(roles))
+	for i, role := range roles {
+		names[i] = role.String()
+	}
+	return names
+}
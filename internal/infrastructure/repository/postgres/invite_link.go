@@ -0,0 +1,131 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Chatyx/backend/internal/entity"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type GroupInviteLinkRepository struct {
+	pool   *pgxpool.Pool
+	getter dbClientGetter
+}
+
+func NewGroupInviteLinkRepository(pool *pgxpool.Pool) *GroupInviteLinkRepository {
+	return &GroupInviteLinkRepository{
+		pool:   pool,
+		getter: dbClientGetter{pool: pool},
+	}
+}
+
+func (r *GroupInviteLinkRepository) List(ctx context.Context, groupID int) ([]entity.GroupInviteLink, error) {
+	query := `SELECT l.token, l.chat_id, l.creator_id,
+		l.max_uses, l.uses, l.requires_approval, l.expires_at,
+		l.revoked_at, l.created_at
+	FROM group_invite_links l
+	WHERE l.chat_id = $1
+	ORDER BY l.created_at`
+
+	rows, err := r.getter.Get(ctx).Query(ctx, query, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("exec query to select group invite links: %v", err)
+	}
+	defer rows.Close()
+
+	var links []entity.GroupInviteLink
+
+	for rows.Next() {
+		var link entity.GroupInviteLink
+
+		err = rows.Scan(
+			&link.Token, &link.GroupID, &link.CreatorID,
+			&link.MaxUses, &link.Uses, &link.RequiresApproval, &link.ExpiresAt,
+			&link.RevokedAt, &link.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scan group invite link row: %v", err)
+		}
+
+		links = append(links, link)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("reading group invite link rows: %v", err)
+	}
+	return links, nil
+}
+
+func (r *GroupInviteLinkRepository) Get(ctx context.Context, token string, withLock bool) (entity.GroupInviteLink, error) {
+	var link entity.GroupInviteLink
+
+	query := `SELECT l.token, l.chat_id, l.creator_id,
+		l.max_uses, l.uses, l.requires_approval, l.expires_at,
+		l.revoked_at, l.created_at
+	FROM group_invite_links l
+		INNER JOIN chats c
+			ON c.id = l.chat_id
+	WHERE l.token = $1 AND c.deleted_at IS NULL`
+
+	if withLock {
+		query += " FOR UPDATE OF l"
+	}
+
+	err := r.getter.Get(ctx).QueryRow(ctx, query, token).Scan(
+		&link.Token, &link.GroupID, &link.CreatorID,
+		&link.MaxUses, &link.Uses, &link.RequiresApproval, &link.ExpiresAt,
+		&link.RevokedAt, &link.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return link, fmt.Errorf("%w: %v", entity.ErrGroupInviteLinkNotFound, err)
+		}
+
+		return link, fmt.Errorf("exec query to select group invite link: %v", err)
+	}
+
+	return link, nil
+}
+
+func (r *GroupInviteLinkRepository) Create(ctx context.Context, link *entity.GroupInviteLink) error {
+	query := `INSERT INTO group_invite_links
+		(token, chat_id, creator_id, max_uses, requires_approval, expires_at, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	_, err := r.getter.Get(ctx).Exec(ctx, query,
+		link.Token, link.GroupID, link.CreatorID,
+		link.MaxUses, link.RequiresApproval,
+		link.ExpiresAt, link.CreatedAt,
+	)
+	if err != nil {
+		pgErr := &pgconn.PgError{}
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode {
+			return fmt.Errorf("%w: %s", entity.ErrGroupNotFound, pgErr.Message)
+		}
+
+		return fmt.Errorf("exec query to insert group invite link: %v", err)
+	}
+
+	return nil
+}
+
+func (r *GroupInviteLinkRepository) Update(ctx context.Context, link *entity.GroupInviteLink) error {
+	query := `UPDATE group_invite_links
+	SET uses = $2, revoked_at = $3
+	WHERE token = $1`
+
+	execRes, err := r.getter.Get(ctx).Exec(ctx, query, link.Token, link.Uses, link.RevokedAt)
+	if err != nil {
+		return fmt.Errorf("exec query to update group invite link: %v", err)
+	}
+
+	if execRes.RowsAffected() == 0 {
+		return fmt.Errorf("%w: there aren't affected rows", entity.ErrGroupInviteLinkNotFound)
+	}
+	return nil
+}
@@ -2,13 +2,16 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 
 	"github.com/Chatyx/backend/internal/dto"
 	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/ctxutil"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -27,13 +30,7 @@ func NewMessageRepository(pool *pgxpool.Pool) *MessageRepository {
 }
 
 func (r *MessageRepository) List(ctx context.Context, obj dto.MessageList) ([]entity.Message, error) {
-	b := builder.Select("id", "sender_id", "chat_id", "chat_type",
-		"content", "content_type", "is_service", "sent_at", "delivered_at").
-		From("messages").
-		Where(sq.And{
-			sq.Eq{"chat_id": obj.ChatID.ID},
-			sq.Eq{"chat_type": obj.ChatID.Type},
-		})
+	b := selectMessages(ctx, obj.ChatID)
 
 	if obj.Sort == dto.DescSort {
 		if obj.IDAfter == 0 {
@@ -60,11 +57,7 @@ func (r *MessageRepository) List(ctx context.Context, obj dto.MessageList) ([]en
 	for rows.Next() {
 		var message entity.Message
 
-		err = rows.Scan(
-			&message.ID, &message.SenderID, &message.ChatID.ID, &message.ChatID.Type,
-			&message.Content, &message.ContentType, &message.IsService,
-			&message.SentAt, &message.DeliveredAt,
-		)
+		message, err = scanMessage(rows)
 		if err != nil {
 			return nil, fmt.Errorf("scan message row: %v", err)
 		}
@@ -78,22 +71,109 @@ func (r *MessageRepository) List(ctx context.Context, obj dto.MessageList) ([]en
 	return messages, nil
 }
 
+// Get gets the message of the chat. Messages cleared by the current user
+// on their side of the dialog aren't found as well as they aren't listed.
+func (r *MessageRepository) Get(ctx context.Context, chatID entity.ChatID, id int) (entity.Message, error) {
+	query, args, err := selectMessages(ctx, chatID).Where(sq.Eq{"id": id}).ToSql()
+	if err != nil {
+		return entity.Message{}, fmt.Errorf("build select message query: %v", err)
+	}
+
+	message, err := scanMessage(r.getter.Get(ctx).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Message{}, fmt.Errorf("%w: %v", entity.ErrMessageNotFound, err)
+		}
+
+		return entity.Message{}, fmt.Errorf("exec query to select message: %v", err)
+	}
+	return message, nil
+}
+
 func (r *MessageRepository) Create(ctx context.Context, message *entity.Message) error {
+	var (
+		serviceAction *entity.ServiceActionType
+		serviceParams map[string]string
+	)
+	if message.ServiceAction != nil {
+		serviceAction = &message.ServiceAction.Type
+		serviceParams = message.ServiceAction.Params
+	}
+
 	query, args, err := builder.
 		Insert("messages").
 		Columns("sender_id", "chat_id", "chat_type",
-			"content", "content_type", "is_service", "sent_at").
+			"content", "content_type", "is_service",
+			"service_action", "service_params", "forwarded_from_id", "sent_at").
 		Values(message.SenderID, message.ChatID.ID, message.ChatID.Type,
-			message.Content, message.ContentType, message.IsService, message.SentAt).
-		Suffix("RETURNING id").
+			message.Content, message.ContentType, message.IsService,
+			serviceAction, serviceParams, message.ForwardedFromID, message.SentAt).
+		Suffix("RETURNING id, chat_id").
 		ToSql()
 	if err != nil {
 		return fmt.Errorf("build insert message query: %v", err)
 	}
 
+	// The last message of the chat is moved forward in the same statement,
+	// so the chat list never points to a message which isn't the last one.
+	query = `WITH m AS (` + query + `),
+	lm AS (
+		INSERT INTO last_messages (chat_id, message_id)
+		SELECT chat_id, id FROM m
+		ON CONFLICT (chat_id) DO UPDATE
+			SET message_id = GREATEST(last_messages.message_id, EXCLUDED.message_id))
+	SELECT id FROM m`
+
 	err = r.getter.Get(ctx).QueryRow(ctx, query, args...).Scan(&message.ID)
 	if err != nil {
 		return fmt.Errorf("exec query to insert message: %v", err)
 	}
 	return nil
 }
+
+func selectMessages(ctx context.Context, chatID entity.ChatID) sq.SelectBuilder {
+	b := builder.Select("id", "sender_id", "chat_id", "chat_type",
+		"content", "content_type", "is_service", "service_action", "service_params",
+		"forwarded_from_id", "sent_at", "delivered_at").
+		From("messages").
+		Where(sq.And{
+			sq.Eq{"chat_id": chatID.ID},
+			sq.Eq{"chat_type": chatID.Type},
+		})
+
+	if chatID.Type == entity.DialogChatType {
+		// Messages cleared by the current user on their side of the dialog aren't listed.
+		userID := ctxutil.UserIDFromContext(ctx).ToInt()
+		b = b.Where(`id > COALESCE((
+			SELECT cleared_before_message_id FROM dialog_participants
+			WHERE chat_id = ?
+			  AND user_id = ?), 0)`, chatID.ID, userID)
+	}
+	return b
+}
+
+func scanMessage(row pgx.Row) (entity.Message, error) {
+	var (
+		message       entity.Message
+		serviceAction *entity.ServiceActionType
+		serviceParams map[string]string
+	)
+
+	err := row.Scan(
+		&message.ID, &message.SenderID, &message.ChatID.ID, &message.ChatID.Type,
+		&message.Content, &message.ContentType, &message.IsService,
+		&serviceAction, &serviceParams, &message.ForwardedFromID,
+		&message.SentAt, &message.DeliveredAt,
+	)
+	if err != nil {
+		return entity.Message{}, err
+	}
+
+	if serviceAction != nil {
+		message.ServiceAction = &entity.ServiceAction{
+			Type:   *serviceAction,
+			Params: serviceParams,
+		}
+	}
+	return message, nil
+}
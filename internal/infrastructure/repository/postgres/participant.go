@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/Chatyx/backend/internal/entity"
 
@@ -25,9 +26,12 @@ func NewGroupParticipantRepository(pool *pgxpool.Pool) *GroupParticipantReposito
 }
 
 func (r *GroupParticipantRepository) List(ctx context.Context, groupID int) ([]entity.GroupParticipant, error) {
-	query := `SELECT gp.chat_id, gp.user_id, gp.status, gp.is_admin
+	query := `SELECT gp.chat_id, gp.user_id, gp.status, gp.role,
+		gp.joined_at, gp.restricted_until
 	FROM group_participants gp
-	WHERE gp.chat_id = $1`
+		INNER JOIN chats c
+			ON c.id = gp.chat_id
+	WHERE gp.chat_id = $1 AND c.deleted_at IS NULL`
 
 	rows, err := r.getter.Get(ctx).Query(ctx, query, groupID)
 	if err != nil {
@@ -42,7 +46,8 @@ func (r *GroupParticipantRepository) List(ctx context.Context, groupID int) ([]e
 
 		err = rows.Scan(
 			&participant.GroupID, &participant.UserID,
-			&participant.Status, &participant.IsAdmin,
+			&participant.Status, &participant.Role,
+			&participant.JoinedAt, &participant.RestrictedUntil,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("scan group participant row: %v", err)
@@ -60,17 +65,21 @@ func (r *GroupParticipantRepository) List(ctx context.Context, groupID int) ([]e
 func (r *GroupParticipantRepository) Get(ctx context.Context, groupID, userID int, withLock bool) (entity.GroupParticipant, error) {
 	var participant entity.GroupParticipant
 
-	query := `SELECT gp.chat_id, gp.user_id, gp.status, gp.is_admin
+	query := `SELECT gp.chat_id, gp.user_id, gp.status, gp.role,
+		gp.joined_at, gp.restricted_until
 	FROM group_participants gp
-	WHERE gp.chat_id = $1 AND gp.user_id = $2`
+		INNER JOIN chats c
+			ON c.id = gp.chat_id
+	WHERE gp.chat_id = $1 AND gp.user_id = $2 AND c.deleted_at IS NULL`
 
 	if withLock {
-		query += " FOR UPDATE"
+		query += " FOR UPDATE OF gp"
 	}
 
 	err := r.getter.Get(ctx).QueryRow(ctx, query, groupID, userID).Scan(
 		&participant.GroupID, &participant.UserID,
-		&participant.Status, &participant.IsAdmin,
+		&participant.Status, &participant.Role,
+		&participant.JoinedAt, &participant.RestrictedUntil,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -83,27 +92,65 @@ func (r *GroupParticipantRepository) Get(ctx context.Context, groupID, userID in
 	return participant, nil
 }
 
-func (r *GroupParticipantRepository) Update(ctx context.Context, participant *entity.GroupParticipant) error {
-	query := "UPDATE group_participants SET status = $3 WHERE chat_id = $1 AND user_id = $2"
+// Count counts participants who are in the group. The lock prevents adding participants
+// to the group concurrently until the end of the transaction.
+func (r *GroupParticipantRepository) Count(ctx context.Context, groupID int, withLock bool) (int, error) {
+	if withLock {
+		query := "SELECT id FROM chats WHERE id = $1 AND type = 'group' AND deleted_at IS NULL FOR UPDATE"
 
-	execRes, err := r.getter.Get(ctx).Exec(ctx, query, participant.GroupID, participant.UserID, participant.Status)
-	if err != nil {
-		return fmt.Errorf("exec query to update group participant: %v", err)
+		var id int
+		if err := r.getter.Get(ctx).QueryRow(ctx, query, groupID).Scan(&id); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return 0, fmt.Errorf("%w: %v", entity.ErrGroupNotFound, err)
+			}
+
+			return 0, fmt.Errorf("exec query to lock group: %v", err)
+		}
 	}
 
-	if execRes.RowsAffected() == 0 {
-		return fmt.Errorf("%w: there aren't affected rows", entity.ErrGroupParticipantNotFound)
+	query := `SELECT count(*)
+	FROM group_participants gp
+	WHERE gp.chat_id = $1 AND gp.status IN ('joined', 'muted')`
+
+	var count int
+	if err := r.getter.Get(ctx).QueryRow(ctx, query, groupID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("exec query to count group participants: %v", err)
+	}
+	return count, nil
+}
+
+func (r *GroupParticipantRepository) Update(ctx context.Context, participant *entity.GroupParticipant) error {
+	// Participants returning to the group are considered as just joined,
+	// unmuted ones have been in the group all along.
+	query := `UPDATE group_participants
+	SET status = $3, role = $4, restricted_until = $5,
+	    joined_at = CASE WHEN $3 = 'joined' AND status NOT IN ('joined', 'muted') THEN now() ELSE joined_at END
+	WHERE chat_id = $1 AND user_id = $2
+	RETURNING joined_at`
+
+	err := r.getter.Get(ctx).QueryRow(ctx, query,
+		participant.GroupID, participant.UserID,
+		participant.Status, participant.Role,
+		participant.RestrictedUntil,
+	).Scan(&participant.JoinedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%w: there aren't affected rows", entity.ErrGroupParticipantNotFound)
+		}
+
+		return fmt.Errorf("exec query to update group participant: %v", err)
 	}
 	return nil
 }
 
 func (r *GroupParticipantRepository) Create(ctx context.Context, participant *entity.GroupParticipant) error {
-	query := "INSERT INTO group_participants (chat_id, user_id, status, is_admin) VALUES ($1, $2, $3, $4)"
-	_, err := r.getter.Get(ctx).Exec(
+	query := `INSERT INTO group_participants (chat_id, user_id, status, role)
+	VALUES ($1, $2, $3, $4) RETURNING joined_at`
+	err := r.getter.Get(ctx).QueryRow(
 		ctx, query,
 		participant.GroupID, participant.UserID,
-		participant.Status, participant.IsAdmin,
-	)
+		participant.Status, participant.Role,
+	).Scan(&participant.JoinedAt)
 	if err != nil {
 		pgErr := &pgconn.PgError{}
 		if errors.As(err, &pgErr) {
@@ -123,6 +170,79 @@ func (r *GroupParticipantRepository) Create(ctx context.Context, participant *en
 	return nil
 }
 
+// UpdateLastSentAt sets the time when the participant posted to the group last time,
+// unless the participant has already posted after the since time. In that case
+// the time of the last post is returned and the flag of the update is false.
+func (r *GroupParticipantRepository) UpdateLastSentAt(ctx context.Context, groupID, userID int, sentAt, since time.Time) (time.Time, bool, error) {
+	// The guard is re-checked against the latest row version when concurrent
+	// updates are waited for, so only one of them succeeds.
+	query := `UPDATE group_participants
+	SET last_sent_at = $3
+	WHERE chat_id = $1 AND user_id = $2
+	  AND (last_sent_at IS NULL OR last_sent_at <= $4)`
+
+	execRes, err := r.getter.Get(ctx).Exec(ctx, query, groupID, userID, sentAt, since)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("exec query to update last sending time of group participant: %v", err)
+	}
+
+	if execRes.RowsAffected() != 0 {
+		return sentAt, true, nil
+	}
+
+	query = `SELECT COALESCE(last_sent_at, 'epoch'::timestamptz)
+	FROM group_participants
+	WHERE chat_id = $1 AND user_id = $2`
+
+	var lastSentAt time.Time
+	if err = r.getter.Get(ctx).QueryRow(ctx, query, groupID, userID).Scan(&lastSentAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return time.Time{}, false, fmt.Errorf("%w: %v", entity.ErrGroupParticipantNotFound, err)
+		}
+
+		return time.Time{}, false, fmt.Errorf("exec query to select last sending time of group participant: %v", err)
+	}
+	return lastSentAt, false, nil
+}
+
+// LiftExpiredRestrictions unmutes participants and lifts bans which have expired by the time.
+// Banned participants are considered as left, so they can join the group again.
+func (r *GroupParticipantRepository) LiftExpiredRestrictions(ctx context.Context, now time.Time) ([]entity.GroupParticipant, error) {
+	query := `UPDATE group_participants
+	SET status = CASE WHEN status = 'muted' THEN 'joined'::group_participant_status ELSE 'left'::group_participant_status END,
+	    restricted_until = NULL
+	WHERE status IN ('muted', 'banned') AND restricted_until <= $1
+	RETURNING chat_id, user_id, status, role, joined_at`
+
+	rows, err := r.getter.Get(ctx).Query(ctx, query, now)
+	if err != nil {
+		return nil, fmt.Errorf("exec query to lift expired restrictions: %v", err)
+	}
+	defer rows.Close()
+
+	var participants []entity.GroupParticipant
+
+	for rows.Next() {
+		var participant entity.GroupParticipant
+
+		err = rows.Scan(
+			&participant.GroupID, &participant.UserID,
+			&participant.Status, &participant.Role,
+			&participant.JoinedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scan group participant row: %v", err)
+		}
+
+		participants = append(participants, participant)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("reading group participant rows: %v", err)
+	}
+	return participants, nil
+}
+
 func isGroupParticipantUniqueViolation(pgErr *pgconn.PgError) bool {
 	return pgErr.Code == uniqueViolationCode && pgErr.ConstraintName == "group_participants_pkey"
 }
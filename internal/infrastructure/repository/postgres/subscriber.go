@@ -0,0 +1,144 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Chatyx/backend/internal/entity"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ChannelSubscriberRepository struct {
+	pool   *pgxpool.Pool
+	getter dbClientGetter
+}
+
+func NewChannelSubscriberRepository(pool *pgxpool.Pool) *ChannelSubscriberRepository {
+	return &ChannelSubscriberRepository{
+		pool:   pool,
+		getter: dbClientGetter{pool: pool},
+	}
+}
+
+func (r *ChannelSubscriberRepository) Get(ctx context.Context, channelID, userID int, withLock bool) (entity.ChannelSubscriber, error) {
+	var subscriber entity.ChannelSubscriber
+
+	query := `SELECT cs.chat_id, cs.user_id, cs.is_admin, cs.subscribed_at
+	FROM channel_subscribers cs
+	WHERE cs.chat_id = $1 AND cs.user_id = $2`
+
+	if withLock {
+		query += " FOR UPDATE"
+	}
+
+	err := r.getter.Get(ctx).QueryRow(ctx, query, channelID, userID).Scan(
+		&subscriber.ChannelID, &subscriber.UserID,
+		&subscriber.IsAdmin, &subscriber.SubscribedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return subscriber, fmt.Errorf("%w: %v", entity.ErrChannelSubscriberNotFound, err)
+		}
+
+		return subscriber, fmt.Errorf("exec query to select channel subscriber: %v", err)
+	}
+
+	return subscriber, nil
+}
+
+// CountAdmins counts admins of the channel. The lock prevents revoking admins
+// of the channel concurrently until the end of the transaction.
+func (r *ChannelSubscriberRepository) CountAdmins(ctx context.Context, channelID int, withLock bool) (int, error) {
+	if withLock {
+		query := "SELECT id FROM chats WHERE id = $1 AND type = 'channel' FOR UPDATE"
+
+		var id int
+		if err := r.getter.Get(ctx).QueryRow(ctx, query, channelID).Scan(&id); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return 0, fmt.Errorf("%w: %v", entity.ErrChannelNotFound, err)
+			}
+
+			return 0, fmt.Errorf("exec query to lock channel: %v", err)
+		}
+	}
+
+	query := `SELECT count(*)
+	FROM channel_subscribers cs
+	WHERE cs.chat_id = $1 AND cs.is_admin`
+
+	var count int
+	if err := r.getter.Get(ctx).QueryRow(ctx, query, channelID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("exec query to count channel admins: %v", err)
+	}
+	return count, nil
+}
+
+func (r *ChannelSubscriberRepository) Create(ctx context.Context, subscriber *entity.ChannelSubscriber) error {
+	// The channel is checked explicitly, since chat ids of groups and dialogs
+	// satisfy the foreign key as well.
+	query := `INSERT INTO channel_subscribers (chat_id, user_id, is_admin)
+	SELECT c.id, $2, $3
+	FROM chats c
+	WHERE c.id = $1 AND c.type = 'channel'
+	RETURNING subscribed_at`
+
+	err := r.getter.Get(ctx).QueryRow(
+		ctx, query,
+		subscriber.ChannelID, subscriber.UserID, subscriber.IsAdmin,
+	).Scan(&subscriber.SubscribedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%w: %v", entity.ErrChannelNotFound, err)
+		}
+
+		pgErr := &pgconn.PgError{}
+		if errors.As(err, &pgErr) && isChannelSubscriberUniqueViolation(pgErr) {
+			return fmt.Errorf("%w: %s", entity.ErrSuchChannelSubscriberAlreadyExists, pgErr.Message)
+		}
+
+		return fmt.Errorf("exec query to create channel subscriber: %v", err)
+	}
+
+	return nil
+}
+
+func (r *ChannelSubscriberRepository) Update(ctx context.Context, subscriber *entity.ChannelSubscriber) error {
+	query := `UPDATE channel_subscribers
+	SET is_admin = $3
+	WHERE chat_id = $1 AND user_id = $2`
+
+	execRes, err := r.getter.Get(ctx).Exec(ctx, query,
+		subscriber.ChannelID, subscriber.UserID, subscriber.IsAdmin,
+	)
+	if err != nil {
+		return fmt.Errorf("exec query to update channel subscriber: %v", err)
+	}
+
+	if execRes.RowsAffected() == 0 {
+		return fmt.Errorf("%w: there aren't affected rows", entity.ErrChannelSubscriberNotFound)
+	}
+	return nil
+}
+
+func (r *ChannelSubscriberRepository) Delete(ctx context.Context, channelID, userID int) error {
+	query := `DELETE FROM channel_subscribers
+	WHERE chat_id = $1 AND user_id = $2`
+
+	execRes, err := r.getter.Get(ctx).Exec(ctx, query, channelID, userID)
+	if err != nil {
+		return fmt.Errorf("exec query to delete channel subscriber: %v", err)
+	}
+
+	if execRes.RowsAffected() == 0 {
+		return fmt.Errorf("%w: there aren't affected rows", entity.ErrChannelSubscriberNotFound)
+	}
+	return nil
+}
+
+func isChannelSubscriberUniqueViolation(pgErr *pgconn.PgError) bool {
+	return pgErr.Code == uniqueViolationCode && pgErr.ConstraintName == "channel_subscribers_pkey"
+}
@@ -31,16 +31,33 @@ func (g dbClientGetter) Get(ctx context.Context) dbClient { //nolint:ireturn //
 	return g.pool
 }
 
+// txBeginner begins transactions, it's satisfied by *pgxpool.Pool.
+type txBeginner interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
 type TransactionManager struct {
-	pool *pgxpool.Pool
+	pool txBeginner
 }
 
 func NewTransactionManager(pool *pgxpool.Pool) *TransactionManager {
 	return &TransactionManager{pool: pool}
 }
 
+// Do runs the function in a transaction. If the context already holds a transaction,
+// a savepoint is used, so the failed function doesn't abort the outer transaction.
+// Errors of the function are wrapped, so callers still can match domain errors.
 func (txm *TransactionManager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
-	tx, err := txm.pool.Begin(ctx)
+	var (
+		tx  pgx.Tx
+		err error
+	)
+
+	if outerTx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		tx, err = outerTx.Begin(ctx)
+	} else {
+		tx, err = txm.pool.Begin(ctx)
+	}
 	if err != nil {
 		return fmt.Errorf("begin transaction: %v", err)
 	}
@@ -52,7 +69,7 @@ func (txm *TransactionManager) Do(ctx context.Context, fn func(ctx context.Conte
 
 	txCtx := context.WithValue(ctx, txKey{}, tx)
 	if err = fn(txCtx); err != nil {
-		return fmt.Errorf("call inner func: %v", err)
+		return fmt.Errorf("call inner func: %w", err)
 	}
 
 	if err = tx.Commit(ctx); err != nil {
@@ -0,0 +1,75 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Chatyx/backend/internal/entity"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeTx struct {
+	pgx.Tx
+	committed bool
+}
+
+func (tx *fakeTx) Commit(context.Context) error {
+	tx.committed = true
+	return nil
+}
+
+func (tx *fakeTx) Rollback(context.Context) error {
+	if tx.committed {
+		return pgx.ErrTxClosed
+	}
+	return nil
+}
+
+type fakeTxBeginner struct {
+	tx *fakeTx
+}
+
+func (b fakeTxBeginner) Begin(context.Context) (pgx.Tx, error) {
+	return b.tx, nil
+}
+
+func TestTransactionManager_Do(t *testing.T) {
+	testCases := []struct {
+		name              string
+		fnErr             error
+		expectedError     error
+		expectedCommitted bool
+	}{
+		{
+			name:              "Successful",
+			expectedCommitted: true,
+		},
+		{
+			name:          "Domain error survives",
+			fnErr:         fmt.Errorf("leave group: %w", entity.ErrGroupNotFound),
+			expectedError: entity.ErrGroupNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			tx := &fakeTx{}
+			txm := &TransactionManager{pool: fakeTxBeginner{tx: tx}}
+
+			err := txm.Do(context.Background(), func(ctx context.Context) error {
+				assert.Equal(t, tx, ctx.Value(txKey{}))
+				return testCase.fnErr
+			})
+			if testCase.expectedError == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, errors.Is(err, testCase.expectedError))
+			}
+			assert.Equal(t, testCase.expectedCommitted, tx.committed)
+		})
+	}
+}
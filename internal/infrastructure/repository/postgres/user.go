@@ -4,15 +4,28 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/Chatyx/backend/internal/dto"
 	"github.com/Chatyx/backend/internal/entity"
 
+	sq "github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// userFullNameExpr must match the expression of the users__full_name_trgm__idx index.
+const userFullNameExpr = "(COALESCE(first_name, '') || ' ' || COALESCE(last_name, ''))"
+
+var likePatternReplacer = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLikePattern escapes the wildcards of LIKE, so the string is matched literally.
+func escapeLikePattern(s string) string {
+	return likePatternReplacer.Replace(s)
+}
+
 type UserRepository struct {
 	pool   *pgxpool.Pool
 	getter dbClientGetter
@@ -25,20 +38,32 @@ func NewUserRepository(pool *pgxpool.Pool) *UserRepository {
 	}
 }
 
-func (r *UserRepository) List(ctx context.Context) ([]entity.User, error) {
-	query := `SELECT id,
-		username,
-		pwd_hash,
-		email,
-		first_name,
-		last_name,
-		birth_date,
-		bio,
-		created_at
-	FROM users
-	WHERE deleted_at IS NULL`
+// List lists users whose username starts with the search string or whose name contains it.
+// Trigram indexes of the users table serve both conditions.
+func (r *UserRepository) List(ctx context.Context, obj dto.UserList) ([]entity.User, error) {
+	b := builder.Select("id", "username", "email",
+		"first_name", "last_name", "birth_date",
+		"bio", "created_at").
+		From("users").
+		Where(sq.Eq{"deleted_at": nil})
+
+	if obj.Search != "" {
+		pattern := escapeLikePattern(obj.Search)
+		b = b.Where(sq.Or{
+			sq.ILike{"username": pattern + "%"},
+			sq.ILike{userFullNameExpr: "%" + pattern + "%"},
+		})
+	}
+	if obj.UsernameAfter != "" {
+		b = b.Where(sq.Gt{"username": obj.UsernameAfter})
+	}
 
-	rows, err := r.getter.Get(ctx).Query(ctx, query)
+	query, args, err := b.OrderBy("username").Limit(uint64(obj.Limit)).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build select users query: %v", err)
+	}
+
+	rows, err := r.getter.Get(ctx).Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("exec query to select users: %v", err)
 	}
@@ -50,9 +75,9 @@ func (r *UserRepository) List(ctx context.Context) ([]entity.User, error) {
 		var user entity.User
 
 		err = rows.Scan(
-			&user.ID, &user.Username, &user.PwdHash,
-			&user.Email, &user.FirstName, &user.LastName,
-			&user.BirthDate, &user.Bio, &user.CreatedAt,
+			&user.ID, &user.Username, &user.Email,
+			&user.FirstName, &user.LastName, &user.BirthDate,
+			&user.Bio, &user.CreatedAt,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("scan user row: %v", err)
@@ -61,7 +86,7 @@ func (r *UserRepository) List(ctx context.Context) ([]entity.User, error) {
 		users = append(users, user)
 	}
 
-	if rows.Err() != nil {
+	if err = rows.Err(); err != nil {
 		return nil, fmt.Errorf("reading user rows: %v", err)
 	}
 	return users, nil
@@ -101,6 +126,7 @@ func (r *UserRepository) GetByID(ctx context.Context, id int) (entity.User, erro
 		last_name,
 		birth_date,
 		bio,
+		email_verified_at,
 		created_at
 	FROM users
 	WHERE id = $1
@@ -118,6 +144,7 @@ func (r *UserRepository) GetByUsername(ctx context.Context, username string) (en
 		last_name,
 		birth_date,
 		bio,
+		email_verified_at,
 		created_at
 	FROM users
 	WHERE username = $1
@@ -126,13 +153,32 @@ func (r *UserRepository) GetByUsername(ctx context.Context, username string) (en
 	return r.getBy(ctx, query, username)
 }
 
+func (r *UserRepository) GetByEmail(ctx context.Context, email string) (entity.User, error) {
+	query := `SELECT id,
+		username,
+		pwd_hash,
+		email,
+		first_name,
+		last_name,
+		birth_date,
+		bio,
+		email_verified_at,
+		created_at
+	FROM users
+	WHERE email = $1
+	  AND deleted_at IS NULL`
+
+	return r.getBy(ctx, query, email)
+}
+
 func (r *UserRepository) getBy(ctx context.Context, query string, args ...any) (entity.User, error) {
 	var user entity.User
 
 	err := r.getter.Get(ctx).QueryRow(ctx, query, args...).Scan(
 		&user.ID, &user.Username, &user.PwdHash,
 		&user.Email, &user.FirstName, &user.LastName,
-		&user.BirthDate, &user.Bio, &user.CreatedAt,
+		&user.BirthDate, &user.Bio, &user.EmailVerifiedAt,
+		&user.CreatedAt,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -145,24 +191,26 @@ func (r *UserRepository) getBy(ctx context.Context, query string, args ...any) (
 	return user, nil
 }
 
+// Update updates the user, the email becomes unverified if it's changed.
 func (r *UserRepository) Update(ctx context.Context, user *entity.User) error {
 	query := `UPDATE users
-	SET	username   = $2,
-		email      = $3,
-		first_name = $4,
-		last_name  = $5,
-		birth_date = $6,
-		bio        = $7,
-		updated_at = $8
+	SET	username          = $2,
+		email             = $3,
+		first_name        = $4,
+		last_name         = $5,
+		birth_date        = $6,
+		bio               = $7,
+		email_verified_at = CASE WHEN email = $3 THEN email_verified_at END,
+		updated_at        = $8
 	WHERE id = $1
 	  AND deleted_at IS NULL
-	RETURNING pwd_hash, created_at`
+	RETURNING pwd_hash, email_verified_at, created_at`
 
 	err := r.getter.Get(ctx).QueryRow(ctx, query, user.ID,
 		user.Username, user.Email, user.FirstName,
 		user.LastName, user.BirthDate,
 		user.Bio, time.Now(),
-	).Scan(&user.PwdHash, &user.CreatedAt)
+	).Scan(&user.PwdHash, &user.EmailVerifiedAt, &user.CreatedAt)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return fmt.Errorf("%w: %v", entity.ErrUserNotFound, err)
@@ -173,6 +221,42 @@ func (r *UserRepository) Update(ctx context.Context, user *entity.User) error {
 	return nil
 }
 
+// VerifyEmail verifies the email of the user unless the user has changed it since.
+func (r *UserRepository) VerifyEmail(ctx context.Context, userID int, email string, verifiedAt time.Time) error {
+	query := `UPDATE users
+	SET	email_verified_at = $3
+	WHERE id = $1
+	  AND email = $2
+	  AND deleted_at IS NULL`
+
+	execRes, err := r.getter.Get(ctx).Exec(ctx, query, userID, email, verifiedAt)
+	if err != nil {
+		return fmt.Errorf("exec query to verify user email: %v", err)
+	}
+
+	if execRes.RowsAffected() == 0 {
+		return fmt.Errorf("%w: there aren't affected rows", entity.ErrUserNotFound)
+	}
+	return nil
+}
+
+func (r *UserRepository) IsEmailVerified(ctx context.Context, userID int) (bool, error) {
+	query := `SELECT email_verified_at IS NOT NULL
+	FROM users
+	WHERE id = $1
+	  AND deleted_at IS NULL`
+
+	var verified bool
+	if err := r.getter.Get(ctx).QueryRow(ctx, query, userID).Scan(&verified); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, fmt.Errorf("%w: %v", entity.ErrUserNotFound, err)
+		}
+
+		return false, fmt.Errorf("exec query to select user email verification: %v", err)
+	}
+	return verified, nil
+}
+
 func (r *UserRepository) UpdatePassword(ctx context.Context, userID int, pwdHash string) error {
 	query := `UPDATE users
 	SET	pwd_hash   = $2,
@@ -0,0 +1,166 @@
+package codec
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/internal/transport/websocket/model"
+
+	"google.golang.org/protobuf/proto"
+)
+
+type Encoding string
+
+const (
+	ProtobufEncoding Encoding = "protobuf"
+	JSONEncoding     Encoding = "json"
+)
+
+const (
+	// MinSchemaVersion is the oldest envelope version this node is able to decode.
+	MinSchemaVersion uint32 = 1
+	// CurrentSchemaVersion is the newest envelope version this node knows about.
+	CurrentSchemaVersion uint32 = 1
+)
+
+var (
+	ErrUnknownEncoding          = errors.New("unknown encoding")
+	ErrUnsupportedSchemaVersion = errors.New("unsupported schema version")
+	ErrUnexpectedPayload        = errors.New("unexpected envelope payload")
+)
+
+type Config struct {
+	Encoding      Encoding
+	SchemaVersion uint32
+}
+
+// Codec encodes payloads sent over the sysbus either as a versioned protobuf
+// envelope or as plain JSON that nodes released before the envelope understand.
+//
+// Decoding detects the format of every payload, so nodes with different
+// settings can coexist during a rolling upgrade. Envelopes of newer schema
+// versions are decoded as well, because the envelope is only extended and
+// unknown fields are skipped. That's why the writers can be pinned to the
+// lowest schema version still running in the cluster and switched to the
+// current one after all nodes are upgraded.
+type Codec struct {
+	encoding      Encoding
+	schemaVersion uint32
+}
+
+func New(conf Config) (Codec, error) {
+	if conf.Encoding == "" {
+		conf.Encoding = ProtobufEncoding
+	}
+	if conf.SchemaVersion == 0 {
+		conf.SchemaVersion = CurrentSchemaVersion
+	}
+
+	if conf.Encoding != ProtobufEncoding && conf.Encoding != JSONEncoding {
+		return Codec{}, fmt.Errorf("%w %q", ErrUnknownEncoding, conf.Encoding)
+	}
+	if conf.SchemaVersion < MinSchemaVersion || conf.SchemaVersion > CurrentSchemaVersion {
+		return Codec{}, fmt.Errorf("%w %d", ErrUnsupportedSchemaVersion, conf.SchemaVersion)
+	}
+
+	return Codec{
+		encoding:      conf.Encoding,
+		schemaVersion: conf.SchemaVersion,
+	}, nil
+}
+
+func (c Codec) MarshalMessage(message entity.Message) ([]byte, error) {
+	if c.encoding == JSONEncoding {
+		return marshalJSON(newMessageModel(message))
+	}
+
+	return c.marshalEnvelope(&model.Envelope{
+		Payload: &model.Envelope_Message{
+			Message: model.NewMessageFromEntity(message),
+		},
+	})
+}
+
+func (c Codec) UnmarshalMessage(data []byte) (entity.Message, error) {
+	if isJSON(data) {
+		var m messageModel
+		if err := unmarshalJSON(data, &m); err != nil {
+			return entity.Message{}, err
+		}
+		return m.ToEntity(), nil
+	}
+
+	envelope, err := c.unmarshalEnvelope(data)
+	if err != nil {
+		return entity.Message{}, err
+	}
+
+	message := envelope.GetMessage()
+	if message == nil {
+		return entity.Message{}, fmt.Errorf("%w %T, expected message", ErrUnexpectedPayload, envelope.GetPayload())
+	}
+	return message.Entity(), nil
+}
+
+func (c Codec) MarshalParticipantEvent(event entity.ParticipantEvent) ([]byte, error) {
+	if c.encoding == JSONEncoding {
+		return marshalJSON(newParticipantEventModel(event))
+	}
+
+	return c.marshalEnvelope(&model.Envelope{
+		Payload: &model.Envelope_ParticipantEvent{
+			ParticipantEvent: model.NewParticipantEventFromEntity(event),
+		},
+	})
+}
+
+func (c Codec) UnmarshalParticipantEvent(data []byte) (entity.ParticipantEvent, error) {
+	if isJSON(data) {
+		var m participantEventModel
+		if err := unmarshalJSON(data, &m); err != nil {
+			return entity.ParticipantEvent{}, err
+		}
+		return m.ToEntity(), nil
+	}
+
+	envelope, err := c.unmarshalEnvelope(data)
+	if err != nil {
+		return entity.ParticipantEvent{}, err
+	}
+
+	event := envelope.GetParticipantEvent()
+	if event == nil {
+		return entity.ParticipantEvent{}, fmt.Errorf("%w %T, expected participant event", ErrUnexpectedPayload, envelope.GetPayload())
+	}
+	return event.Entity(), nil
+}
+
+func (c Codec) marshalEnvelope(envelope *model.Envelope) ([]byte, error) {
+	envelope.SchemaVersion = c.schemaVersion
+
+	data, err := proto.Marshal(envelope)
+	if err != nil {
+		return nil, fmt.Errorf("marshal envelope: %v", err)
+	}
+	return data, nil
+}
+
+func (c Codec) unmarshalEnvelope(data []byte) (*model.Envelope, error) {
+	var envelope model.Envelope
+	if err := proto.Unmarshal(data, &envelope); err != nil {
+		return nil, fmt.Errorf("unmarshal envelope: %v", err)
+	}
+
+	if envelope.GetSchemaVersion() < MinSchemaVersion {
+		return nil, fmt.Errorf("%w %d", ErrUnsupportedSchemaVersion, envelope.GetSchemaVersion())
+	}
+	return &envelope, nil
+}
+
+// isJSON reports whether the payload is a JSON object. A protobuf envelope
+// never starts with '{', since it isn't a valid tag of any envelope field.
+func isJSON(data []byte) bool {
+	return bytes.HasPrefix(bytes.TrimLeft(data, " \t\r\n"), []byte("{"))
+}
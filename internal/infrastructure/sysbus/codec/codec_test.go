@@ -0,0 +1,131 @@
+package codec
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/internal/transport/websocket/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCodec_Message(t *testing.T) {
+	deliveredAt := time.Date(2024, 3, 10, 18, 45, 12, 0, time.UTC)
+	messages := []entity.Message{
+		{
+			ID:          1,
+			ChatID:      entity.ChatID{ID: 2, Type: entity.GroupChatType},
+			SenderID:    3,
+			Content:     "Hello everyone!",
+			ContentType: entity.TextContentType,
+			SentAt:      deliveredAt.Add(-time.Second),
+			DeliveredAt: &deliveredAt,
+		},
+		{
+			ID:          4,
+			ChatID:      entity.ChatID{ID: 2, Type: entity.GroupChatType},
+			SenderID:    3,
+			Content:     "User 3 kicked user 5",
+			ContentType: entity.TextContentType,
+			IsService:   true,
+			ServiceAction: &entity.ServiceAction{
+				Type:   entity.ParticipantKickedServiceAction,
+				Params: map[string]string{entity.UserIDServiceParam: "5"},
+			},
+			SentAt: deliveredAt,
+		},
+	}
+
+	protoCodec, err := New(Config{Encoding: ProtobufEncoding})
+	require.NoError(t, err)
+
+	jsonCodec, err := New(Config{Encoding: JSONEncoding})
+	require.NoError(t, err)
+
+	for _, message := range messages {
+		for _, encoder := range []Codec{protoCodec, jsonCodec} {
+			data, err := encoder.MarshalMessage(message)
+			require.NoError(t, err)
+
+			// Any codec must decode payloads of both encodings.
+			for _, decoder := range []Codec{protoCodec, jsonCodec} {
+				got, err := decoder.UnmarshalMessage(data)
+				require.NoError(t, err)
+				assert.Equal(t, message, got)
+			}
+		}
+	}
+}
+
+func TestCodec_ParticipantEvent(t *testing.T) {
+	event := entity.ParticipantEvent{
+		Type:   entity.RemovedParticipant,
+		ChatID: entity.ChatID{ID: 2, Type: entity.GroupChatType},
+		UserID: 3,
+	}
+
+	protoCodec, err := New(Config{Encoding: ProtobufEncoding})
+	require.NoError(t, err)
+
+	jsonCodec, err := New(Config{Encoding: JSONEncoding})
+	require.NoError(t, err)
+
+	for _, encoder := range []Codec{protoCodec, jsonCodec} {
+		data, err := encoder.MarshalParticipantEvent(event)
+		require.NoError(t, err)
+
+		for _, decoder := range []Codec{protoCodec, jsonCodec} {
+			got, err := decoder.UnmarshalParticipantEvent(data)
+			require.NoError(t, err)
+			assert.Equal(t, event, got)
+		}
+	}
+
+	data, err := protoCodec.MarshalParticipantEvent(event)
+	require.NoError(t, err)
+
+	_, err = protoCodec.UnmarshalMessage(data)
+	assert.ErrorIs(t, err, ErrUnexpectedPayload)
+}
+
+func TestCodec_SchemaVersion(t *testing.T) {
+	_, err := New(Config{SchemaVersion: CurrentSchemaVersion + 1})
+	assert.ErrorIs(t, err, ErrUnsupportedSchemaVersion)
+
+	_, err = New(Config{Encoding: "xml"})
+	assert.ErrorIs(t, err, ErrUnknownEncoding)
+
+	cdc, err := New(Config{})
+	require.NoError(t, err)
+
+	event := &model.ParticipantEvent{ChatId: 2, ChatType: model.ChatType_GROUP, UserId: 3}
+
+	t.Run("newer version with unknown fields", func(t *testing.T) {
+		data, err := proto.Marshal(&model.Envelope{
+			SchemaVersion: CurrentSchemaVersion + 1,
+			Payload:       &model.Envelope_ParticipantEvent{ParticipantEvent: event},
+		})
+		require.NoError(t, err)
+
+		data = protowire.AppendTag(data, 100, protowire.BytesType)
+		data = protowire.AppendString(data, "field from the future")
+
+		got, err := cdc.UnmarshalParticipantEvent(data)
+		require.NoError(t, err)
+		assert.Equal(t, event.Entity(), got)
+	})
+
+	t.Run("missing version", func(t *testing.T) {
+		data, err := proto.Marshal(&model.Envelope{
+			Payload: &model.Envelope_ParticipantEvent{ParticipantEvent: event},
+		})
+		require.NoError(t, err)
+
+		_, err = cdc.UnmarshalParticipantEvent(data)
+		assert.ErrorIs(t, err, ErrUnsupportedSchemaVersion)
+	})
+}
@@ -0,0 +1,123 @@
+package codec
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Chatyx/backend/internal/entity"
+)
+
+func marshalJSON(v any) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("marshal json: %v", err)
+	}
+	return data, nil
+}
+
+func unmarshalJSON(data []byte, v any) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("unmarshal json: %v", err)
+	}
+	return nil
+}
+
+type serviceActionModel struct {
+	Type   entity.ServiceActionType `json:"type"`
+	Params map[string]string        `json:"params,omitempty"`
+}
+
+type messageModel struct {
+	ID              int                 `json:"id"`
+	ChatID          int                 `json:"chat_id"`
+	ChatType        entity.ChatType     `json:"chat_type"`
+	SenderID        int                 `json:"sender_id"`
+	Content         string              `json:"content"`
+	ContentType     entity.ContentType  `json:"content_type"`
+	IsService       bool                `json:"is_service"`
+	ServiceAction   *serviceActionModel `json:"service_action,omitempty"`
+	ForwardedFromID *int                `json:"forwarded_from_id,omitempty"`
+	SentAt          time.Time           `json:"sent_at"`
+	DeliveredAt     *time.Time          `json:"delivered_at,omitempty"`
+}
+
+func newMessageModel(message entity.Message) messageModel {
+	var serviceAction *serviceActionModel
+	if message.ServiceAction != nil {
+		serviceAction = &serviceActionModel{
+			Type:   message.ServiceAction.Type,
+			Params: message.ServiceAction.Params,
+		}
+	}
+
+	return messageModel{
+		ID:              message.ID,
+		ChatID:          message.ChatID.ID,
+		ChatType:        message.ChatID.Type,
+		SenderID:        message.SenderID,
+		Content:         message.Content,
+		ContentType:     message.ContentType,
+		IsService:       message.IsService,
+		ServiceAction:   serviceAction,
+		ForwardedFromID: message.ForwardedFromID,
+		SentAt:          message.SentAt,
+		DeliveredAt:     message.DeliveredAt,
+	}
+}
+
+func (m messageModel) ToEntity() entity.Message {
+	var serviceAction *entity.ServiceAction
+	if m.ServiceAction != nil {
+		serviceAction = &entity.ServiceAction{
+			Type:   m.ServiceAction.Type,
+			Params: m.ServiceAction.Params,
+		}
+	}
+
+	return entity.Message{
+		ID: m.ID,
+		ChatID: entity.ChatID{
+			ID:   m.ChatID,
+			Type: m.ChatType,
+		},
+		SenderID:        m.SenderID,
+		Content:         m.Content,
+		ContentType:     m.ContentType,
+		IsService:       m.IsService,
+		ServiceAction:   serviceAction,
+		ForwardedFromID: m.ForwardedFromID,
+		SentAt:          m.SentAt,
+		DeliveredAt:     m.DeliveredAt,
+	}
+}
+
+type participantEventModel struct {
+	Type        entity.ParticipantEventType `json:"type"`
+	ChatID      int                         `json:"chat_id"`
+	ChatType    entity.ChatType             `json:"chat_type"`
+	UserID      int                         `json:"user_id"`
+	InitiatorID int                         `json:"initiator_id,omitempty"`
+}
+
+func newParticipantEventModel(event entity.ParticipantEvent) participantEventModel {
+	return participantEventModel{
+		Type:        event.Type,
+		ChatID:      event.ChatID.ID,
+		ChatType:    event.ChatID.Type,
+		UserID:      event.UserID,
+		InitiatorID: event.InitiatorID,
+	}
+}
+
+func (e participantEventModel) ToEntity() entity.ParticipantEvent {
+	return entity.ParticipantEvent{
+		Type: e.Type,
+		ChatID: entity.ChatID{
+			ID:   e.ChatID,
+			Type: e.ChatType,
+		},
+		UserID:      e.UserID,
+		InitiatorID: e.InitiatorID,
+	}
+}
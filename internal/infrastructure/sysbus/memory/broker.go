@@ -0,0 +1,136 @@
+package memory
+
+import (
+	"sync"
+
+	"github.com/Chatyx/backend/pkg/log"
+)
+
+const defaultSubscriptionBufferSize = 100
+
+type Broker struct {
+	mu      sync.RWMutex
+	topics  map[string]map[*Subscription]struct{}
+	bufSize int
+}
+
+func NewBroker() *Broker {
+	return &Broker{
+		topics:  make(map[string]map[*Subscription]struct{}),
+		bufSize: defaultSubscriptionBufferSize,
+	}
+}
+
+func (b *Broker) Publish(topic string, payload any) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for sub := range b.topics[topic] {
+		select {
+		case sub.ch <- payload:
+		default:
+			log.With("topic", topic).Warn("Subscription buffer is full, payload was dropped")
+		}
+	}
+}
+
+func (b *Broker) Subscribe(topics ...string) *Subscription {
+	sub := &Subscription{
+		broker: b,
+		ch:     make(chan any, b.bufSize),
+		topics: make(map[string]struct{}, len(topics)),
+	}
+	sub.Subscribe(topics...)
+
+	return sub
+}
+
+func (b *Broker) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	closed := make(map[*Subscription]struct{})
+	for _, subs := range b.topics {
+		for sub := range subs {
+			if _, ok := closed[sub]; !ok {
+				close(sub.ch)
+				sub.closed = true
+				closed[sub] = struct{}{}
+			}
+		}
+	}
+
+	b.topics = make(map[string]map[*Subscription]struct{})
+	return nil
+}
+
+type Subscription struct {
+	broker *Broker
+	ch     chan any
+	topics map[string]struct{}
+	closed bool
+}
+
+func (s *Subscription) Channel() <-chan any {
+	return s.ch
+}
+
+func (s *Subscription) Subscribe(topics ...string) {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+
+	for _, topic := range topics {
+		subs, ok := s.broker.topics[topic]
+		if !ok {
+			subs = make(map[*Subscription]struct{})
+			s.broker.topics[topic] = subs
+		}
+
+		subs[s] = struct{}{}
+		s.topics[topic] = struct{}{}
+	}
+}
+
+func (s *Subscription) Unsubscribe(topics ...string) {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+
+	s.unsubscribe(topics...)
+}
+
+func (s *Subscription) Close() {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+
+	topics := make([]string, 0, len(s.topics))
+	for topic := range s.topics {
+		topics = append(topics, topic)
+	}
+
+	s.unsubscribe(topics...)
+	close(s.ch)
+	s.closed = true
+}
+
+func (s *Subscription) unsubscribe(topics ...string) {
+	for _, topic := range topics {
+		subs, ok := s.broker.topics[topic]
+		if !ok {
+			continue
+		}
+
+		delete(subs, s)
+		if len(subs) == 0 {
+			delete(s.broker.topics, topic)
+		}
+		delete(s.topics, topic)
+	}
+}
@@ -0,0 +1,34 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/internal/service"
+)
+
+type MessageInboxPublishSubscriber struct {
+	broker *Broker
+}
+
+func NewMessageInboxPublishSubscriber(broker *Broker) *MessageInboxPublishSubscriber {
+	return &MessageInboxPublishSubscriber{broker: broker}
+}
+
+func (ps *MessageInboxPublishSubscriber) PublishToInboxes(_ context.Context, message entity.Message, userIDs ...int) error {
+	for _, userID := range userIDs {
+		ps.broker.Publish(inboxTopicName(userID), message)
+	}
+	return nil
+}
+
+func (ps *MessageInboxPublishSubscriber) SubscribeInbox(_ context.Context, userID int) service.MessageInboxConsumer { //nolint:ireturn,lll // that's a factory
+	return &MessageConsumer{
+		sub: ps.broker.Subscribe(inboxTopicName(userID)),
+	}
+}
+
+func inboxTopicName(userID int) string {
+	return fmt.Sprintf("user:%d:inbox", userID)
+}
@@ -0,0 +1,91 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/internal/service"
+)
+
+type MessagePublishSubscriber struct {
+	broker *Broker
+}
+
+func NewMessagePublishSubscriber(broker *Broker) *MessagePublishSubscriber {
+	return &MessagePublishSubscriber{broker: broker}
+}
+
+func (ps *MessagePublishSubscriber) Publish(_ context.Context, message entity.Message) error {
+	ps.broker.Publish(chatTopicName(message.ChatID), message)
+	return nil
+}
+
+func (ps *MessagePublishSubscriber) Subscribe(_ context.Context, chatIDs ...entity.ChatID) service.MessageConsumer { //nolint:ireturn,lll // that's a factory
+	return &MessageConsumer{
+		sub: ps.broker.Subscribe(chatTopicNames(chatIDs...)...),
+	}
+}
+
+type MessageConsumer struct {
+	sub *Subscription
+}
+
+func (c *MessageConsumer) BeginConsume(ctx context.Context) (<-chan entity.Message, <-chan error) {
+	outCh, errCh := make(chan entity.Message), make(chan error)
+
+	go func() {
+		defer close(outCh)
+		defer close(errCh)
+
+		ch := c.sub.Channel()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case payload, ok := <-ch:
+				if !ok {
+					return
+				}
+
+				message, ok := payload.(entity.Message)
+				if !ok {
+					errCh <- fmt.Errorf("unexpected message payload type %T", payload)
+					continue
+				}
+
+				outCh <- message
+			}
+		}
+	}()
+
+	return outCh, errCh
+}
+
+func (c *MessageConsumer) Subscribe(_ context.Context, chatIDs ...entity.ChatID) error {
+	c.sub.Subscribe(chatTopicNames(chatIDs...)...)
+	return nil
+}
+
+func (c *MessageConsumer) Unsubscribe(_ context.Context, chatIDs ...entity.ChatID) error {
+	c.sub.Unsubscribe(chatTopicNames(chatIDs...)...)
+	return nil
+}
+
+func (c *MessageConsumer) Close() error {
+	c.sub.Close()
+	return nil
+}
+
+func chatTopicName(chatID entity.ChatID) string {
+	return fmt.Sprintf("%s:%d", chatID.Type, chatID.ID)
+}
+
+func chatTopicNames(chatIDs ...entity.ChatID) []string {
+	topics := make([]string, len(chatIDs))
+	for i, chatID := range chatIDs {
+		topics[i] = chatTopicName(chatID)
+	}
+	return topics
+}
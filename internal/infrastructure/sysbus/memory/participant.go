@@ -0,0 +1,69 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Chatyx/backend/internal/entity"
+)
+
+const allParticipantEventsTopicName = "participant_events"
+
+type ParticipantEventProduceConsumer struct {
+	broker *Broker
+}
+
+func NewParticipantEventProduceConsumer(broker *Broker) *ParticipantEventProduceConsumer {
+	return &ParticipantEventProduceConsumer{broker: broker}
+}
+
+func (pc *ParticipantEventProduceConsumer) Produce(_ context.Context, event entity.ParticipantEvent) error {
+	pc.broker.Publish(participantEventsTopicName(event.UserID), event)
+	pc.broker.Publish(allParticipantEventsTopicName, event)
+	return nil
+}
+
+func (pc *ParticipantEventProduceConsumer) BeginConsume(ctx context.Context, userID int) (<-chan entity.ParticipantEvent, <-chan error) {
+	return pc.consume(ctx, pc.broker.Subscribe(participantEventsTopicName(userID)))
+}
+
+// BeginConsumeAll consumes participant events of all users.
+func (pc *ParticipantEventProduceConsumer) BeginConsumeAll(ctx context.Context) (<-chan entity.ParticipantEvent, <-chan error) {
+	return pc.consume(ctx, pc.broker.Subscribe(allParticipantEventsTopicName))
+}
+
+func (pc *ParticipantEventProduceConsumer) consume(ctx context.Context, sub *Subscription) (<-chan entity.ParticipantEvent, <-chan error) {
+	outCh, errCh := make(chan entity.ParticipantEvent), make(chan error)
+
+	go func() {
+		defer close(outCh)
+		defer close(errCh)
+		defer sub.Close()
+
+		ch := sub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case payload, ok := <-ch:
+				if !ok {
+					return
+				}
+
+				event, ok := payload.(entity.ParticipantEvent)
+				if !ok {
+					errCh <- fmt.Errorf("unexpected participant event payload type %T", payload)
+					continue
+				}
+
+				outCh <- event
+			}
+		}
+	}()
+
+	return outCh, errCh
+}
+
+func participantEventsTopicName(userID int) string {
+	return fmt.Sprintf("user:%d:participant_events", userID)
+}
@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/internal/infrastructure/sysbus/codec"
+	"github.com/Chatyx/backend/internal/service"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type MessageInboxPublishSubscriber struct {
+	pool     *pgxpool.Pool
+	listener *Listener
+	codec    codec.Codec
+}
+
+func NewMessageInboxPublishSubscriber(pool *pgxpool.Pool, listener *Listener, cdc codec.Codec) *MessageInboxPublishSubscriber {
+	return &MessageInboxPublishSubscriber{
+		pool:     pool,
+		listener: listener,
+		codec:    cdc,
+	}
+}
+
+func (ps *MessageInboxPublishSubscriber) PublishToInboxes(ctx context.Context, message entity.Message, userIDs ...int) error {
+	bytes, err := ps.codec.MarshalMessage(message)
+	if err != nil {
+		return fmt.Errorf("marshal message: %v", err)
+	}
+
+	channels := make([]string, len(userIDs))
+	for i, userID := range userIDs {
+		channels[i] = inboxChannelName(userID)
+	}
+
+	if err = notify(ctx, ps.pool, bytes, channels...); err != nil {
+		return fmt.Errorf("publish message to inbox channels: %v", err)
+	}
+	return nil
+}
+
+func (ps *MessageInboxPublishSubscriber) SubscribeInbox(_ context.Context, userID int) service.MessageInboxConsumer { //nolint:ireturn,lll // that's a factory
+	return &MessageConsumer{
+		sub:   ps.listener.Subscribe(inboxChannelName(userID)),
+		codec: ps.codec,
+	}
+}
+
+func inboxChannelName(userID int) string {
+	return fmt.Sprintf("user:%d:inbox", userID)
+}
@@ -0,0 +1,509 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Chatyx/backend/pkg/log"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	defaultReconnectInterval      = 5 * time.Second
+	defaultOutboxRetention        = time.Hour
+	defaultSubscriptionBufferSize = 100
+	outboxBatchSize               = 100
+	notificationBatchWait         = 10 * time.Millisecond
+)
+
+type outboxRow struct {
+	id      int
+	channel string
+	payload []byte
+}
+
+type ListenerConfig struct {
+	OutboxRetention time.Duration
+}
+
+// Listener holds a dedicated connection that LISTENs to all channels
+// which local subscriptions are interested in. Notifications carry only
+// the identity of an outbox row, so payloads aren't limited by NOTIFY size.
+type Listener struct {
+	pool      *pgxpool.Pool
+	retention time.Duration
+
+	mu       sync.Mutex
+	channels map[string]map[*Subscription]struct{}
+	wakeCh   chan struct{}
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewListener(pool *pgxpool.Pool, conf ListenerConfig) *Listener {
+	if conf.OutboxRetention == 0 {
+		conf.OutboxRetention = defaultOutboxRetention
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &Listener{
+		pool:      pool,
+		retention: conf.OutboxRetention,
+		channels:  make(map[string]map[*Subscription]struct{}),
+		wakeCh:    make(chan struct{}, 1),
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+}
+
+func (l *Listener) Run() {
+	l.wg.Add(2)
+
+	go l.run()
+	go l.purgeOutbox()
+}
+
+func (l *Listener) Close() error {
+	l.cancel()
+	l.wg.Wait()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	closed := make(map[*Subscription]struct{})
+	for _, subs := range l.channels {
+		for sub := range subs {
+			if _, ok := closed[sub]; !ok {
+				close(sub.ch)
+				sub.closed = true
+				closed[sub] = struct{}{}
+			}
+		}
+	}
+
+	l.channels = make(map[string]map[*Subscription]struct{})
+	return nil
+}
+
+func (l *Listener) Subscribe(channels ...string) *Subscription {
+	sub := &Subscription{
+		listener: l,
+		ch:       make(chan []byte, defaultSubscriptionBufferSize),
+		channels: make(map[string]struct{}, len(channels)),
+	}
+	sub.Subscribe(channels...)
+
+	return sub
+}
+
+func (l *Listener) run() {
+	defer l.wg.Done()
+
+	// The last dispatched outbox ids outlive connections, so the rows
+	// notified while the connection was lost can be replayed.
+	lastIDs := make(map[string]int)
+
+	for {
+		err := l.listen(lastIDs)
+		if l.ctx.Err() != nil {
+			return
+		}
+
+		log.WithError(err).Error("Postgres listener connection is lost, reconnecting")
+
+		select {
+		case <-l.ctx.Done():
+			return
+		case <-time.After(defaultReconnectInterval):
+		}
+	}
+}
+
+// listen serves a single connection until it's broken. Every (re)connect
+// starts with an empty set of listened channels, so all the active ones
+// are LISTENed again during the first reconciliation. Notifications sent
+// while there was no connection are lost, so the outbox rows created since
+// the last dispatched ones are replayed right after that.
+func (l *Listener) listen(lastIDs map[string]int) error {
+	conn, err := pgx.ConnectConfig(l.ctx, l.pool.Config().ConnConfig)
+	if err != nil {
+		return fmt.Errorf("connect to postgres: %v", err)
+	}
+	defer conn.Close(context.Background())
+
+	var (
+		notification  *pgconn.Notification
+		notifications []*pgconn.Notification
+	)
+
+	listened := make(map[string]struct{})
+	if err = l.reconcile(conn, listened, lastIDs); err != nil {
+		return err
+	}
+
+	replayed, err := l.replay(lastIDs)
+	if err != nil {
+		return err
+	}
+
+	for {
+		if err = l.reconcile(conn, listened, lastIDs); err != nil {
+			return err
+		}
+
+		waitCtx, cancel := context.WithCancel(l.ctx)
+		go func() {
+			select {
+			case <-l.wakeCh:
+				cancel()
+			case <-waitCtx.Done():
+			}
+		}()
+
+		notification, err = conn.WaitForNotification(waitCtx)
+		woken := waitCtx.Err() != nil
+		cancel()
+
+		if err != nil {
+			if l.ctx.Err() != nil {
+				return l.ctx.Err()
+			}
+			if woken && !conn.IsClosed() {
+				continue
+			}
+			return fmt.Errorf("wait for notification: %v", err)
+		}
+
+		notifications, err = l.drain(conn, notification)
+		l.dispatch(notifications, lastIDs, replayed)
+
+		if err != nil {
+			if l.ctx.Err() != nil {
+				return l.ctx.Err()
+			}
+			return fmt.Errorf("wait for notification: %v", err)
+		}
+	}
+}
+
+// reconcile LISTENs the channels which local subscriptions are interested in and UNLISTENs
+// the rest. The newly listened channels start from the latest outbox row, there is nothing
+// to replay for them.
+func (l *Listener) reconcile(conn *pgx.Conn, listened map[string]struct{}, lastIDs map[string]int) error {
+	var toListen, toUnlisten []string
+
+	l.mu.Lock()
+	for channel := range l.channels {
+		if _, ok := listened[channel]; !ok {
+			toListen = append(toListen, channel)
+		}
+	}
+	for channel := range listened {
+		if _, ok := l.channels[channel]; !ok {
+			toUnlisten = append(toUnlisten, channel)
+		}
+	}
+	for channel := range lastIDs {
+		if _, ok := l.channels[channel]; !ok {
+			delete(lastIDs, channel)
+		}
+	}
+	l.mu.Unlock()
+
+	for _, channel := range toListen {
+		if _, err := conn.Exec(l.ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize()); err != nil {
+			return fmt.Errorf("listen channel %s: %v", channel, err)
+		}
+		listened[channel] = struct{}{}
+	}
+	for _, channel := range toUnlisten {
+		if _, err := conn.Exec(l.ctx, "UNLISTEN "+pgx.Identifier{channel}.Sanitize()); err != nil {
+			return fmt.Errorf("unlisten channel %s: %v", channel, err)
+		}
+		delete(listened, channel)
+	}
+
+	var latestID int
+	for _, channel := range toListen {
+		if _, ok := lastIDs[channel]; ok {
+			continue
+		}
+
+		if latestID == 0 {
+			query := "SELECT COALESCE(MAX(id), 0) FROM sysbus_outbox"
+			if err := conn.QueryRow(l.ctx, query).Scan(&latestID); err != nil {
+				return fmt.Errorf("get latest outbox id: %v", err)
+			}
+		}
+		lastIDs[channel] = latestID
+	}
+
+	return nil
+}
+
+// replay dispatches the outbox rows created after the last dispatched ones in batches.
+// It returns ids of the replayed rows, their notifications may still arrive and must be skipped.
+func (l *Listener) replay(lastIDs map[string]int) (map[int]struct{}, error) {
+	replayed := make(map[int]struct{})
+	if len(lastIDs) == 0 {
+		return replayed, nil
+	}
+
+	channels := make([]string, 0, len(lastIDs))
+	fromID := math.MaxInt
+	for channel, lastID := range lastIDs {
+		channels = append(channels, channel)
+		fromID = min(fromID, lastID)
+	}
+
+	query := `SELECT id, channel, payload FROM sysbus_outbox
+	WHERE id > $1 AND channel = ANY($2)
+	ORDER BY id
+	LIMIT $3`
+
+	for {
+		rows, err := l.fetchOutbox(query, fromID, channels, outboxBatchSize)
+		if err != nil {
+			return nil, fmt.Errorf("replay outbox: %v", err)
+		}
+
+		for _, row := range rows {
+			fromID = row.id
+			if row.id <= lastIDs[row.channel] {
+				continue
+			}
+
+			replayed[row.id] = struct{}{}
+			l.deliver(row, lastIDs)
+		}
+
+		if len(rows) < outboxBatchSize {
+			return replayed, nil
+		}
+	}
+}
+
+// drain collects the notifications which arrive right after the first one,
+// so their outbox rows are fetched by a single query.
+func (l *Listener) drain(conn *pgx.Conn, first *pgconn.Notification) ([]*pgconn.Notification, error) {
+	notifications := []*pgconn.Notification{first}
+
+	for len(notifications) < outboxBatchSize {
+		waitCtx, cancel := context.WithTimeout(l.ctx, notificationBatchWait)
+		notification, err := conn.WaitForNotification(waitCtx)
+		timedOut := waitCtx.Err() != nil
+		cancel()
+
+		if err != nil {
+			if timedOut && l.ctx.Err() == nil && !conn.IsClosed() {
+				return notifications, nil
+			}
+			return notifications, err
+		}
+
+		notifications = append(notifications, notification)
+	}
+
+	return notifications, nil
+}
+
+func (l *Listener) dispatch(notifications []*pgconn.Notification, lastIDs map[string]int, replayed map[int]struct{}) {
+	ids := make([]int, 0, len(notifications))
+	for _, notification := range notifications {
+		outboxID, err := strconv.Atoi(notification.Payload)
+		if err != nil {
+			log.With("channel", notification.Channel).WithError(err).Error("Failed to parse outbox id from notification")
+			continue
+		}
+
+		if _, ok := replayed[outboxID]; ok {
+			delete(replayed, outboxID)
+			continue
+		}
+		ids = append(ids, outboxID)
+	}
+
+	if len(ids) == 0 {
+		return
+	}
+
+	query := "SELECT id, channel, payload FROM sysbus_outbox WHERE id = ANY($1) ORDER BY id"
+
+	rows, err := l.fetchOutbox(query, ids)
+	if err != nil {
+		log.WithError(err).Error("Failed to fetch outbox rows")
+		return
+	}
+	if len(rows) < len(ids) {
+		log.Warnf("%d outbox rows are already purged", len(ids)-len(rows))
+	}
+
+	for _, row := range rows {
+		l.deliver(row, lastIDs)
+	}
+}
+
+func (l *Listener) deliver(row outboxRow, lastIDs map[string]int) {
+	if row.id > lastIDs[row.channel] {
+		lastIDs[row.channel] = row.id
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for sub := range l.channels[row.channel] {
+		select {
+		case sub.ch <- row.payload:
+		default:
+			log.With("channel", row.channel).Warn("Subscription buffer is full, payload was dropped")
+		}
+	}
+}
+
+func (l *Listener) fetchOutbox(query string, args ...any) ([]outboxRow, error) {
+	rows, err := l.pool.Query(l.ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec query to select outbox rows: %v", err)
+	}
+	defer rows.Close()
+
+	var result []outboxRow
+	for rows.Next() {
+		var row outboxRow
+		if err = rows.Scan(&row.id, &row.channel, &row.payload); err != nil {
+			return nil, fmt.Errorf("scan outbox row: %v", err)
+		}
+		result = append(result, row)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("reading outbox rows: %v", err)
+	}
+
+	return result, nil
+}
+
+func (l *Listener) purgeOutbox() {
+	defer l.wg.Done()
+
+	ticker := time.NewTicker(l.retention)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-l.ctx.Done():
+			return
+		case <-ticker.C:
+			query := "DELETE FROM sysbus_outbox WHERE created_at < $1"
+			if _, err := l.pool.Exec(l.ctx, query, time.Now().Add(-l.retention)); err != nil && l.ctx.Err() == nil {
+				log.WithError(err).Error("Failed to purge sysbus outbox")
+			}
+		}
+	}
+}
+
+func (l *Listener) wake() {
+	select {
+	case l.wakeCh <- struct{}{}:
+	default:
+	}
+}
+
+type Subscription struct {
+	listener *Listener
+	ch       chan []byte
+	channels map[string]struct{}
+	closed   bool
+}
+
+func (s *Subscription) Channel() <-chan []byte {
+	return s.ch
+}
+
+func (s *Subscription) Subscribe(channels ...string) {
+	s.listener.mu.Lock()
+	defer s.listener.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+
+	for _, channel := range channels {
+		subs, ok := s.listener.channels[channel]
+		if !ok {
+			subs = make(map[*Subscription]struct{})
+			s.listener.channels[channel] = subs
+		}
+
+		subs[s] = struct{}{}
+		s.channels[channel] = struct{}{}
+	}
+
+	s.listener.wake()
+}
+
+func (s *Subscription) Unsubscribe(channels ...string) {
+	s.listener.mu.Lock()
+	defer s.listener.mu.Unlock()
+
+	s.unsubscribe(channels...)
+	s.listener.wake()
+}
+
+func (s *Subscription) Close() {
+	s.listener.mu.Lock()
+	defer s.listener.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+
+	channels := make([]string, 0, len(s.channels))
+	for channel := range s.channels {
+		channels = append(channels, channel)
+	}
+
+	s.unsubscribe(channels...)
+	close(s.ch)
+	s.closed = true
+	s.listener.wake()
+}
+
+func (s *Subscription) unsubscribe(channels ...string) {
+	for _, channel := range channels {
+		subs, ok := s.listener.channels[channel]
+		if !ok {
+			continue
+		}
+
+		delete(subs, s)
+		if len(subs) == 0 {
+			delete(s.listener.channels, channel)
+		}
+		delete(s.channels, channel)
+	}
+}
+
+func notify(ctx context.Context, pool *pgxpool.Pool, payload []byte, channels ...string) error {
+	query := `WITH outbox AS (
+		INSERT INTO sysbus_outbox (channel, payload, created_at)
+		SELECT channel, $2, $3
+		FROM unnest($1::text[]) AS channel
+		RETURNING id, channel)
+	SELECT pg_notify(outbox.channel, outbox.id::text)
+	FROM outbox`
+
+	if _, err := pool.Exec(ctx, query, channels, payload, time.Now()); err != nil {
+		return fmt.Errorf("exec query to insert outbox rows and notify: %v", err)
+	}
+	return nil
+}
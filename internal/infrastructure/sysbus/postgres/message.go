@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/internal/infrastructure/sysbus/codec"
+	"github.com/Chatyx/backend/internal/service"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type MessagePublishSubscriber struct {
+	pool     *pgxpool.Pool
+	listener *Listener
+	codec    codec.Codec
+}
+
+func NewMessagePublishSubscriber(pool *pgxpool.Pool, listener *Listener, cdc codec.Codec) *MessagePublishSubscriber {
+	return &MessagePublishSubscriber{
+		pool:     pool,
+		listener: listener,
+		codec:    cdc,
+	}
+}
+
+func (ps *MessagePublishSubscriber) Publish(ctx context.Context, message entity.Message) error {
+	bytes, err := ps.codec.MarshalMessage(message)
+	if err != nil {
+		return fmt.Errorf("marshal message: %v", err)
+	}
+
+	channel := chatChannelName(message.ChatID)
+	if err = notify(ctx, ps.pool, bytes, channel); err != nil {
+		return fmt.Errorf("publish message to channel: %v", err)
+	}
+	return nil
+}
+
+func (ps *MessagePublishSubscriber) Subscribe(_ context.Context, chatIDs ...entity.ChatID) service.MessageConsumer { //nolint:ireturn,lll // that's a factory
+	return &MessageConsumer{
+		sub:   ps.listener.Subscribe(chatChannelNames(chatIDs...)...),
+		codec: ps.codec,
+	}
+}
+
+type MessageConsumer struct {
+	sub   *Subscription
+	codec codec.Codec
+}
+
+func (c *MessageConsumer) BeginConsume(ctx context.Context) (<-chan entity.Message, <-chan error) {
+	outCh, errCh := make(chan entity.Message), make(chan error)
+
+	go func() {
+		defer close(outCh)
+		defer close(errCh)
+
+		ch := c.sub.Channel()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case payload, ok := <-ch:
+				if !ok {
+					return
+				}
+
+				message, err := c.codec.UnmarshalMessage(payload)
+				if err != nil {
+					errCh <- fmt.Errorf("unmarshal message: %v", err)
+					continue
+				}
+
+				outCh <- message
+			}
+		}
+	}()
+
+	return outCh, errCh
+}
+
+func (c *MessageConsumer) Subscribe(_ context.Context, chatIDs ...entity.ChatID) error {
+	c.sub.Subscribe(chatChannelNames(chatIDs...)...)
+	return nil
+}
+
+func (c *MessageConsumer) Unsubscribe(_ context.Context, chatIDs ...entity.ChatID) error {
+	c.sub.Unsubscribe(chatChannelNames(chatIDs...)...)
+	return nil
+}
+
+func (c *MessageConsumer) Close() error {
+	c.sub.Close()
+	return nil
+}
+
+func chatChannelName(chatID entity.ChatID) string {
+	return fmt.Sprintf("%s:%d", chatID.Type, chatID.ID)
+}
+
+func chatChannelNames(chatIDs ...entity.ChatID) []string {
+	channels := make([]string, len(chatIDs))
+	for i, chatID := range chatIDs {
+		channels[i] = chatChannelName(chatID)
+	}
+	return channels
+}
@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/internal/infrastructure/sysbus/codec"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const allParticipantEventsChannelName = "participant_events"
+
+type ParticipantEventProduceConsumer struct {
+	pool     *pgxpool.Pool
+	listener *Listener
+	codec    codec.Codec
+}
+
+func NewParticipantEventProduceConsumer(pool *pgxpool.Pool, listener *Listener, cdc codec.Codec) *ParticipantEventProduceConsumer {
+	return &ParticipantEventProduceConsumer{
+		pool:     pool,
+		listener: listener,
+		codec:    cdc,
+	}
+}
+
+func (pc *ParticipantEventProduceConsumer) Produce(ctx context.Context, event entity.ParticipantEvent) error {
+	bytes, err := pc.codec.MarshalParticipantEvent(event)
+	if err != nil {
+		return fmt.Errorf("marshal participant event: %v", err)
+	}
+
+	channels := []string{participantEventsChannelName(event.UserID), allParticipantEventsChannelName}
+	if err = notify(ctx, pc.pool, bytes, channels...); err != nil {
+		return fmt.Errorf("produce participant event to channel: %v", err)
+	}
+	return nil
+}
+
+func (pc *ParticipantEventProduceConsumer) BeginConsume(ctx context.Context, userID int) (<-chan entity.ParticipantEvent, <-chan error) {
+	return pc.consume(ctx, pc.listener.Subscribe(participantEventsChannelName(userID)))
+}
+
+// BeginConsumeAll consumes participant events of all users.
+func (pc *ParticipantEventProduceConsumer) BeginConsumeAll(ctx context.Context) (<-chan entity.ParticipantEvent, <-chan error) {
+	return pc.consume(ctx, pc.listener.Subscribe(allParticipantEventsChannelName))
+}
+
+func (pc *ParticipantEventProduceConsumer) consume(ctx context.Context, sub *Subscription) (<-chan entity.ParticipantEvent, <-chan error) {
+	outCh, errCh := make(chan entity.ParticipantEvent), make(chan error)
+
+	go func() {
+		defer close(outCh)
+		defer close(errCh)
+		defer sub.Close()
+
+		ch := sub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case payload, ok := <-ch:
+				if !ok {
+					return
+				}
+
+				event, err := pc.codec.UnmarshalParticipantEvent(payload)
+				if err != nil {
+					errCh <- fmt.Errorf("unmarshal participant event: %v", err)
+					continue
+				}
+
+				outCh <- event
+			}
+		}
+	}()
+
+	return outCh, errCh
+}
+
+func participantEventsChannelName(userID int) string {
+	return fmt.Sprintf("user:%d:participant_events", userID)
+}
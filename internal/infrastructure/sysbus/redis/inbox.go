@@ -0,0 +1,53 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/internal/infrastructure/sysbus/codec"
+	"github.com/Chatyx/backend/internal/service"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type MessageInboxPublishSubscriber struct {
+	cli   *redis.Client
+	codec codec.Codec
+}
+
+func NewMessageInboxPublishSubscriber(cli *redis.Client, cdc codec.Codec) *MessageInboxPublishSubscriber {
+	return &MessageInboxPublishSubscriber{
+		cli:   cli,
+		codec: cdc,
+	}
+}
+
+func (ps *MessageInboxPublishSubscriber) PublishToInboxes(ctx context.Context, message entity.Message, userIDs ...int) error {
+	bytes, err := ps.codec.MarshalMessage(message)
+	if err != nil {
+		return fmt.Errorf("marshal message: %v", err)
+	}
+
+	_, err = ps.cli.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, userID := range userIDs {
+			pipe.Publish(ctx, inboxChannelName(userID), bytes)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("publish message to inbox channels: %v", err)
+	}
+	return nil
+}
+
+func (ps *MessageInboxPublishSubscriber) SubscribeInbox(ctx context.Context, userID int) service.MessageInboxConsumer { //nolint:ireturn,lll // that's a factory
+	return &MessageConsumer{
+		pubSub: ps.cli.Subscribe(ctx, inboxChannelName(userID)),
+		codec:  ps.codec,
+	}
+}
+
+func inboxChannelName(userID int) string {
+	return fmt.Sprintf("user:%d:inbox", userID)
+}
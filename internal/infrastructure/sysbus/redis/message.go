@@ -2,28 +2,29 @@ package redis
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"time"
 
 	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/internal/infrastructure/sysbus/codec"
 	"github.com/Chatyx/backend/internal/service"
 
 	"github.com/redis/go-redis/v9"
 )
 
 type MessagePublishSubscriber struct {
-	cli *redis.Client
+	cli   *redis.Client
+	codec codec.Codec
 }
 
-func NewMessagePublishSubscriber(cli *redis.Client) *MessagePublishSubscriber {
-	return &MessagePublishSubscriber{cli: cli}
+func NewMessagePublishSubscriber(cli *redis.Client, cdc codec.Codec) *MessagePublishSubscriber {
+	return &MessagePublishSubscriber{
+		cli:   cli,
+		codec: cdc,
+	}
 }
 
 func (ps *MessagePublishSubscriber) Publish(ctx context.Context, message entity.Message) error {
-	model := newMessageModel(message)
-
-	bytes, err := json.Marshal(model)
+	bytes, err := ps.codec.MarshalMessage(message)
 	if err != nil {
 		return fmt.Errorf("marshal message: %v", err)
 	}
@@ -39,11 +40,13 @@ func (ps *MessagePublishSubscriber) Subscribe(ctx context.Context, chatIDs ...en
 	channels := chatChannelNames(chatIDs...)
 	return &MessageConsumer{
 		pubSub: ps.cli.Subscribe(ctx, channels...),
+		codec:  ps.codec,
 	}
 }
 
 type MessageConsumer struct {
 	pubSub *redis.PubSub
+	codec  codec.Codec
 }
 
 func (c *MessageConsumer) BeginConsume(ctx context.Context) (<-chan entity.Message, <-chan error) {
@@ -64,13 +67,13 @@ func (c *MessageConsumer) BeginConsume(ctx context.Context) (<-chan entity.Messa
 					return
 				}
 
-				var model messageModel
-				if err := json.Unmarshal([]byte(msg.Payload), &model); err != nil {
+				message, err := c.codec.UnmarshalMessage([]byte(msg.Payload))
+				if err != nil {
 					errCh <- fmt.Errorf("unmarshal message: %v", err)
 					continue
 				}
 
-				outCh <- model.ToEntity()
+				outCh <- message
 			}
 		}
 	}()
@@ -101,48 +104,6 @@ func (c *MessageConsumer) Close() error {
 	return nil
 }
 
-type messageModel struct {
-	ID          int                `json:"id"`
-	ChatID      int                `json:"chat_id"`
-	ChatType    entity.ChatType    `json:"chat_type"`
-	SenderID    int                `json:"sender_id"`
-	Content     string             `json:"content"`
-	ContentType entity.ContentType `json:"content_type"`
-	IsService   bool               `json:"is_service"`
-	SentAt      time.Time          `json:"sent_at"`
-	DeliveredAt *time.Time         `json:"delivered_at,omitempty"`
-}
-
-func newMessageModel(message entity.Message) messageModel {
-	return messageModel{
-		ID:          message.ID,
-		ChatID:      message.ChatID.ID,
-		ChatType:    message.ChatID.Type,
-		SenderID:    message.SenderID,
-		Content:     message.Content,
-		ContentType: message.ContentType,
-		IsService:   message.IsService,
-		SentAt:      message.SentAt,
-		DeliveredAt: message.DeliveredAt,
-	}
-}
-
-func (m messageModel) ToEntity() entity.Message {
-	return entity.Message{
-		ID: m.ID,
-		ChatID: entity.ChatID{
-			ID:   m.ChatID,
-			Type: m.ChatType,
-		},
-		SenderID:    m.SenderID,
-		Content:     m.Content,
-		ContentType: m.ContentType,
-		IsService:   m.IsService,
-		SentAt:      m.SentAt,
-		DeliveredAt: m.DeliveredAt,
-	}
-}
-
 func chatChannelName(chatID entity.ChatID) string {
 	return fmt.Sprintf("%s:%d", chatID.Type, chatID.ID)
 }
@@ -2,26 +2,30 @@ package redis
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/internal/infrastructure/sysbus/codec"
 
 	"github.com/redis/go-redis/v9"
 )
 
+const allParticipantEventsChannelPattern = "user:*:participant_events"
+
 type ParticipantEventProduceConsumer struct {
-	cli *redis.Client
+	cli   *redis.Client
+	codec codec.Codec
 }
 
-func NewParticipantEventProduceConsumer(cli *redis.Client) *ParticipantEventProduceConsumer {
-	return &ParticipantEventProduceConsumer{cli: cli}
+func NewParticipantEventProduceConsumer(cli *redis.Client, cdc codec.Codec) *ParticipantEventProduceConsumer {
+	return &ParticipantEventProduceConsumer{
+		cli:   cli,
+		codec: cdc,
+	}
 }
 
 func (ps *ParticipantEventProduceConsumer) Produce(ctx context.Context, event entity.ParticipantEvent) error {
-	model := newParticipantEventModel(event)
-
-	bytes, err := json.Marshal(model)
+	bytes, err := ps.codec.MarshalParticipantEvent(event)
 	if err != nil {
 		return fmt.Errorf("marshal participant event: %v", err)
 	}
@@ -34,14 +38,26 @@ func (ps *ParticipantEventProduceConsumer) Produce(ctx context.Context, event en
 }
 
 func (ps *ParticipantEventProduceConsumer) BeginConsume(ctx context.Context, userID int) (<-chan entity.ParticipantEvent, <-chan error) {
+	return ps.consume(ctx, func() *redis.PubSub {
+		return ps.cli.Subscribe(ctx, participantEventsChannelName(userID))
+	})
+}
+
+// BeginConsumeAll consumes participant events of all users.
+func (ps *ParticipantEventProduceConsumer) BeginConsumeAll(ctx context.Context) (<-chan entity.ParticipantEvent, <-chan error) {
+	return ps.consume(ctx, func() *redis.PubSub {
+		return ps.cli.PSubscribe(ctx, allParticipantEventsChannelPattern)
+	})
+}
+
+func (ps *ParticipantEventProduceConsumer) consume(ctx context.Context, subscribe func() *redis.PubSub) (<-chan entity.ParticipantEvent, <-chan error) {
 	outCh, errCh := make(chan entity.ParticipantEvent), make(chan error)
 
 	go func() {
 		defer close(outCh)
 		defer close(errCh)
 
-		channel := participantEventsChannelName(userID)
-		pubSub := ps.cli.Subscribe(ctx, channel)
+		pubSub := subscribe()
 		defer pubSub.Close()
 
 		ch := pubSub.Channel()
@@ -54,13 +70,13 @@ func (ps *ParticipantEventProduceConsumer) BeginConsume(ctx context.Context, use
 					return
 				}
 
-				var model participantEventModel
-				if err := json.Unmarshal([]byte(msg.Payload), &model); err != nil {
+				event, err := ps.codec.UnmarshalParticipantEvent([]byte(msg.Payload))
+				if err != nil {
 					errCh <- fmt.Errorf("unmarshal participant event: %v", err)
 					continue
 				}
 
-				outCh <- model.ToEntity()
+				outCh <- event
 			}
 		}
 	}()
@@ -68,33 +84,6 @@ func (ps *ParticipantEventProduceConsumer) BeginConsume(ctx context.Context, use
 	return outCh, errCh
 }
 
-type participantEventModel struct {
-	Type     entity.ParticipantEventType `json:"type"`
-	ChatID   int                         `json:"chat_id"`
-	ChatType entity.ChatType             `json:"chat_type"`
-	UserID   int                         `json:"user_id"`
-}
-
-func newParticipantEventModel(event entity.ParticipantEvent) participantEventModel {
-	return participantEventModel{
-		Type:     event.Type,
-		ChatID:   event.ChatID.ID,
-		ChatType: event.ChatID.Type,
-		UserID:   event.UserID,
-	}
-}
-
-func (e participantEventModel) ToEntity() entity.ParticipantEvent {
-	return entity.ParticipantEvent{
-		Type: e.Type,
-		ChatID: entity.ChatID{
-			ID:   e.ChatID,
-			Type: e.ChatType,
-		},
-		UserID: e.UserID,
-	}
-}
-
 func participantEventsChannelName(userID int) string {
 	return fmt.Sprintf("user:%d:participant_events", userID)
 }
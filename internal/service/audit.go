@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Chatyx/backend/internal/dto"
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/ctxutil"
+)
+
+//go:generate mockery --inpackage --testonly --case underscore --name GroupAuditLogRepository
+type GroupAuditLogRepository interface {
+	List(ctx context.Context, obj dto.GroupAuditLogList) ([]entity.GroupAuditEntry, error)
+	Create(ctx context.Context, entry *entity.GroupAuditEntry) error
+}
+
+// GroupAuditLog gives admins access to the history of administrative actions in groups.
+type GroupAuditLog struct {
+	repo            GroupAuditLogRepository
+	participantRepo GroupParticipantRepository
+}
+
+func NewGroupAuditLog(repo GroupAuditLogRepository, participantRepo GroupParticipantRepository) *GroupAuditLog {
+	return &GroupAuditLog{
+		repo:            repo,
+		participantRepo: participantRepo,
+	}
+}
+
+func (l *GroupAuditLog) List(ctx context.Context, obj dto.GroupAuditLogList) ([]entity.GroupAuditEntry, error) {
+	curUserID := ctxutil.UserIDFromContext(ctx).ToInt()
+
+	curParticipant, err := checkGroupPermission(ctx, l.participantRepo, obj.GroupID, curUserID)
+	if err != nil {
+		return nil, fmt.Errorf("check permission: %w", err)
+	}
+
+	if !curParticipant.IsAdmin() {
+		return nil, fmt.Errorf("%w: only admins can view the audit log", entity.ErrForbiddenPerformAction)
+	}
+
+	entries, err := l.repo.List(ctx, obj)
+	if err != nil {
+		return nil, fmt.Errorf("list of group audit entries: %w", err)
+	}
+	return entries, nil
+}
+
+// recordGroupAudit records the action of the current user. It's supposed to be called
+// inside the transaction of the action, so the action isn't done without the record.
+func recordGroupAudit(
+	ctx context.Context,
+	repo GroupAuditLogRepository,
+	groupID int,
+	targetUserID *int,
+	action entity.GroupAuditAction,
+	before, after map[string]any,
+) error {
+	entry := entity.GroupAuditEntry{
+		GroupID:      groupID,
+		ActorID:      ctxutil.UserIDFromContext(ctx).ToInt(),
+		TargetUserID: targetUserID,
+		Action:       action,
+		Before:       before,
+		After:        after,
+		CreatedAt:    time.Now(),
+	}
+	if err := repo.Create(ctx, &entry); err != nil {
+		return fmt.Errorf("create group audit entry: %w", err)
+	}
+	return nil
+}
+
+func groupAuditState(group entity.Group) map[string]any {
+	return map[string]any{
+		"uname":       group.Uname,
+		"name":        group.Name,
+		"description": group.Description,
+	}
+}
+
+func groupSettingsAuditState(settings entity.GroupSettings) map[string]any {
+	return map[string]any{
+		"only_admins_can_post": settings.OnlyAdminsCanPost,
+		"slow_mode_interval":   int(settings.SlowModeInterval / time.Second),
+		"members_can_invite":   settings.MembersCanInvite,
+	}
+}
+
+func participantAuditState(participant entity.GroupParticipant) map[string]any {
+	state := map[string]any{
+		"role":   participant.Role.String(),
+		"status": participant.Status.String(),
+	}
+	if participant.RestrictedUntil != nil {
+		state["restricted_until"] = participant.RestrictedUntil.UTC().Format(time.RFC3339)
+	}
+	return state
+}
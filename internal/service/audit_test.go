@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Chatyx/backend/internal/dto"
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/ctxutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupAuditLog_List(t *testing.T) {
+	obj := dto.GroupAuditLogList{GroupID: 1, Limit: 20, Sort: dto.DescSort}
+	entries := []entity.GroupAuditEntry{
+		{ID: 2, GroupID: 1, ActorID: 1, Action: entity.GroupUpdatedAuditAction},
+		{ID: 1, GroupID: 1, ActorID: 1, Action: entity.ParticipantInvitedAuditAction},
+	}
+
+	testCases := []struct {
+		name            string
+		curParticipant  entity.GroupParticipant
+		participantErr  error
+		mockBehavior    func(repo *MockGroupAuditLogRepository)
+		expectedEntries []entity.GroupAuditEntry
+		expectedError   error
+	}{
+		{
+			name:           "Successful",
+			curParticipant: entity.GroupParticipant{GroupID: 1, UserID: 1, Role: entity.AdminRole, Status: entity.JoinedStatus},
+			mockBehavior: func(repo *MockGroupAuditLogRepository) {
+				repo.On("List", mock.Anything, obj).Return(entries, nil)
+			},
+			expectedEntries: entries,
+		},
+		{
+			name:           "Current participant isn't an admin",
+			curParticipant: entity.GroupParticipant{GroupID: 1, UserID: 1, Role: entity.ModeratorRole, Status: entity.JoinedStatus},
+			expectedError:  entity.ErrForbiddenPerformAction,
+		},
+		{
+			name:           "Current participant isn't in the group",
+			curParticipant: entity.GroupParticipant{GroupID: 1, UserID: 1, Role: entity.MemberRole, Status: entity.LeftStatus},
+			expectedError:  entity.ErrGroupNotFound,
+		},
+		{
+			name:           "Group isn't found",
+			participantErr: entity.ErrGroupParticipantNotFound,
+			expectedError:  entity.ErrGroupNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			repo := NewMockGroupAuditLogRepository(t)
+			participantRepo := NewMockGroupParticipantRepository(t)
+			participantRepo.On("Get", mock.Anything, 1, 1, false).Return(testCase.curParticipant, testCase.participantErr)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(repo)
+			}
+
+			service := NewGroupAuditLog(repo, participantRepo)
+			ctx := ctxutil.WithUserID(context.Background(), "1")
+
+			got, err := service.List(ctx, obj)
+			if testCase.expectedError == nil {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedEntries, got)
+			} else {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestGroupParticipant_Promote_AuditLog(t *testing.T) {
+	txm := NewMockTransactionManager(t)
+	txm.On("Do", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	})
+
+	repo := NewMockGroupParticipantRepository(t)
+	repo.On("Get", mock.Anything, 1, 1, false).Return(entity.GroupParticipant{
+		GroupID: 1,
+		UserID:  1,
+		Role:    entity.OwnerRole,
+		Status:  entity.JoinedStatus,
+	}, nil)
+	repo.On("Get", mock.Anything, 1, 2, true).Return(entity.GroupParticipant{
+		GroupID: 1,
+		UserID:  2,
+		Role:    entity.MemberRole,
+		Status:  entity.JoinedStatus,
+	}, nil)
+	repo.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+	auditRepo := NewMockGroupAuditLogRepository(t)
+	auditRepo.On("Create", mock.Anything, mock.MatchedBy(func(entry *entity.GroupAuditEntry) bool {
+		return entry.GroupID == 1 &&
+			entry.ActorID == 1 &&
+			entry.TargetUserID != nil && *entry.TargetUserID == 2 &&
+			entry.Action == entity.ParticipantRoleChangedAuditAction &&
+			assert.ObjectsAreEqual(map[string]any{"role": "member", "status": "joined"}, entry.Before) &&
+			assert.ObjectsAreEqual(map[string]any{"role": "admin", "status": "joined"}, entry.After)
+	})).Return(nil)
+
+	service := NewGroupParticipant(GroupParticipantConfig{
+		TxManager:          txm,
+		Repository:         repo,
+		AuditLogRepository: auditRepo,
+	})
+	ctx := ctxutil.WithUserID(context.Background(), "1")
+
+	require.NoError(t, service.Promote(ctx, 1, 2, entity.AdminRole))
+}
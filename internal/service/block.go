@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/ctxutil"
+)
+
+//go:generate mockery --inpackage --testonly --case underscore --name UserBlockRepository
+type UserBlockRepository interface {
+	List(ctx context.Context, userID int) ([]entity.UserBlock, error)
+	Create(ctx context.Context, block *entity.UserBlock) error
+	Delete(ctx context.Context, userID, blockedUserID int) error
+}
+
+//go:generate mockery --inpackage --testonly --case underscore --name UserBlockChecker
+type UserBlockChecker interface {
+	Exists(ctx context.Context, userID, blockedUserID int) (bool, error)
+}
+
+//go:generate mockery --inpackage --testonly --case underscore --name DialogBlocker
+type DialogBlocker interface {
+	SetPartnerBlocked(ctx context.Context, partnerUserID int, isBlocked bool) (entity.Dialog, bool, error)
+	NotifyPartnerBlocked(ctx context.Context, dialog entity.Dialog) error
+}
+
+type UserBlockConfig struct {
+	TxManager     TransactionManager
+	Repository    UserBlockRepository
+	DialogBlocker DialogBlocker
+}
+
+// UserBlock manages the account-wide blocklist of the current user.
+type UserBlock struct {
+	txm           TransactionManager
+	repo          UserBlockRepository
+	dialogBlocker DialogBlocker
+}
+
+func NewUserBlock(conf UserBlockConfig) *UserBlock {
+	return &UserBlock{
+		txm:           conf.TxManager,
+		repo:          conf.Repository,
+		dialogBlocker: conf.DialogBlocker,
+	}
+}
+
+func (b *UserBlock) List(ctx context.Context) ([]entity.UserBlock, error) {
+	curUserID := ctxutil.UserIDFromContext(ctx).ToInt()
+
+	blocks, err := b.repo.List(ctx, curUserID)
+	if err != nil {
+		return nil, fmt.Errorf("list of user blocks: %w", err)
+	}
+
+	return blocks, nil
+}
+
+// Block blocks the user for the current user. The existing dialog with the user
+// is blocked as well, so the blocked user can't write to it anymore.
+func (b *UserBlock) Block(ctx context.Context, userID int) (entity.UserBlock, error) {
+	curUserID := ctxutil.UserIDFromContext(ctx).ToInt()
+	if curUserID == userID {
+		return entity.UserBlock{}, entity.ErrBlockYourself
+	}
+
+	block := entity.UserBlock{
+		UserID:        curUserID,
+		BlockedUserID: userID,
+		CreatedAt:     time.Now(),
+	}
+
+	dialog, changed, err := b.setDialogBlocked(ctx, userID, true, func(ctx context.Context) error {
+		if err := b.repo.Create(ctx, &block); err != nil {
+			return fmt.Errorf("create user block: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return entity.UserBlock{}, err
+	}
+
+	if changed {
+		if err = b.dialogBlocker.NotifyPartnerBlocked(ctx, dialog); err != nil {
+			return entity.UserBlock{}, fmt.Errorf("notify about blocked dialog: %w", err)
+		}
+	}
+
+	return block, nil
+}
+
+// Unblock unblocks the user for the current user together with the existing dialog.
+func (b *UserBlock) Unblock(ctx context.Context, userID int) error {
+	curUserID := ctxutil.UserIDFromContext(ctx).ToInt()
+
+	dialog, changed, err := b.setDialogBlocked(ctx, userID, false, func(ctx context.Context) error {
+		if err := b.repo.Delete(ctx, curUserID, userID); err != nil {
+			return fmt.Errorf("delete user block: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if changed {
+		if err = b.dialogBlocker.NotifyPartnerBlocked(ctx, dialog); err != nil {
+			return fmt.Errorf("notify about unblocked dialog: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// setDialogBlocked changes the blocklist by the function and the existing dialog
+// with the user in one transaction, so they can't diverge.
+func (b *UserBlock) setDialogBlocked(
+	ctx context.Context,
+	userID int,
+	isBlocked bool,
+	fn func(ctx context.Context) error,
+) (dialog entity.Dialog, changed bool, err error) {
+	err = b.txm.Do(ctx, func(ctx context.Context) error {
+		if err := fn(ctx); err != nil {
+			return err
+		}
+
+		var err error
+		if dialog, changed, err = b.dialogBlocker.SetPartnerBlocked(ctx, userID, isBlocked); err != nil {
+			return fmt.Errorf("set dialog partner blocked: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return entity.Dialog{}, false, fmt.Errorf("call transaction manager: %w", err)
+	}
+
+	return dialog, changed, nil
+}
+
+// checkNotBlocked makes sure that neither of the users has blocked the other one.
+func checkNotBlocked(ctx context.Context, checker UserBlockChecker, userID, otherUserID int) error {
+	for _, pair := range [][2]int{{userID, otherUserID}, {otherUserID, userID}} {
+		blocked, err := checker.Exists(ctx, pair[0], pair[1])
+		if err != nil {
+			return fmt.Errorf("check user block: %w", err)
+		}
+		if blocked {
+			return fmt.Errorf("%w: user %d has blocked user %d", entity.ErrUserBlocked, pair[0], pair[1])
+		}
+	}
+
+	return nil
+}
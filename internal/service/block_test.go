@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/ctxutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserBlock_Block(t *testing.T) {
+	testCases := []struct {
+		name          string
+		userID        int
+		mockBehavior  func(repo *MockUserBlockRepository, dialogBlocker *MockDialogBlocker)
+		expectedError error
+	}{
+		{
+			name:   "Successful",
+			userID: 2,
+			mockBehavior: func(repo *MockUserBlockRepository, dialogBlocker *MockDialogBlocker) {
+				repo.On("Create", mock.Anything, mock.MatchedBy(func(block *entity.UserBlock) bool {
+					return block.UserID == 1 && block.BlockedUserID == 2
+				})).Return(nil)
+				dialog := entity.Dialog{ID: 1, Partner: entity.DialogPartner{UserID: 2, IsBlocked: true}}
+				dialogBlocker.On("SetPartnerBlocked", mock.Anything, 2, true).Return(dialog, true, nil)
+				dialogBlocker.On("NotifyPartnerBlocked", mock.Anything, dialog).Return(nil)
+			},
+		},
+		{
+			name:   "There is no dialog with the user",
+			userID: 2,
+			mockBehavior: func(repo *MockUserBlockRepository, dialogBlocker *MockDialogBlocker) {
+				repo.On("Create", mock.Anything, mock.Anything).Return(nil)
+				dialogBlocker.On("SetPartnerBlocked", mock.Anything, 2, true).Return(entity.Dialog{}, false, nil)
+			},
+		},
+		{
+			name:          "Blocking yourself",
+			userID:        1,
+			expectedError: entity.ErrBlockYourself,
+		},
+		{
+			name:   "User is already blocked",
+			userID: 2,
+			mockBehavior: func(repo *MockUserBlockRepository, _ *MockDialogBlocker) {
+				repo.On("Create", mock.Anything, mock.Anything).Return(entity.ErrSuchUserBlockAlreadyExists)
+			},
+			expectedError: entity.ErrSuchUserBlockAlreadyExists,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			repo := NewMockUserBlockRepository(t)
+			dialogBlocker := NewMockDialogBlocker(t)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(repo, dialogBlocker)
+			}
+
+			txm := NewMockTransactionManager(t)
+			txm.On("Do", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+				return fn(ctx)
+			}).Maybe()
+
+			service := NewUserBlock(UserBlockConfig{
+				TxManager:     txm,
+				Repository:    repo,
+				DialogBlocker: dialogBlocker,
+			})
+			ctx := ctxutil.WithUserID(context.Background(), "1")
+
+			block, err := service.Block(ctx, testCase.userID)
+			if testCase.expectedError == nil {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.userID, block.BlockedUserID)
+			} else {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestUserBlock_Unblock(t *testing.T) {
+	testCases := []struct {
+		name          string
+		mockBehavior  func(repo *MockUserBlockRepository, dialogBlocker *MockDialogBlocker)
+		expectedError error
+	}{
+		{
+			name: "Successful",
+			mockBehavior: func(repo *MockUserBlockRepository, dialogBlocker *MockDialogBlocker) {
+				repo.On("Delete", mock.Anything, 1, 2).Return(nil)
+				dialog := entity.Dialog{ID: 1, Partner: entity.DialogPartner{UserID: 2}}
+				dialogBlocker.On("SetPartnerBlocked", mock.Anything, 2, false).Return(dialog, true, nil)
+				dialogBlocker.On("NotifyPartnerBlocked", mock.Anything, dialog).Return(nil)
+			},
+		},
+		{
+			name: "User isn't blocked",
+			mockBehavior: func(repo *MockUserBlockRepository, _ *MockDialogBlocker) {
+				repo.On("Delete", mock.Anything, 1, 2).Return(entity.ErrUserBlockNotFound)
+			},
+			expectedError: entity.ErrUserBlockNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			repo := NewMockUserBlockRepository(t)
+			dialogBlocker := NewMockDialogBlocker(t)
+			testCase.mockBehavior(repo, dialogBlocker)
+
+			txm := NewMockTransactionManager(t)
+			txm.On("Do", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+				return fn(ctx)
+			}).Maybe()
+
+			service := NewUserBlock(UserBlockConfig{
+				TxManager:     txm,
+				Repository:    repo,
+				DialogBlocker: dialogBlocker,
+			})
+			ctx := ctxutil.WithUserID(context.Background(), "1")
+
+			err := service.Unblock(ctx, 2)
+			if testCase.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
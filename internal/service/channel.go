@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Chatyx/backend/internal/dto"
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/ctxutil"
+)
+
+//go:generate mockery --inpackage --testonly --case underscore --name ChannelRepository
+type ChannelRepository interface {
+	List(ctx context.Context) ([]entity.Channel, error)
+	Create(ctx context.Context, channel *entity.Channel) error
+	GetByID(ctx context.Context, id int) (entity.Channel, error)
+	Update(ctx context.Context, channel *entity.Channel) error
+	Delete(ctx context.Context, id int) error
+}
+
+type Channel struct {
+	repo ChannelRepository
+	prod GroupParticipantEventProducer
+}
+
+func NewChannel(repo ChannelRepository, prod GroupParticipantEventProducer) *Channel {
+	return &Channel{
+		repo: repo,
+		prod: prod,
+	}
+}
+
+func (c *Channel) List(ctx context.Context) ([]entity.Channel, error) {
+	channels, err := c.repo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list of channels: %w", err)
+	}
+
+	return channels, nil
+}
+
+func (c *Channel) Create(ctx context.Context, obj dto.ChannelCreate) (entity.Channel, error) {
+	channel := entity.Channel{
+		Uname:       obj.Uname,
+		Name:        obj.Name,
+		Description: obj.Description,
+		CreatedAt:   time.Now(),
+	}
+
+	if err := c.repo.Create(ctx, &channel); err != nil {
+		return entity.Channel{}, fmt.Errorf("create channel: %w", err)
+	}
+
+	event := entity.ParticipantEvent{
+		Type: entity.AddedParticipant,
+		ChatID: entity.ChatID{
+			ID:   channel.ID,
+			Type: entity.ChannelChatType,
+		},
+		UserID: ctxutil.UserIDFromContext(ctx).ToInt(),
+	}
+	if err := c.prod.Produce(ctx, event); err != nil {
+		return entity.Channel{}, fmt.Errorf("produce channel subscriber event: %w", err)
+	}
+
+	return channel, nil
+}
+
+func (c *Channel) GetByID(ctx context.Context, id int) (entity.Channel, error) {
+	channel, err := c.repo.GetByID(ctx, id)
+	if err != nil {
+		return entity.Channel{}, fmt.Errorf("get channel by id: %w", err)
+	}
+
+	return channel, nil
+}
+
+func (c *Channel) Update(ctx context.Context, obj dto.ChannelUpdate) (entity.Channel, error) {
+	channel := entity.Channel{
+		ID:          obj.ID,
+		Uname:       obj.Uname,
+		Name:        obj.Name,
+		Description: obj.Description,
+	}
+
+	if err := c.repo.Update(ctx, &channel); err != nil {
+		return entity.Channel{}, fmt.Errorf("update channel: %w", err)
+	}
+	return channel, nil
+}
+
+func (c *Channel) Delete(ctx context.Context, id int) error {
+	if err := c.repo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("delete channel: %w", err)
+	}
+
+	return nil
+}
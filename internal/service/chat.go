@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Chatyx/backend/internal/dto"
+	"github.com/Chatyx/backend/internal/entity"
+)
+
+//go:generate mockery --inpackage --testonly --case underscore --name ChatRepository
+type ChatRepository interface {
+	List(ctx context.Context, obj dto.ChatList) ([]entity.ChatListItem, error)
+}
+
+// Chat lists dialogs and groups of the current user together.
+type Chat struct {
+	repo       ChatRepository
+	folderRepo ChatFolderRepository
+}
+
+func NewChat(repo ChatRepository, folderRepo ChatFolderRepository) *Chat {
+	return &Chat{
+		repo:       repo,
+		folderRepo: folderRepo,
+	}
+}
+
+func (c *Chat) List(ctx context.Context, obj dto.ChatList) ([]entity.ChatListItem, error) {
+	if obj.FolderID != nil {
+		if _, err := c.folderRepo.GetByID(ctx, *obj.FolderID); err != nil {
+			return nil, fmt.Errorf("get chat folder: %w", err)
+		}
+	}
+
+	items, err := c.repo.List(ctx, obj)
+	if err != nil {
+		return nil, fmt.Errorf("list of chats: %w", err)
+	}
+
+	return items, nil
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Chatyx/backend/internal/dto"
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/ctxutil"
+)
+
+// maxChatFolders is the max number of chat folders of a user.
+const maxChatFolders = 10
+
+//go:generate mockery --inpackage --testonly --case underscore --name ChatFolderRepository
+type ChatFolderRepository interface {
+	List(ctx context.Context) ([]entity.ChatFolder, error)
+	GetByID(ctx context.Context, id int) (entity.ChatFolder, error)
+	Count(ctx context.Context, withLock bool) (int, error)
+	Create(ctx context.Context, folder *entity.ChatFolder) error
+	Update(ctx context.Context, folder *entity.ChatFolder) error
+	Delete(ctx context.Context, id int) error
+}
+
+//go:generate mockery --inpackage --testonly --case underscore --name ChatFolderEventProducer
+type ChatFolderEventProducer interface {
+	Produce(ctx context.Context, event entity.ParticipantEvent) error
+}
+
+type ChatFolderConfig struct {
+	TxManager     TransactionManager
+	Repository    ChatFolderRepository
+	EventProducer ChatFolderEventProducer
+}
+
+// ChatFolder manages user-defined folders of the current user's chats.
+// Other devices of the user are notified about changes of the folders.
+type ChatFolder struct {
+	txm  TransactionManager
+	repo ChatFolderRepository
+	prod ChatFolderEventProducer
+}
+
+func NewChatFolder(conf ChatFolderConfig) *ChatFolder {
+	return &ChatFolder{
+		txm:  conf.TxManager,
+		repo: conf.Repository,
+		prod: conf.EventProducer,
+	}
+}
+
+func (f *ChatFolder) List(ctx context.Context) ([]entity.ChatFolder, error) {
+	folders, err := f.repo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list of chat folders: %w", err)
+	}
+
+	return folders, nil
+}
+
+func (f *ChatFolder) GetByID(ctx context.Context, id int) (entity.ChatFolder, error) {
+	folder, err := f.repo.GetByID(ctx, id)
+	if err != nil {
+		return entity.ChatFolder{}, fmt.Errorf("get chat folder: %w", err)
+	}
+
+	return folder, nil
+}
+
+func (f *ChatFolder) Create(ctx context.Context, obj dto.ChatFolderCreate) (entity.ChatFolder, error) {
+	folder := entity.ChatFolder{
+		UserID:          ctxutil.UserIDFromContext(ctx).ToInt(),
+		Name:            obj.Name,
+		IncludeDialogs:  obj.IncludeDialogs,
+		IncludeGroups:   obj.IncludeGroups,
+		ExcludeMuted:    obj.ExcludeMuted,
+		ExcludeArchived: obj.ExcludeArchived,
+		IncludedChats:   obj.IncludedChats,
+		ExcludedChats:   obj.ExcludedChats,
+		CreatedAt:       time.Now(),
+	}
+
+	err := f.txm.Do(ctx, func(ctx context.Context) error {
+		count, err := f.repo.Count(ctx, true)
+		if err != nil {
+			return fmt.Errorf("count chat folders: %w", err)
+		}
+
+		if count >= maxChatFolders {
+			return entity.ErrChatFolderLimitReached
+		}
+
+		if err = f.repo.Create(ctx, &folder); err != nil {
+			return fmt.Errorf("create chat folder: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return entity.ChatFolder{}, err
+	}
+
+	if err = f.produceUpdated(ctx); err != nil {
+		return entity.ChatFolder{}, err
+	}
+	return folder, nil
+}
+
+func (f *ChatFolder) Update(ctx context.Context, obj dto.ChatFolderUpdate) (entity.ChatFolder, error) {
+	folder := entity.ChatFolder{
+		ID:              obj.ID,
+		UserID:          ctxutil.UserIDFromContext(ctx).ToInt(),
+		Name:            obj.Name,
+		IncludeDialogs:  obj.IncludeDialogs,
+		IncludeGroups:   obj.IncludeGroups,
+		ExcludeMuted:    obj.ExcludeMuted,
+		ExcludeArchived: obj.ExcludeArchived,
+		IncludedChats:   obj.IncludedChats,
+		ExcludedChats:   obj.ExcludedChats,
+	}
+
+	err := f.txm.Do(ctx, func(ctx context.Context) error {
+		if err := f.repo.Update(ctx, &folder); err != nil {
+			return fmt.Errorf("update chat folder: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return entity.ChatFolder{}, err
+	}
+
+	if err = f.produceUpdated(ctx); err != nil {
+		return entity.ChatFolder{}, err
+	}
+	return folder, nil
+}
+
+func (f *ChatFolder) Delete(ctx context.Context, id int) error {
+	if err := f.repo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("delete chat folder: %w", err)
+	}
+
+	return f.produceUpdated(ctx)
+}
+
+func (f *ChatFolder) produceUpdated(ctx context.Context) error {
+	curUserID := ctxutil.UserIDFromContext(ctx).ToInt()
+	event := entity.ParticipantEvent{
+		Type:        entity.ChatFoldersUpdated,
+		UserID:      curUserID,
+		InitiatorID: curUserID,
+	}
+	if err := f.prod.Produce(ctx, event); err != nil {
+		return fmt.Errorf("produce chat folders event: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Chatyx/backend/internal/dto"
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/ctxutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChatFolder_Create(t *testing.T) {
+	obj := dto.ChatFolderCreate{
+		Name:          "Work",
+		IncludeGroups: true,
+		ExcludedChats: []entity.ChatID{{ID: 3, Type: entity.GroupChatType}},
+	}
+
+	testCases := []struct {
+		name           string
+		mockBehavior   func(repo *MockChatFolderRepository, prod *MockChatFolderEventProducer)
+		expectedFolder entity.ChatFolder
+		expectedError  error
+	}{
+		{
+			name: "Successful",
+			mockBehavior: func(repo *MockChatFolderRepository, prod *MockChatFolderEventProducer) {
+				repo.On("Count", mock.Anything, true).Return(1, nil)
+				repo.On("Create", mock.Anything, mock.Anything).Return(func(_ context.Context, folder *entity.ChatFolder) error {
+					folder.ID = 2
+					return nil
+				})
+				prod.On("Produce", mock.Anything, entity.ParticipantEvent{
+					Type:        entity.ChatFoldersUpdated,
+					UserID:      1,
+					InitiatorID: 1,
+				}).Return(nil)
+			},
+			expectedFolder: entity.ChatFolder{
+				ID:            2,
+				UserID:        1,
+				Name:          "Work",
+				IncludeGroups: true,
+				ExcludedChats: []entity.ChatID{{ID: 3, Type: entity.GroupChatType}},
+			},
+		},
+		{
+			name: "Limit is reached",
+			mockBehavior: func(repo *MockChatFolderRepository, _ *MockChatFolderEventProducer) {
+				repo.On("Count", mock.Anything, true).Return(maxChatFolders, nil)
+			},
+			expectedError: entity.ErrChatFolderLimitReached,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			txm := NewMockTransactionManager(t)
+			txm.On("Do", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+				return fn(ctx)
+			})
+
+			repo := NewMockChatFolderRepository(t)
+			prod := NewMockChatFolderEventProducer(t)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(repo, prod)
+			}
+
+			service := NewChatFolder(ChatFolderConfig{
+				TxManager:     txm,
+				Repository:    repo,
+				EventProducer: prod,
+			})
+			ctx := ctxutil.WithUserID(context.Background(), "1")
+
+			folder, err := service.Create(ctx, obj)
+			if testCase.expectedError == nil {
+				require.NoError(t, err)
+				folder.CreatedAt = testCase.expectedFolder.CreatedAt
+				assert.Equal(t, testCase.expectedFolder, folder)
+			} else {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
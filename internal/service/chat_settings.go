@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Chatyx/backend/internal/dto"
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/ctxutil"
+)
+
+//go:generate mockery --inpackage --testonly --case underscore --name ChatSettingsRepository
+type ChatSettingsRepository interface {
+	Get(ctx context.Context, chatID entity.ChatID) (entity.ChatSettings, error)
+	ListMuted(ctx context.Context) ([]entity.ChatSettings, error)
+	MaxPinnedOrder(ctx context.Context) (int, error)
+	Update(ctx context.Context, settings entity.ChatSettings) error
+}
+
+//go:generate mockery --inpackage --testonly --case underscore --name ChatSettingsEventProducer
+type ChatSettingsEventProducer interface {
+	Produce(ctx context.Context, event entity.ParticipantEvent) error
+}
+
+type ChatSettingsConfig struct {
+	TxManager     TransactionManager
+	Repository    ChatSettingsRepository
+	EventProducer ChatSettingsEventProducer
+}
+
+// ChatSettings manages settings of dialogs and groups which are personal for the current user.
+type ChatSettings struct {
+	txm  TransactionManager
+	repo ChatSettingsRepository
+	prod ChatSettingsEventProducer
+}
+
+func NewChatSettings(conf ChatSettingsConfig) *ChatSettings {
+	return &ChatSettings{
+		txm:  conf.TxManager,
+		repo: conf.Repository,
+		prod: conf.EventProducer,
+	}
+}
+
+func (s *ChatSettings) Get(ctx context.Context, chatID entity.ChatID) (entity.ChatSettings, error) {
+	settings, err := s.repo.Get(ctx, chatID)
+	if err != nil {
+		return entity.ChatSettings{}, fmt.Errorf("get chat settings: %w", err)
+	}
+
+	return settings, nil
+}
+
+// Update updates settings of the chat. A newly pinned chat gets the greatest pinned order,
+// so it's listed before the chats pinned earlier. Other devices of the current user
+// are notified about the change.
+func (s *ChatSettings) Update(ctx context.Context, obj dto.ChatSettingsUpdate) (entity.ChatSettings, error) {
+	settings := entity.ChatSettings{
+		ChatID:     obj.ChatID,
+		MutedUntil: obj.MutedUntil,
+		IsArchived: obj.IsArchived,
+	}
+
+	err := s.txm.Do(ctx, func(ctx context.Context) error {
+		prevSettings, err := s.repo.Get(ctx, obj.ChatID)
+		if err != nil {
+			return fmt.Errorf("get chat settings: %w", err)
+		}
+
+		if obj.IsPinned {
+			settings.PinnedOrder = prevSettings.PinnedOrder
+			if settings.PinnedOrder == nil {
+				order, err := s.repo.MaxPinnedOrder(ctx)
+				if err != nil {
+					return fmt.Errorf("get max pinned order: %w", err)
+				}
+
+				order++
+				settings.PinnedOrder = &order
+			}
+		}
+
+		if err = s.repo.Update(ctx, settings); err != nil {
+			return fmt.Errorf("update chat settings: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return entity.ChatSettings{}, err
+	}
+
+	curUserID := ctxutil.UserIDFromContext(ctx).ToInt()
+	event := entity.ParticipantEvent{
+		Type:        entity.ChatSettingsUpdated,
+		ChatID:      obj.ChatID,
+		UserID:      curUserID,
+		InitiatorID: curUserID,
+	}
+	if err = s.prod.Produce(ctx, event); err != nil {
+		return entity.ChatSettings{}, fmt.Errorf("produce chat settings event: %w", err)
+	}
+
+	return settings, nil
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Chatyx/backend/internal/dto"
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/ctxutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChatSettings_Update(t *testing.T) {
+	chatID := entity.ChatID{ID: 1, Type: entity.GroupChatType}
+	mutedUntil := time.Now().Add(time.Hour)
+	intPtr := func(v int) *int { return &v }
+
+	testCases := []struct {
+		name             string
+		obj              dto.ChatSettingsUpdate
+		mockBehavior     func(repo *MockChatSettingsRepository, prod *MockChatSettingsEventProducer)
+		expectedSettings entity.ChatSettings
+		expectedError    error
+	}{
+		{
+			name: "Pin the chat",
+			obj:  dto.ChatSettingsUpdate{ChatID: chatID, MutedUntil: &mutedUntil, IsPinned: true},
+			mockBehavior: func(repo *MockChatSettingsRepository, prod *MockChatSettingsEventProducer) {
+				repo.On("Get", mock.Anything, chatID).Return(entity.ChatSettings{ChatID: chatID}, nil)
+				repo.On("MaxPinnedOrder", mock.Anything).Return(2, nil)
+				repo.On("Update", mock.Anything, entity.ChatSettings{
+					ChatID:      chatID,
+					MutedUntil:  &mutedUntil,
+					PinnedOrder: intPtr(3),
+				}).Return(nil)
+				prod.On("Produce", mock.Anything, entity.ParticipantEvent{
+					Type:        entity.ChatSettingsUpdated,
+					ChatID:      chatID,
+					UserID:      1,
+					InitiatorID: 1,
+				}).Return(nil)
+			},
+			expectedSettings: entity.ChatSettings{ChatID: chatID, MutedUntil: &mutedUntil, PinnedOrder: intPtr(3)},
+		},
+		{
+			name: "Keep the order of the pinned chat",
+			obj:  dto.ChatSettingsUpdate{ChatID: chatID, IsPinned: true, IsArchived: true},
+			mockBehavior: func(repo *MockChatSettingsRepository, prod *MockChatSettingsEventProducer) {
+				repo.On("Get", mock.Anything, chatID).Return(entity.ChatSettings{ChatID: chatID, PinnedOrder: intPtr(1)}, nil)
+				repo.On("Update", mock.Anything, entity.ChatSettings{
+					ChatID:      chatID,
+					PinnedOrder: intPtr(1),
+					IsArchived:  true,
+				}).Return(nil)
+				prod.On("Produce", mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedSettings: entity.ChatSettings{ChatID: chatID, PinnedOrder: intPtr(1), IsArchived: true},
+		},
+		{
+			name: "Unpin the chat",
+			obj:  dto.ChatSettingsUpdate{ChatID: chatID},
+			mockBehavior: func(repo *MockChatSettingsRepository, prod *MockChatSettingsEventProducer) {
+				repo.On("Get", mock.Anything, chatID).Return(entity.ChatSettings{ChatID: chatID, PinnedOrder: intPtr(1)}, nil)
+				repo.On("Update", mock.Anything, entity.ChatSettings{ChatID: chatID}).Return(nil)
+				prod.On("Produce", mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedSettings: entity.ChatSettings{ChatID: chatID},
+		},
+		{
+			name: "Chat isn't found",
+			obj:  dto.ChatSettingsUpdate{ChatID: chatID, IsPinned: true},
+			mockBehavior: func(repo *MockChatSettingsRepository, _ *MockChatSettingsEventProducer) {
+				repo.On("Get", mock.Anything, chatID).Return(entity.ChatSettings{}, entity.ErrChatNotFound)
+			},
+			expectedError: entity.ErrChatNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			txm := NewMockTransactionManager(t)
+			txm.On("Do", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+				return fn(ctx)
+			})
+
+			repo := NewMockChatSettingsRepository(t)
+			prod := NewMockChatSettingsEventProducer(t)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(repo, prod)
+			}
+
+			service := NewChatSettings(ChatSettingsConfig{
+				TxManager:     txm,
+				Repository:    repo,
+				EventProducer: prod,
+			})
+			ctx := ctxutil.WithUserID(context.Background(), "1")
+
+			settings, err := service.Update(ctx, testCase.obj)
+			if testCase.expectedError == nil {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedSettings, settings)
+			} else {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Chatyx/backend/internal/dto"
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/ctxutil"
+)
+
+//go:generate mockery --inpackage --testonly --case underscore --name ContactRepository
+type ContactRepository interface {
+	List(ctx context.Context, userID int) ([]entity.Contact, error)
+	Get(ctx context.Context, userID, contactUserID int) (entity.Contact, error)
+	Create(ctx context.Context, contact *entity.Contact) error
+	Update(ctx context.Context, contact *entity.Contact) error
+	Delete(ctx context.Context, userID, contactUserID int) error
+}
+
+//go:generate mockery --inpackage --testonly --case underscore --name ContactUserRepository
+type ContactUserRepository interface {
+	GetByID(ctx context.Context, id int) (entity.User, error)
+	GetByUsername(ctx context.Context, username string) (entity.User, error)
+	GetByEmail(ctx context.Context, email string) (entity.User, error)
+}
+
+//go:generate mockery --inpackage --testonly --case underscore --name ContactChecker
+type ContactChecker interface {
+	Exists(ctx context.Context, userID, contactUserID int) (bool, error)
+}
+
+type ContactConfig struct {
+	Repository     ContactRepository
+	UserRepository ContactUserRepository
+}
+
+// Contact manages the contacts of the current user.
+type Contact struct {
+	repo     ContactRepository
+	userRepo ContactUserRepository
+}
+
+func NewContact(conf ContactConfig) *Contact {
+	return &Contact{
+		repo:     conf.Repository,
+		userRepo: conf.UserRepository,
+	}
+}
+
+func (c *Contact) List(ctx context.Context) ([]entity.Contact, error) {
+	curUserID := ctxutil.UserIDFromContext(ctx).ToInt()
+
+	contacts, err := c.repo.List(ctx, curUserID)
+	if err != nil {
+		return nil, fmt.Errorf("list of contacts: %w", err)
+	}
+
+	return contacts, nil
+}
+
+func (c *Contact) Get(ctx context.Context, contactUserID int) (entity.Contact, error) {
+	curUserID := ctxutil.UserIDFromContext(ctx).ToInt()
+
+	contact, err := c.repo.Get(ctx, curUserID, contactUserID)
+	if err != nil {
+		return entity.Contact{}, fmt.Errorf("get contact: %w", err)
+	}
+
+	return contact, nil
+}
+
+func (c *Contact) Create(ctx context.Context, obj dto.ContactCreate) (entity.Contact, error) {
+	curUserID := ctxutil.UserIDFromContext(ctx).ToInt()
+	if curUserID == obj.ContactUserID {
+		return entity.Contact{}, entity.ErrAddYourselfToContacts
+	}
+
+	// Deleted users are still referenced by foreign keys, so they are checked explicitly.
+	if _, err := c.userRepo.GetByID(ctx, obj.ContactUserID); err != nil {
+		if errors.Is(err, entity.ErrUserNotFound) {
+			return entity.Contact{}, fmt.Errorf("%w: %v", entity.ErrAddNonExistentUserToContacts, err)
+		}
+		return entity.Contact{}, fmt.Errorf("get user by id: %w", err)
+	}
+
+	contact := entity.Contact{
+		UserID:        curUserID,
+		ContactUserID: obj.ContactUserID,
+		DisplayName:   obj.DisplayName,
+		CreatedAt:     time.Now(),
+	}
+	if err := c.repo.Create(ctx, &contact); err != nil {
+		return entity.Contact{}, fmt.Errorf("create contact: %w", err)
+	}
+
+	return contact, nil
+}
+
+func (c *Contact) Update(ctx context.Context, obj dto.ContactUpdate) (entity.Contact, error) {
+	contact := entity.Contact{
+		UserID:        ctxutil.UserIDFromContext(ctx).ToInt(),
+		ContactUserID: obj.ContactUserID,
+		DisplayName:   obj.DisplayName,
+	}
+	if err := c.repo.Update(ctx, &contact); err != nil {
+		return entity.Contact{}, fmt.Errorf("update contact: %w", err)
+	}
+
+	return contact, nil
+}
+
+func (c *Contact) Delete(ctx context.Context, contactUserID int) error {
+	curUserID := ctxutil.UserIDFromContext(ctx).ToInt()
+
+	if err := c.repo.Delete(ctx, curUserID, contactUserID); err != nil {
+		return fmt.Errorf("delete contact: %w", err)
+	}
+
+	return nil
+}
+
+// Import adds users found by usernames and emails to the contacts. Users who can't be
+// added are reported in the results instead of failing the whole import.
+func (c *Contact) Import(ctx context.Context, obj dto.ContactImport) ([]entity.ContactImportResult, error) {
+	curUserID := ctxutil.UserIDFromContext(ctx).ToInt()
+	results := make([]entity.ContactImportResult, 0, len(obj.Usernames)+len(obj.Emails))
+
+	for _, username := range obj.Usernames {
+		result, err := c.importUser(ctx, curUserID, username, c.userRepo.GetByUsername)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	for _, email := range obj.Emails {
+		result, err := c.importUser(ctx, curUserID, email, c.userRepo.GetByEmail)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+type userGetter func(ctx context.Context, query string) (entity.User, error)
+
+func (c *Contact) importUser(ctx context.Context, curUserID int, query string, getUser userGetter) (entity.ContactImportResult, error) {
+	result := entity.ContactImportResult{Query: query}
+
+	user, err := getUser(ctx, query)
+	if err != nil {
+		if !errors.Is(err, entity.ErrUserNotFound) {
+			return result, fmt.Errorf("get user: %w", err)
+		}
+
+		result.Status = entity.NotFoundContactImportStatus
+		return result, nil
+	}
+
+	result.UserID = user.ID
+	if user.ID == curUserID {
+		result.Status = entity.SelfContactImportStatus
+		return result, nil
+	}
+
+	contact := entity.Contact{
+		UserID:        curUserID,
+		ContactUserID: user.ID,
+		CreatedAt:     time.Now(),
+	}
+
+	switch err = c.repo.Create(ctx, &contact); {
+	case err == nil:
+		result.Status = entity.AddedContactImportStatus
+	case errors.Is(err, entity.ErrSuchContactAlreadyExists):
+		result.Status = entity.AlreadyExistsContactImportStatus
+	default:
+		return result, fmt.Errorf("create contact: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Chatyx/backend/internal/dto"
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/ctxutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContact_Create(t *testing.T) {
+	testCases := []struct {
+		name            string
+		obj             dto.ContactCreate
+		mockBehavior    func(repo *MockContactRepository, userRepo *MockContactUserRepository)
+		expectedContact entity.Contact
+		expectedError   error
+	}{
+		{
+			name: "Successful",
+			obj:  dto.ContactCreate{ContactUserID: 2, DisplayName: "Mick"},
+			mockBehavior: func(repo *MockContactRepository, userRepo *MockContactUserRepository) {
+				userRepo.On("GetByID", mock.Anything, 2).Return(entity.User{ID: 2}, nil)
+				repo.On("Create", mock.Anything, mock.Anything).Return(func(_ context.Context, contact *entity.Contact) error {
+					contact.IsMutual = true
+					return nil
+				})
+			},
+			expectedContact: entity.Contact{UserID: 1, ContactUserID: 2, DisplayName: "Mick", IsMutual: true},
+		},
+		{
+			name:          "Adding yourself",
+			obj:           dto.ContactCreate{ContactUserID: 1},
+			expectedError: entity.ErrAddYourselfToContacts,
+		},
+		{
+			name: "User doesn't exist",
+			obj:  dto.ContactCreate{ContactUserID: 2},
+			mockBehavior: func(_ *MockContactRepository, userRepo *MockContactUserRepository) {
+				userRepo.On("GetByID", mock.Anything, 2).Return(entity.User{}, entity.ErrUserNotFound)
+			},
+			expectedError: entity.ErrAddNonExistentUserToContacts,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			repo := NewMockContactRepository(t)
+			userRepo := NewMockContactUserRepository(t)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(repo, userRepo)
+			}
+
+			service := NewContact(ContactConfig{
+				Repository:     repo,
+				UserRepository: userRepo,
+			})
+			ctx := ctxutil.WithUserID(context.Background(), "1")
+
+			contact, err := service.Create(ctx, testCase.obj)
+			if testCase.expectedError == nil {
+				require.NoError(t, err)
+				contact.CreatedAt = testCase.expectedContact.CreatedAt
+				assert.Equal(t, testCase.expectedContact, contact)
+			} else {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestContact_Import(t *testing.T) {
+	repo := NewMockContactRepository(t)
+	repo.On("Create", mock.Anything, mock.MatchedBy(func(contact *entity.Contact) bool {
+		return contact.ContactUserID == 2
+	})).Return(nil).Once()
+	repo.On("Create", mock.Anything, mock.MatchedBy(func(contact *entity.Contact) bool {
+		return contact.ContactUserID == 3
+	})).Return(entity.ErrSuchContactAlreadyExists).Once()
+
+	userRepo := NewMockContactUserRepository(t)
+	userRepo.On("GetByUsername", mock.Anything, "mick49").Return(entity.User{ID: 2}, nil)
+	userRepo.On("GetByUsername", mock.Anything, "me").Return(entity.User{ID: 1}, nil)
+	userRepo.On("GetByEmail", mock.Anything, "john1967@gmail.com").Return(entity.User{ID: 3}, nil)
+	userRepo.On("GetByEmail", mock.Anything, "nobody@gmail.com").Return(entity.User{}, entity.ErrUserNotFound)
+
+	service := NewContact(ContactConfig{
+		Repository:     repo,
+		UserRepository: userRepo,
+	})
+	ctx := ctxutil.WithUserID(context.Background(), "1")
+
+	results, err := service.Import(ctx, dto.ContactImport{
+		Usernames: []string{"mick49", "me"},
+		Emails:    []string{"john1967@gmail.com", "nobody@gmail.com"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []entity.ContactImportResult{
+		{Query: "mick49", UserID: 2, Status: entity.AddedContactImportStatus},
+		{Query: "me", UserID: 1, Status: entity.SelfContactImportStatus},
+		{Query: "john1967@gmail.com", UserID: 3, Status: entity.AlreadyExistsContactImportStatus},
+		{Query: "nobody@gmail.com", Status: entity.NotFoundContactImportStatus},
+	}, results)
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -11,26 +12,60 @@ import (
 	"github.com/Chatyx/backend/pkg/log"
 )
 
+//go:generate mockery --inpackage --testonly --case underscore --name DialogRepository
 type DialogRepository interface {
 	List(ctx context.Context) ([]entity.Dialog, error)
+	ListAll(ctx context.Context) ([]entity.Dialog, error)
+	ListRequests(ctx context.Context) ([]entity.Dialog, error)
 	Create(ctx context.Context, dialog *entity.Dialog) error
+	CreateRequest(ctx context.Context, request *entity.DialogRequest) error
+	ResolveRequest(ctx context.Context, dialogID int, status entity.DialogRequestStatus) error
 	GetByID(ctx context.Context, id int) (entity.Dialog, error)
+	GetByPartnerID(ctx context.Context, partnerUserID int) (entity.Dialog, error)
 	Update(ctx context.Context, dialog *entity.Dialog) error
+	ClearHistory(ctx context.Context, dialogID int, hide bool) error
+	Delete(ctx context.Context, dialogID int) error
 }
 
+//go:generate mockery --inpackage --testonly --case underscore --name DialogUserBlocker
+type DialogUserBlocker interface {
+	Create(ctx context.Context, block *entity.UserBlock) error
+}
+
+//go:generate mockery --inpackage --testonly --case underscore --name DialogParticipantEventProducer
 type DialogParticipantEventProducer interface {
 	Produce(ctx context.Context, event entity.ParticipantEvent) error
 }
 
+type DialogConfig struct {
+	TxManager      TransactionManager
+	Repository     DialogRepository
+	EventProducer  DialogParticipantEventProducer
+	MessageCreator ServiceMessageCreator
+	BlockChecker   UserBlockChecker
+	ContactChecker ContactChecker
+	UserBlocker    DialogUserBlocker
+}
+
 type Dialog struct {
-	repo DialogRepository
-	prod DialogParticipantEventProducer
+	txm            TransactionManager
+	repo           DialogRepository
+	prod           DialogParticipantEventProducer
+	msgCreator     ServiceMessageCreator
+	blockChecker   UserBlockChecker
+	contactChecker ContactChecker
+	userBlocker    DialogUserBlocker
 }
 
-func NewDialog(repo DialogRepository, prod DialogParticipantEventProducer) *Dialog {
+func NewDialog(conf DialogConfig) *Dialog {
 	return &Dialog{
-		repo: repo,
-		prod: prod,
+		txm:            conf.TxManager,
+		repo:           conf.Repository,
+		prod:           conf.EventProducer,
+		msgCreator:     conf.MessageCreator,
+		blockChecker:   conf.BlockChecker,
+		contactChecker: conf.ContactChecker,
+		userBlocker:    conf.UserBlocker,
 	}
 }
 
@@ -43,7 +78,33 @@ func (g *Dialog) List(ctx context.Context) ([]entity.Dialog, error) {
 	return dialogs, nil
 }
 
+// ListRequests lists pending message requests sent to the current user.
+func (g *Dialog) ListRequests(ctx context.Context) ([]entity.Dialog, error) {
+	dialogs, err := g.repo.ListRequests(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list of dialog requests: %w", err)
+	}
+
+	return dialogs, nil
+}
+
+// Create creates a dialog with the partner. If the current user isn't in the contacts
+// of the partner, the dialog becomes a message request which the partner has to accept.
 func (g *Dialog) Create(ctx context.Context, obj dto.DialogCreate) (entity.Dialog, error) {
+	curUserID := ctxutil.UserIDFromContext(ctx).ToInt()
+	if curUserID == obj.PartnerUserID {
+		return entity.Dialog{}, entity.ErrCreateDialogWithYourself
+	}
+
+	if err := checkNotBlocked(ctx, g.blockChecker, curUserID, obj.PartnerUserID); err != nil {
+		return entity.Dialog{}, err
+	}
+
+	isContact, err := g.contactChecker.Exists(ctx, obj.PartnerUserID, curUserID)
+	if err != nil {
+		return entity.Dialog{}, fmt.Errorf("check contact: %w", err)
+	}
+
 	dialog := entity.Dialog{
 		Partner: entity.DialogPartner{
 			UserID: obj.PartnerUserID,
@@ -51,8 +112,29 @@ func (g *Dialog) Create(ctx context.Context, obj dto.DialogCreate) (entity.Dialo
 		CreatedAt: time.Now(),
 	}
 
-	if err := g.repo.Create(ctx, &dialog); err != nil {
-		return entity.Dialog{}, fmt.Errorf("create dialog: %w", err)
+	err = g.txm.Do(ctx, func(ctx context.Context) error {
+		if err := g.repo.Create(ctx, &dialog); err != nil {
+			return fmt.Errorf("create dialog: %w", err)
+		}
+		if isContact {
+			return nil
+		}
+
+		request := entity.DialogRequest{
+			DialogID:    dialog.ID,
+			SenderID:    curUserID,
+			RecipientID: obj.PartnerUserID,
+			Status:      entity.PendingDialogRequestStatus,
+			CreatedAt:   dialog.CreatedAt,
+		}
+		if err := g.repo.CreateRequest(ctx, &request); err != nil {
+			return fmt.Errorf("create dialog request: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.Dialog{}, err
 	}
 
 	chatID := entity.ChatID{ID: dialog.ID, Type: entity.DialogChatType}
@@ -60,7 +142,7 @@ func (g *Dialog) Create(ctx context.Context, obj dto.DialogCreate) (entity.Dialo
 		{
 			Type:   entity.AddedParticipant,
 			ChatID: chatID,
-			UserID: ctxutil.UserIDFromContext(ctx).ToInt(),
+			UserID: curUserID,
 		},
 		{
 			Type:   entity.AddedParticipant,
@@ -77,6 +159,51 @@ func (g *Dialog) Create(ctx context.Context, obj dto.DialogCreate) (entity.Dialo
 	return dialog, nil
 }
 
+// GetSaved gets the saved messages dialog of the current user with themselves.
+// The dialog is created on the first access, and all sessions of the user
+// are subscribed to it, so the saved messages are synced across devices.
+func (g *Dialog) GetSaved(ctx context.Context) (entity.Dialog, error) {
+	curUserID := ctxutil.UserIDFromContext(ctx).ToInt()
+
+	dialog, err := g.repo.GetByPartnerID(ctx, curUserID)
+	if err == nil {
+		return dialog, nil
+	}
+	if !errors.Is(err, entity.ErrDialogNotFound) {
+		return entity.Dialog{}, fmt.Errorf("get saved messages dialog: %w", err)
+	}
+
+	dialog = entity.Dialog{
+		Partner: entity.DialogPartner{
+			UserID: curUserID,
+		},
+		CreatedAt: time.Now(),
+	}
+
+	if err = g.repo.Create(ctx, &dialog); err != nil {
+		if !errors.Is(err, entity.ErrSuchDialogAlreadyExists) {
+			return entity.Dialog{}, fmt.Errorf("create saved messages dialog: %w", err)
+		}
+
+		// Another session of the user has created the dialog concurrently.
+		if dialog, err = g.repo.GetByPartnerID(ctx, curUserID); err != nil {
+			return entity.Dialog{}, fmt.Errorf("get saved messages dialog: %w", err)
+		}
+		return dialog, nil
+	}
+
+	event := entity.ParticipantEvent{
+		Type:   entity.AddedParticipant,
+		ChatID: entity.ChatID{ID: dialog.ID, Type: entity.DialogChatType},
+		UserID: curUserID,
+	}
+	if err = g.prod.Produce(ctx, event); err != nil {
+		return entity.Dialog{}, fmt.Errorf("produce dialog participant event: %w", err)
+	}
+
+	return dialog, nil
+}
+
 func (g *Dialog) GetByID(ctx context.Context, id int) (entity.Dialog, error) {
 	dialog, err := g.repo.GetByID(ctx, id)
 	if err != nil {
@@ -99,21 +226,50 @@ func (g *Dialog) Update(ctx context.Context, obj dto.DialogUpdate) error {
 		},
 	}
 
-	if err := g.repo.Update(ctx, &dialog); err != nil {
-		return fmt.Errorf("update dialog: %w", err)
+	// The partner in the blocklist of the current user can't be unblocked only in the dialog,
+	// otherwise the dialog becomes writable while the blocklist says the partner is blocked.
+	// The check follows the update, so a concurrent block waits for the locked row.
+	err := g.txm.Do(ctx, func(ctx context.Context) error {
+		if err := g.repo.Update(ctx, &dialog); err != nil {
+			return fmt.Errorf("update dialog: %w", err)
+		}
+
+		if dialog.Partner.IsBlocked {
+			return nil
+		}
+
+		curUserID := ctxutil.UserIDFromContext(ctx).ToInt()
+		blocked, err := g.blockChecker.Exists(ctx, curUserID, dialog.Partner.UserID)
+		if err != nil {
+			return fmt.Errorf("check whether the partner is in the blocklist or not: %w", err)
+		}
+		if blocked {
+			return fmt.Errorf("%w: the partner is in the blocklist, unblock the user instead", entity.ErrForbiddenPerformAction)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("call transaction manager: %w", err)
 	}
 
-	eventType := entity.RemovedParticipant
-	if !*obj.PartnerIsBlocked {
-		eventType = entity.AddedParticipant
+	return g.NotifyPartnerBlocked(ctx, dialog)
+}
+
+// NotifyPartnerBlocked notifies participants of the dialog that the partner is blocked
+// or unblocked. It must be called after the change is committed, otherwise caches
+// invalidated by the event may be filled with the previous state again.
+func (g *Dialog) NotifyPartnerBlocked(ctx context.Context, dialog entity.Dialog) error {
+	chatID := entity.ChatID{ID: dialog.ID, Type: entity.DialogChatType}
+	eventType, actionType := entity.RemovedParticipant, entity.DialogBlockedServiceAction
+	if !dialog.Partner.IsBlocked {
+		eventType, actionType = entity.AddedParticipant, entity.DialogUnblockedServiceAction
 	}
 
+	createServiceMessage(ctx, g.msgCreator, chatID, userServiceAction(actionType, dialog.Partner.UserID))
+
 	event := entity.ParticipantEvent{
-		Type: eventType,
-		ChatID: entity.ChatID{
-			ID:   dialog.ID,
-			Type: entity.DialogChatType,
-		},
+		Type:   eventType,
+		ChatID: chatID,
 		UserID: dialog.Partner.UserID,
 	}
 	if err := g.prod.Produce(ctx, event); err != nil {
@@ -122,3 +278,176 @@ func (g *Dialog) Update(ctx context.Context, obj dto.DialogUpdate) error {
 
 	return nil
 }
+
+// ClearHistory clears messages of the dialog on the side of the current user only.
+func (g *Dialog) ClearHistory(ctx context.Context, dialogID int) error {
+	if err := g.repo.ClearHistory(ctx, dialogID, false); err != nil {
+		return fmt.Errorf("clear dialog history: %w", err)
+	}
+
+	return nil
+}
+
+// Delete deletes the dialog on the side of the current user, so it's hidden until
+// a new message arrives, or deletes it for both sides together with the messages.
+func (g *Dialog) Delete(ctx context.Context, obj dto.DialogDelete) error {
+	if !obj.ForBoth {
+		if err := g.repo.ClearHistory(ctx, obj.ID, true); err != nil {
+			return fmt.Errorf("hide dialog: %w", err)
+		}
+		return nil
+	}
+
+	curUserID := ctxutil.UserIDFromContext(ctx).ToInt()
+
+	dialog, err := g.repo.GetByID(ctx, obj.ID)
+	if err != nil {
+		return fmt.Errorf("get dialog by id: %w", err)
+	}
+
+	if err = g.repo.Delete(ctx, obj.ID); err != nil {
+		return fmt.Errorf("delete dialog: %w", err)
+	}
+
+	userIDs := []int{curUserID}
+	if dialog.Partner.UserID != curUserID {
+		userIDs = append(userIDs, dialog.Partner.UserID)
+	}
+
+	chatID := entity.ChatID{ID: obj.ID, Type: entity.DialogChatType}
+	for _, userID := range userIDs {
+		event := entity.ParticipantEvent{
+			Type:   entity.RemovedParticipant,
+			ChatID: chatID,
+			UserID: userID,
+		}
+		if err = g.prod.Produce(ctx, event); err != nil {
+			return fmt.Errorf("produce dialog participant event: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// AcceptRequest accepts the message request sent to the current user,
+// so the dialog is listed along with the others. Read receipts aren't implemented
+// yet, once they are, the sender mustn't get them until the request is accepted.
+func (g *Dialog) AcceptRequest(ctx context.Context, dialogID int) error {
+	if err := g.repo.ResolveRequest(ctx, dialogID, entity.AcceptedDialogRequestStatus); err != nil {
+		return fmt.Errorf("accept dialog request: %w", err)
+	}
+
+	return nil
+}
+
+// DeclineRequest declines the message request sent to the current user, so the dialog
+// is no longer shown and served to the user, and its sender can't write to it anymore.
+func (g *Dialog) DeclineRequest(ctx context.Context, dialogID int) error {
+	curUserID := ctxutil.UserIDFromContext(ctx).ToInt()
+
+	dialog, err := g.repo.GetByID(ctx, dialogID)
+	if err != nil {
+		return fmt.Errorf("get dialog by id: %w", err)
+	}
+
+	if err = g.repo.ResolveRequest(ctx, dialogID, entity.DeclinedDialogRequestStatus); err != nil {
+		return fmt.Errorf("decline dialog request: %w", err)
+	}
+
+	chatID := entity.ChatID{ID: dialogID, Type: entity.DialogChatType}
+	events := []entity.ParticipantEvent{
+		{
+			Type:   entity.RemovedParticipant,
+			ChatID: chatID,
+			UserID: curUserID,
+		},
+		{
+			Type:   entity.RestrictedParticipant,
+			ChatID: chatID,
+			UserID: dialog.Partner.UserID,
+		},
+	}
+	for _, event := range events {
+		if err = g.prod.Produce(ctx, event); err != nil {
+			return fmt.Errorf("produce dialog participant event: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ReportRequest declines the message request sent to the current user as spam
+// and blocks its sender.
+func (g *Dialog) ReportRequest(ctx context.Context, dialogID int) error {
+	curUserID := ctxutil.UserIDFromContext(ctx).ToInt()
+
+	dialog, err := g.repo.GetByID(ctx, dialogID)
+	if err != nil {
+		return fmt.Errorf("get dialog by id: %w", err)
+	}
+
+	senderID := dialog.Partner.UserID
+	wasBlocked := dialog.Partner.IsBlocked
+
+	err = g.txm.Do(ctx, func(ctx context.Context) error {
+		if err := g.repo.ResolveRequest(ctx, dialogID, entity.ReportedDialogRequestStatus); err != nil {
+			return fmt.Errorf("report dialog request: %w", err)
+		}
+
+		blocked, err := g.blockChecker.Exists(ctx, curUserID, senderID)
+		if err != nil {
+			return fmt.Errorf("check user block: %w", err)
+		}
+		if !blocked {
+			block := entity.UserBlock{
+				UserID:        curUserID,
+				BlockedUserID: senderID,
+				CreatedAt:     time.Now(),
+			}
+			if err = g.userBlocker.Create(ctx, &block); err != nil {
+				return fmt.Errorf("create user block: %w", err)
+			}
+		}
+
+		if wasBlocked {
+			return nil
+		}
+
+		dialog.Partner.IsBlocked = true
+		if err = g.repo.Update(ctx, &dialog); err != nil {
+			return fmt.Errorf("update dialog: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("call transaction manager: %w", err)
+	}
+
+	if wasBlocked {
+		return nil
+	}
+	return g.NotifyPartnerBlocked(ctx, dialog)
+}
+
+// SetPartnerBlocked blocks or unblocks the partner in the dialog with the current user
+// without notifying participants, so it can be called inside a transaction. It reports
+// false if there is no such a dialog or the partner is already in the state.
+func (g *Dialog) SetPartnerBlocked(ctx context.Context, partnerUserID int, isBlocked bool) (entity.Dialog, bool, error) {
+	dialog, err := g.repo.GetByPartnerID(ctx, partnerUserID)
+	if err != nil {
+		if errors.Is(err, entity.ErrDialogNotFound) {
+			return entity.Dialog{}, false, nil
+		}
+		return entity.Dialog{}, false, fmt.Errorf("get dialog by partner id: %w", err)
+	}
+
+	if dialog.Partner.IsBlocked == isBlocked {
+		return dialog, false, nil
+	}
+
+	dialog.Partner.IsBlocked = isBlocked
+	if err = g.repo.Update(ctx, &dialog); err != nil {
+		return entity.Dialog{}, false, fmt.Errorf("update dialog: %w", err)
+	}
+	return dialog, true, nil
+}
@@ -0,0 +1,499 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Chatyx/backend/internal/dto"
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/ctxutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDialog_Create_Blocked(t *testing.T) {
+	testCases := []struct {
+		name          string
+		blockedByUser bool
+		blockedByPeer bool
+	}{
+		{
+			name:          "Current user has blocked the partner",
+			blockedByUser: true,
+		},
+		{
+			name:          "Partner has blocked the current user",
+			blockedByPeer: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			blockChecker := NewMockUserBlockChecker(t)
+			blockChecker.On("Exists", mock.Anything, 1, 2).Return(testCase.blockedByUser, nil)
+			blockChecker.On("Exists", mock.Anything, 2, 1).Return(testCase.blockedByPeer, nil).Maybe()
+
+			service := NewDialog(DialogConfig{
+				Repository:   NewMockDialogRepository(t),
+				BlockChecker: blockChecker,
+			})
+			ctx := ctxutil.WithUserID(context.Background(), "1")
+
+			_, err := service.Create(ctx, dto.DialogCreate{PartnerUserID: 2})
+			assert.ErrorIs(t, err, entity.ErrUserBlocked)
+		})
+	}
+}
+
+func TestDialog_SetPartnerBlocked(t *testing.T) {
+	testCases := []struct {
+		name            string
+		mockBehavior    func(repo *MockDialogRepository)
+		expectedChanged bool
+	}{
+		{
+			name: "Successful",
+			mockBehavior: func(repo *MockDialogRepository) {
+				repo.On("GetByPartnerID", mock.Anything, 2).Return(entity.Dialog{
+					ID:      1,
+					Partner: entity.DialogPartner{UserID: 2},
+				}, nil)
+				repo.On("Update", mock.Anything, &entity.Dialog{
+					ID:      1,
+					Partner: entity.DialogPartner{UserID: 2, IsBlocked: true},
+				}).Return(nil)
+			},
+			expectedChanged: true,
+		},
+		{
+			name: "Partner is already blocked",
+			mockBehavior: func(repo *MockDialogRepository) {
+				repo.On("GetByPartnerID", mock.Anything, 2).Return(entity.Dialog{
+					ID:      1,
+					Partner: entity.DialogPartner{UserID: 2, IsBlocked: true},
+				}, nil)
+			},
+		},
+		{
+			name: "There is no dialog",
+			mockBehavior: func(repo *MockDialogRepository) {
+				repo.On("GetByPartnerID", mock.Anything, 2).Return(entity.Dialog{}, entity.ErrDialogNotFound)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			repo := NewMockDialogRepository(t)
+			testCase.mockBehavior(repo)
+
+			// Participants aren't notified until the change is committed.
+			service := NewDialog(DialogConfig{
+				Repository:     repo,
+				EventProducer:  NewMockDialogParticipantEventProducer(t),
+				MessageCreator: NewMockServiceMessageCreator(t),
+			})
+			ctx := ctxutil.WithUserID(context.Background(), "1")
+
+			_, changed, err := service.SetPartnerBlocked(ctx, 2, true)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedChanged, changed)
+		})
+	}
+}
+
+func TestDialog_Update(t *testing.T) {
+	chatID := entity.ChatID{ID: 1, Type: entity.DialogChatType}
+
+	testCases := []struct {
+		name          string
+		isBlocked     bool
+		inBlocklist   bool
+		expectedError error
+	}{
+		{
+			name:      "Successful unblock",
+			isBlocked: false,
+		},
+		{
+			name:          "Unblock of the partner in the blocklist",
+			isBlocked:     false,
+			inBlocklist:   true,
+			expectedError: entity.ErrForbiddenPerformAction,
+		},
+		{
+			name:      "Successful block",
+			isBlocked: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			txm := NewMockTransactionManager(t)
+			txm.On("Do", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+				return fn(ctx)
+			})
+
+			repo := NewMockDialogRepository(t)
+			repo.On("Update", mock.Anything, mock.Anything).Return(func(_ context.Context, dialog *entity.Dialog) error {
+				dialog.Partner.UserID = 2
+				return nil
+			})
+
+			blockChecker := NewMockUserBlockChecker(t)
+			if !testCase.isBlocked {
+				blockChecker.On("Exists", mock.Anything, 1, 2).Return(testCase.inBlocklist, nil)
+			}
+
+			msgCreator := NewMockServiceMessageCreator(t)
+			prod := NewMockDialogParticipantEventProducer(t)
+			if testCase.expectedError == nil {
+				msgCreator.On("CreateService", mock.Anything, chatID, mock.Anything).Return(entity.Message{}, nil)
+				prod.On("Produce", mock.Anything, mock.Anything).Return(nil)
+			}
+
+			service := NewDialog(DialogConfig{
+				TxManager:      txm,
+				Repository:     repo,
+				EventProducer:  prod,
+				MessageCreator: msgCreator,
+				BlockChecker:   blockChecker,
+			})
+			ctx := ctxutil.WithUserID(context.Background(), "1")
+
+			err := service.Update(ctx, dto.DialogUpdate{ID: 1, PartnerIsBlocked: &testCase.isBlocked})
+			if testCase.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestDialog_NotifyPartnerBlocked(t *testing.T) {
+	chatID := entity.ChatID{ID: 1, Type: entity.DialogChatType}
+
+	msgCreator := NewMockServiceMessageCreator(t)
+	msgCreator.On("CreateService", mock.Anything, chatID, userServiceAction(entity.DialogBlockedServiceAction, 2)).
+		Return(entity.Message{}, nil)
+
+	prod := NewMockDialogParticipantEventProducer(t)
+	prod.On("Produce", mock.Anything, entity.ParticipantEvent{
+		Type:   entity.RemovedParticipant,
+		ChatID: chatID,
+		UserID: 2,
+	}).Return(nil)
+
+	service := NewDialog(DialogConfig{
+		EventProducer:  prod,
+		MessageCreator: msgCreator,
+	})
+	ctx := ctxutil.WithUserID(context.Background(), "1")
+
+	err := service.NotifyPartnerBlocked(ctx, entity.Dialog{
+		ID:      1,
+		Partner: entity.DialogPartner{UserID: 2, IsBlocked: true},
+	})
+	require.NoError(t, err)
+}
+
+func TestDialog_Create_Request(t *testing.T) {
+	chatID := entity.ChatID{ID: 1, Type: entity.DialogChatType}
+
+	testCases := []struct {
+		name         string
+		isContact    bool
+		mockBehavior func(repo *MockDialogRepository)
+	}{
+		{
+			name:      "Current user is a contact of the partner",
+			isContact: true,
+		},
+		{
+			name: "Current user isn't a contact of the partner",
+			mockBehavior: func(repo *MockDialogRepository) {
+				repo.On("CreateRequest", mock.Anything, mock.MatchedBy(func(request *entity.DialogRequest) bool {
+					return request.DialogID == 1 &&
+						request.SenderID == 1 &&
+						request.RecipientID == 2 &&
+						request.Status == entity.PendingDialogRequestStatus
+				})).Return(nil)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			txm := NewMockTransactionManager(t)
+			txm.On("Do", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+				return fn(ctx)
+			})
+
+			blockChecker := NewMockUserBlockChecker(t)
+			blockChecker.On("Exists", mock.Anything, mock.Anything, mock.Anything).Return(false, nil)
+
+			contactChecker := NewMockContactChecker(t)
+			contactChecker.On("Exists", mock.Anything, 2, 1).Return(testCase.isContact, nil)
+
+			repo := NewMockDialogRepository(t)
+			repo.On("Create", mock.Anything, mock.Anything).Return(func(_ context.Context, dialog *entity.Dialog) error {
+				dialog.ID = 1
+				return nil
+			})
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(repo)
+			}
+
+			prod := NewMockDialogParticipantEventProducer(t)
+			for _, userID := range []int{1, 2} {
+				prod.On("Produce", mock.Anything, entity.ParticipantEvent{
+					Type:   entity.AddedParticipant,
+					ChatID: chatID,
+					UserID: userID,
+				}).Return(nil)
+			}
+
+			service := NewDialog(DialogConfig{
+				TxManager:      txm,
+				Repository:     repo,
+				EventProducer:  prod,
+				BlockChecker:   blockChecker,
+				ContactChecker: contactChecker,
+			})
+			ctx := ctxutil.WithUserID(context.Background(), "1")
+
+			dialog, err := service.Create(ctx, dto.DialogCreate{PartnerUserID: 2})
+			require.NoError(t, err)
+			assert.Equal(t, 1, dialog.ID)
+		})
+	}
+}
+
+func TestDialog_ReportRequest(t *testing.T) {
+	chatID := entity.ChatID{ID: 1, Type: entity.DialogChatType}
+
+	txm := NewMockTransactionManager(t)
+	txm.On("Do", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	})
+
+	repo := NewMockDialogRepository(t)
+	repo.On("GetByID", mock.Anything, 1).Return(entity.Dialog{
+		ID:        1,
+		IsRequest: true,
+		Partner:   entity.DialogPartner{UserID: 2},
+	}, nil)
+	repo.On("ResolveRequest", mock.Anything, 1, entity.ReportedDialogRequestStatus).Return(nil)
+	repo.On("Update", mock.Anything, mock.Anything).Return(func(_ context.Context, dialog *entity.Dialog) error {
+		dialog.Partner.UserID = 2
+		return nil
+	})
+
+	blockChecker := NewMockUserBlockChecker(t)
+	blockChecker.On("Exists", mock.Anything, 1, 2).Return(false, nil)
+
+	userBlocker := NewMockDialogUserBlocker(t)
+	userBlocker.On("Create", mock.Anything, mock.MatchedBy(func(block *entity.UserBlock) bool {
+		return block.UserID == 1 && block.BlockedUserID == 2
+	})).Return(nil)
+
+	msgCreator := NewMockServiceMessageCreator(t)
+	msgCreator.On("CreateService", mock.Anything, chatID, userServiceAction(entity.DialogBlockedServiceAction, 2)).
+		Return(entity.Message{}, nil)
+
+	prod := NewMockDialogParticipantEventProducer(t)
+	prod.On("Produce", mock.Anything, entity.ParticipantEvent{
+		Type:   entity.RemovedParticipant,
+		ChatID: chatID,
+		UserID: 2,
+	}).Return(nil)
+
+	service := NewDialog(DialogConfig{
+		TxManager:      txm,
+		Repository:     repo,
+		EventProducer:  prod,
+		MessageCreator: msgCreator,
+		BlockChecker:   blockChecker,
+		UserBlocker:    userBlocker,
+	})
+	ctx := ctxutil.WithUserID(context.Background(), "1")
+
+	require.NoError(t, service.ReportRequest(ctx, 1))
+}
+
+func TestDialog_DeclineRequest(t *testing.T) {
+	chatID := entity.ChatID{ID: 1, Type: entity.DialogChatType}
+
+	repo := NewMockDialogRepository(t)
+	repo.On("GetByID", mock.Anything, 1).Return(entity.Dialog{
+		ID:        1,
+		IsRequest: true,
+		Partner:   entity.DialogPartner{UserID: 2},
+	}, nil)
+	repo.On("ResolveRequest", mock.Anything, 1, entity.DeclinedDialogRequestStatus).Return(nil)
+
+	// The recipient is unsubscribed from the dialog, and the sender loses access to write.
+	prod := NewMockDialogParticipantEventProducer(t)
+	prod.On("Produce", mock.Anything, entity.ParticipantEvent{
+		Type:   entity.RemovedParticipant,
+		ChatID: chatID,
+		UserID: 1,
+	}).Return(nil)
+	prod.On("Produce", mock.Anything, entity.ParticipantEvent{
+		Type:   entity.RestrictedParticipant,
+		ChatID: chatID,
+		UserID: 2,
+	}).Return(nil)
+
+	service := NewDialog(DialogConfig{
+		Repository:    repo,
+		EventProducer: prod,
+	})
+	ctx := ctxutil.WithUserID(context.Background(), "1")
+
+	require.NoError(t, service.DeclineRequest(ctx, 1))
+}
+
+func TestDialog_Create_Yourself(t *testing.T) {
+	service := NewDialog(DialogConfig{
+		Repository: NewMockDialogRepository(t),
+	})
+	ctx := ctxutil.WithUserID(context.Background(), "1")
+
+	_, err := service.Create(ctx, dto.DialogCreate{PartnerUserID: 1})
+	assert.ErrorIs(t, err, entity.ErrCreateDialogWithYourself)
+}
+
+func TestDialog_GetSaved(t *testing.T) {
+	savedDialog := entity.Dialog{
+		ID:      1,
+		Partner: entity.DialogPartner{UserID: 1},
+	}
+
+	testCases := []struct {
+		name         string
+		mockBehavior func(repo *MockDialogRepository, prod *MockDialogParticipantEventProducer)
+	}{
+		{
+			name: "Dialog already exists",
+			mockBehavior: func(repo *MockDialogRepository, _ *MockDialogParticipantEventProducer) {
+				repo.On("GetByPartnerID", mock.Anything, 1).Return(savedDialog, nil)
+			},
+		},
+		{
+			name: "Dialog is created on the first access",
+			mockBehavior: func(repo *MockDialogRepository, prod *MockDialogParticipantEventProducer) {
+				repo.On("GetByPartnerID", mock.Anything, 1).Return(entity.Dialog{}, entity.ErrDialogNotFound)
+				repo.On("Create", mock.Anything, mock.MatchedBy(func(dialog *entity.Dialog) bool {
+					return dialog.Partner.UserID == 1
+				})).Return(func(_ context.Context, dialog *entity.Dialog) error {
+					dialog.ID = 1
+					return nil
+				})
+				prod.On("Produce", mock.Anything, entity.ParticipantEvent{
+					Type:   entity.AddedParticipant,
+					ChatID: entity.ChatID{ID: 1, Type: entity.DialogChatType},
+					UserID: 1,
+				}).Return(nil)
+			},
+		},
+		{
+			name: "Dialog is created concurrently",
+			mockBehavior: func(repo *MockDialogRepository, _ *MockDialogParticipantEventProducer) {
+				repo.On("GetByPartnerID", mock.Anything, 1).Return(entity.Dialog{}, entity.ErrDialogNotFound).Once()
+				repo.On("Create", mock.Anything, mock.Anything).Return(entity.ErrSuchDialogAlreadyExists)
+				repo.On("GetByPartnerID", mock.Anything, 1).Return(savedDialog, nil).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			repo := NewMockDialogRepository(t)
+			prod := NewMockDialogParticipantEventProducer(t)
+			testCase.mockBehavior(repo, prod)
+
+			service := NewDialog(DialogConfig{
+				Repository:    repo,
+				EventProducer: prod,
+			})
+			ctx := ctxutil.WithUserID(context.Background(), "1")
+
+			dialog, err := service.GetSaved(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, savedDialog.ID, dialog.ID)
+			assert.Equal(t, savedDialog.Partner, dialog.Partner)
+		})
+	}
+}
+
+func TestDialog_Delete(t *testing.T) {
+	chatID := entity.ChatID{ID: 1, Type: entity.DialogChatType}
+
+	testCases := []struct {
+		name         string
+		obj          dto.DialogDelete
+		mockBehavior func(repo *MockDialogRepository, prod *MockDialogParticipantEventProducer)
+	}{
+		{
+			name: "Delete on the side of the current user",
+			obj:  dto.DialogDelete{ID: 1},
+			mockBehavior: func(repo *MockDialogRepository, _ *MockDialogParticipantEventProducer) {
+				repo.On("ClearHistory", mock.Anything, 1, true).Return(nil)
+			},
+		},
+		{
+			name: "Delete for both sides",
+			obj:  dto.DialogDelete{ID: 1, ForBoth: true},
+			mockBehavior: func(repo *MockDialogRepository, prod *MockDialogParticipantEventProducer) {
+				repo.On("GetByID", mock.Anything, 1).Return(entity.Dialog{
+					ID:      1,
+					Partner: entity.DialogPartner{UserID: 2},
+				}, nil)
+				repo.On("Delete", mock.Anything, 1).Return(nil)
+				for _, userID := range []int{1, 2} {
+					prod.On("Produce", mock.Anything, entity.ParticipantEvent{
+						Type:   entity.RemovedParticipant,
+						ChatID: chatID,
+						UserID: userID,
+					}).Return(nil).Once()
+				}
+			},
+		},
+		{
+			name: "Delete saved messages for both sides",
+			obj:  dto.DialogDelete{ID: 1, ForBoth: true},
+			mockBehavior: func(repo *MockDialogRepository, prod *MockDialogParticipantEventProducer) {
+				repo.On("GetByID", mock.Anything, 1).Return(entity.Dialog{
+					ID:      1,
+					Partner: entity.DialogPartner{UserID: 1},
+				}, nil)
+				repo.On("Delete", mock.Anything, 1).Return(nil)
+				prod.On("Produce", mock.Anything, entity.ParticipantEvent{
+					Type:   entity.RemovedParticipant,
+					ChatID: chatID,
+					UserID: 1,
+				}).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			repo := NewMockDialogRepository(t)
+			prod := NewMockDialogParticipantEventProducer(t)
+			testCase.mockBehavior(repo, prod)
+
+			service := NewDialog(DialogConfig{
+				Repository:    repo,
+				EventProducer: prod,
+			})
+			ctx := ctxutil.WithUserID(context.Background(), "1")
+
+			require.NoError(t, service.Delete(ctx, testCase.obj))
+		})
+	}
+}
@@ -0,0 +1,574 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/ctxutil"
+	"github.com/Chatyx/backend/pkg/log"
+)
+
+//go:generate mockery --inpackage --testonly --case underscore --name ChatParticipantLister
+type ChatParticipantLister interface {
+	ListActiveUserIDs(ctx context.Context, chatID entity.ChatID) ([]int, error)
+}
+
+type MessageInboxPublisher interface {
+	PublishToInboxes(ctx context.Context, message entity.Message, userIDs ...int) error
+}
+
+type MessageInboxConsumer interface {
+	BeginConsume(ctx context.Context) (<-chan entity.Message, <-chan error)
+	Close() error
+}
+
+type MessageInboxSubscriber interface {
+	SubscribeInbox(ctx context.Context, userID int) MessageInboxConsumer
+}
+
+type InboxFanOutConfig struct {
+	ParticipantLister ChatParticipantLister
+	Publisher         MessageInboxPublisher
+	Subscriber        MessageInboxSubscriber
+}
+
+// InboxFanOut is an alternative to per-chat channels. A message is delivered to
+// inboxes of all active chat participants, so every session holds a single
+// subscription no matter how many chats the user is in.
+type InboxFanOut struct {
+	lister     ChatParticipantLister
+	publisher  MessageInboxPublisher
+	subscriber MessageInboxSubscriber
+}
+
+func NewInboxFanOut(conf InboxFanOutConfig) *InboxFanOut {
+	return &InboxFanOut{
+		lister:     conf.ParticipantLister,
+		publisher:  conf.Publisher,
+		subscriber: conf.Subscriber,
+	}
+}
+
+func (f *InboxFanOut) Publish(ctx context.Context, message entity.Message) error {
+	userIDs, err := f.lister.ListActiveUserIDs(ctx, message.ChatID)
+	if err != nil {
+		return fmt.Errorf("list active chat participants: %w", err)
+	}
+
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	if err = f.publisher.PublishToInboxes(ctx, message, userIDs...); err != nil {
+		return fmt.Errorf("publish message to inboxes: %w", err)
+	}
+	return nil
+}
+
+// Subscribe subscribes to the inbox of the current user. Chat ids are only used to filter
+// incoming messages, so subscribing to or unsubscribing from chats doesn't touch the sysbus.
+func (f *InboxFanOut) Subscribe(ctx context.Context, chatIDs ...entity.ChatID) MessageConsumer { //nolint:ireturn // that's a factory
+	userID := ctxutil.UserIDFromContext(ctx).ToInt()
+
+	cons := &inboxMessageConsumer{
+		inbox:   f.subscriber.SubscribeInbox(ctx, userID),
+		chatIDs: make(map[entity.ChatID]struct{}, len(chatIDs)),
+	}
+	for _, chatID := range chatIDs {
+		cons.chatIDs[chatID] = struct{}{}
+	}
+
+	return cons
+}
+
+type inboxMessageConsumer struct {
+	inbox   MessageInboxConsumer
+	mu      sync.RWMutex
+	chatIDs map[entity.ChatID]struct{}
+}
+
+func (c *inboxMessageConsumer) BeginConsume(ctx context.Context) (<-chan entity.Message, <-chan error) {
+	inboxCh, inboxErrCh := c.inbox.BeginConsume(ctx)
+	outCh, errCh := make(chan entity.Message), make(chan error)
+
+	go func() {
+		defer close(outCh)
+		defer close(errCh)
+
+		for {
+			select {
+			case message, ok := <-inboxCh:
+				if !ok {
+					return
+				}
+
+				if c.isSubscribed(message.ChatID) {
+					outCh <- message
+				}
+			case err, ok := <-inboxErrCh:
+				if !ok {
+					return
+				}
+
+				errCh <- err
+			}
+		}
+	}()
+
+	return outCh, errCh
+}
+
+func (c *inboxMessageConsumer) Subscribe(_ context.Context, chatIDs ...entity.ChatID) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, chatID := range chatIDs {
+		c.chatIDs[chatID] = struct{}{}
+	}
+	return nil
+}
+
+func (c *inboxMessageConsumer) Unsubscribe(_ context.Context, chatIDs ...entity.ChatID) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, chatID := range chatIDs {
+		delete(c.chatIDs, chatID)
+	}
+	return nil
+}
+
+func (c *inboxMessageConsumer) Close() error {
+	if err := c.inbox.Close(); err != nil {
+		return fmt.Errorf("close inbox consumer: %w", err)
+	}
+	return nil
+}
+
+func (c *inboxMessageConsumer) isSubscribed(chatID entity.ChatID) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	_, ok := c.chatIDs[chatID]
+	return ok
+}
+
+const defaultChannelConsumerBufferSize = 64
+
+type ChannelFanOutConfig struct {
+	// Publisher and Subscriber deliver messages of dialogs and groups.
+	Publisher  MessagePublisher
+	Subscriber MessageSubscriber
+	// BroadcastPublisher and BroadcastSubscriber deliver messages of channels per chat.
+	BroadcastPublisher  MessagePublisher
+	BroadcastSubscriber MessageSubscriber
+	// ConsumerBufferSize is a number of channel messages buffered for every session.
+	ConsumerBufferSize int
+}
+
+// ChannelFanOut delivers messages of channels through a hub shared by all sessions of the node,
+// so every channel costs a single sysbus subscription per node instead of one per subscriber,
+// and publishing doesn't depend on the number of subscribers. Messages of dialogs and groups
+// are passed to the underlying strategy as is.
+type ChannelFanOut struct {
+	publisher      MessagePublisher
+	subscriber     MessageSubscriber
+	bcastPublisher MessagePublisher
+	bcastSub       MessageSubscriber
+	bufferSize     int
+
+	mu        sync.RWMutex
+	upstream  MessageConsumer
+	refs      map[entity.ChatID]int
+	consumers map[*channelMessageConsumer]struct{}
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewChannelFanOut(conf ChannelFanOutConfig) *ChannelFanOut {
+	if conf.ConsumerBufferSize == 0 {
+		conf.ConsumerBufferSize = defaultChannelConsumerBufferSize
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &ChannelFanOut{
+		publisher:      conf.Publisher,
+		subscriber:     conf.Subscriber,
+		bcastPublisher: conf.BroadcastPublisher,
+		bcastSub:       conf.BroadcastSubscriber,
+		bufferSize:     conf.ConsumerBufferSize,
+		refs:           make(map[entity.ChatID]int),
+		consumers:      make(map[*channelMessageConsumer]struct{}),
+		ctx:            ctx,
+		cancel:         cancel,
+	}
+}
+
+func (f *ChannelFanOut) Publish(ctx context.Context, message entity.Message) error {
+	if message.ChatID.Type == entity.ChannelChatType {
+		return f.bcastPublisher.Publish(ctx, message)
+	}
+	return f.publisher.Publish(ctx, message)
+}
+
+func (f *ChannelFanOut) Subscribe(ctx context.Context, chatIDs ...entity.ChatID) MessageConsumer { //nolint:ireturn // that's a factory
+	channelIDs, otherIDs := splitChannelIDs(chatIDs)
+
+	cons := &channelMessageConsumer{
+		hub:        f,
+		msgCh:      make(chan entity.Message, f.bufferSize),
+		overflowCh: make(chan struct{}),
+		chatIDs:    make(map[entity.ChatID]struct{}, len(channelIDs)),
+	}
+
+	f.mu.Lock()
+	f.consumers[cons] = struct{}{}
+	f.mu.Unlock()
+
+	err := cons.Subscribe(ctx, channelIDs...)
+
+	return &splitMessageConsumer{
+		next:    f.subscriber.Subscribe(ctx, otherIDs...),
+		channel: cons,
+		initErr: err,
+	}
+}
+
+func (f *ChannelFanOut) Close() error {
+	f.cancel()
+
+	f.mu.Lock()
+	upstream := f.upstream
+	f.mu.Unlock()
+
+	var err error
+	if upstream != nil {
+		if err = upstream.Close(); err != nil {
+			err = fmt.Errorf("close channel hub consumer: %w", err)
+		}
+	}
+
+	f.wg.Wait()
+	return err
+}
+
+// acquire subscribes the hub to channels which nobody on the node has been subscribed to yet.
+func (f *ChannelFanOut) acquire(ctx context.Context, chatIDs ...entity.ChatID) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var newIDs []entity.ChatID
+	for _, chatID := range chatIDs {
+		if f.refs[chatID] == 0 {
+			newIDs = append(newIDs, chatID)
+		}
+		f.refs[chatID]++
+	}
+
+	if len(newIDs) == 0 {
+		return nil
+	}
+
+	if f.upstream == nil {
+		f.upstream = f.bcastSub.Subscribe(f.ctx, newIDs...)
+
+		f.wg.Add(1)
+		go f.dispatch(f.upstream)
+		return nil
+	}
+
+	if err := f.upstream.Subscribe(ctx, newIDs...); err != nil {
+		for _, chatID := range chatIDs {
+			f.refs[chatID]--
+			if f.refs[chatID] == 0 {
+				delete(f.refs, chatID)
+			}
+		}
+
+		return fmt.Errorf("subscribe channel hub to channels: %w", err)
+	}
+	return nil
+}
+
+// release unsubscribes the hub from channels which nobody on the node is subscribed to anymore.
+func (f *ChannelFanOut) release(ctx context.Context, chatIDs ...entity.ChatID) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var oldIDs []entity.ChatID
+	for _, chatID := range chatIDs {
+		if f.refs[chatID] == 0 {
+			continue
+		}
+
+		f.refs[chatID]--
+		if f.refs[chatID] == 0 {
+			delete(f.refs, chatID)
+			oldIDs = append(oldIDs, chatID)
+		}
+	}
+
+	if len(oldIDs) == 0 || f.upstream == nil {
+		return nil
+	}
+
+	if err := f.upstream.Unsubscribe(ctx, oldIDs...); err != nil {
+		return fmt.Errorf("unsubscribe channel hub from channels: %w", err)
+	}
+	return nil
+}
+
+func (f *ChannelFanOut) dispatch(upstream MessageConsumer) {
+	defer f.wg.Done()
+
+	msgCh, errCh := upstream.BeginConsume(f.ctx)
+	for {
+		select {
+		case message, ok := <-msgCh:
+			if !ok {
+				return
+			}
+
+			f.mu.RLock()
+			for cons := range f.consumers {
+				cons.deliver(message)
+			}
+			f.mu.RUnlock()
+		case err, ok := <-errCh:
+			if !ok {
+				return
+			}
+
+			log.WithError(err).Error("Failed to consume channel message")
+		}
+	}
+}
+
+func (f *ChannelFanOut) unregister(cons *channelMessageConsumer) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.consumers, cons)
+	close(cons.msgCh)
+}
+
+func splitChannelIDs(chatIDs []entity.ChatID) (channelIDs, otherIDs []entity.ChatID) {
+	for _, chatID := range chatIDs {
+		if chatID.Type == entity.ChannelChatType {
+			channelIDs = append(channelIDs, chatID)
+		} else {
+			otherIDs = append(otherIDs, chatID)
+		}
+	}
+	return channelIDs, otherIDs
+}
+
+// channelMessageConsumer receives messages of channels from the hub.
+type channelMessageConsumer struct {
+	hub          *ChannelFanOut
+	msgCh        chan entity.Message
+	overflowCh   chan struct{}
+	overflowOnce sync.Once
+
+	mu      sync.RWMutex
+	chatIDs map[entity.ChatID]struct{}
+}
+
+// deliver must be called under the read lock of the hub, so the consumer isn't closed concurrently.
+// A session which doesn't keep up with the channels is overflowed instead of stalling the delivery
+// for the whole node, it's finished then, so the client reconnects and fetches missed messages.
+func (c *channelMessageConsumer) deliver(message entity.Message) {
+	c.mu.RLock()
+	_, ok := c.chatIDs[message.ChatID]
+	c.mu.RUnlock()
+
+	if !ok {
+		return
+	}
+
+	select {
+	case c.msgCh <- message:
+	default:
+		c.overflowOnce.Do(func() {
+			log.Warnf("Channel message %d is dropped for the slow session, the session is overflowed", message.ID)
+			close(c.overflowCh)
+		})
+	}
+}
+
+func (c *channelMessageConsumer) Subscribe(ctx context.Context, chatIDs ...entity.ChatID) error {
+	c.mu.Lock()
+	var newIDs []entity.ChatID
+	for _, chatID := range chatIDs {
+		if _, ok := c.chatIDs[chatID]; !ok {
+			c.chatIDs[chatID] = struct{}{}
+			newIDs = append(newIDs, chatID)
+		}
+	}
+	c.mu.Unlock()
+
+	if len(newIDs) == 0 {
+		return nil
+	}
+
+	if err := c.hub.acquire(ctx, newIDs...); err != nil {
+		c.mu.Lock()
+		for _, chatID := range newIDs {
+			delete(c.chatIDs, chatID)
+		}
+		c.mu.Unlock()
+
+		return err
+	}
+	return nil
+}
+
+func (c *channelMessageConsumer) Unsubscribe(ctx context.Context, chatIDs ...entity.ChatID) error {
+	c.mu.Lock()
+	var oldIDs []entity.ChatID
+	for _, chatID := range chatIDs {
+		if _, ok := c.chatIDs[chatID]; ok {
+			delete(c.chatIDs, chatID)
+			oldIDs = append(oldIDs, chatID)
+		}
+	}
+	c.mu.Unlock()
+
+	if len(oldIDs) == 0 {
+		return nil
+	}
+	return c.hub.release(ctx, oldIDs...)
+}
+
+func (c *channelMessageConsumer) Close() error {
+	c.mu.Lock()
+	chatIDs := make([]entity.ChatID, 0, len(c.chatIDs))
+	for chatID := range c.chatIDs {
+		chatIDs = append(chatIDs, chatID)
+	}
+	c.chatIDs = make(map[entity.ChatID]struct{})
+	c.mu.Unlock()
+
+	c.hub.unregister(c)
+	return c.hub.release(context.Background(), chatIDs...)
+}
+
+// splitMessageConsumer consumes messages of channels from the hub and messages of other chats
+// from the underlying strategy.
+type splitMessageConsumer struct {
+	next    MessageConsumer
+	channel *channelMessageConsumer
+	initErr error
+}
+
+func (c *splitMessageConsumer) BeginConsume(ctx context.Context) (<-chan entity.Message, <-chan error) {
+	nextCh, nextErrCh := c.next.BeginConsume(ctx)
+	channelCh, overflowCh := c.channel.msgCh, c.channel.overflowCh
+	outCh, errCh := make(chan entity.Message), make(chan error)
+
+	go func() {
+		defer close(outCh)
+		defer close(errCh)
+
+		if c.initErr != nil {
+			select {
+			case errCh <- c.initErr:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		for nextCh != nil || channelCh != nil {
+			var (
+				message entity.Message
+				ok      bool
+			)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-overflowCh:
+				c.sendOverflow(ctx, errCh)
+				return
+			case message, ok = <-nextCh:
+				if !ok {
+					nextCh = nil
+					continue
+				}
+			case message, ok = <-channelCh:
+				if !ok {
+					channelCh = nil
+					continue
+				}
+			case err, ok := <-nextErrCh:
+				if !ok {
+					nextErrCh = nil
+					continue
+				}
+
+				select {
+				case errCh <- err:
+				case <-ctx.Done():
+					return
+				}
+				continue
+			}
+
+			select {
+			case outCh <- message:
+			case <-overflowCh:
+				c.sendOverflow(ctx, errCh)
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return outCh, errCh
+}
+
+func (c *splitMessageConsumer) sendOverflow(ctx context.Context, errCh chan<- error) {
+	select {
+	case errCh <- fmt.Errorf("%w: channel messages were dropped", entity.ErrMessageConsumerOverflow):
+	case <-ctx.Done():
+	}
+}
+
+func (c *splitMessageConsumer) Subscribe(ctx context.Context, chatIDs ...entity.ChatID) error {
+	channelIDs, otherIDs := splitChannelIDs(chatIDs)
+
+	if len(channelIDs) != 0 {
+		if err := c.channel.Subscribe(ctx, channelIDs...); err != nil {
+			return err
+		}
+	}
+	if len(otherIDs) != 0 {
+		return c.next.Subscribe(ctx, otherIDs...)
+	}
+	return nil
+}
+
+func (c *splitMessageConsumer) Unsubscribe(ctx context.Context, chatIDs ...entity.ChatID) error {
+	channelIDs, otherIDs := splitChannelIDs(chatIDs)
+
+	if len(channelIDs) != 0 {
+		if err := c.channel.Unsubscribe(ctx, channelIDs...); err != nil {
+			return err
+		}
+	}
+	if len(otherIDs) != 0 {
+		return c.next.Unsubscribe(ctx, otherIDs...)
+	}
+	return nil
+}
+
+func (c *splitMessageConsumer) Close() error {
+	return errors.Join(c.channel.Close(), c.next.Close())
+}
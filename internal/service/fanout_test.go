@@ -0,0 +1,239 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/internal/infrastructure/sysbus/memory"
+	"github.com/Chatyx/backend/internal/service"
+	"github.com/Chatyx/backend/pkg/ctxutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInboxFanOut(t *testing.T) {
+	groupChatID := entity.ChatID{ID: 1, Type: entity.GroupChatType}
+	dialogChatID := entity.ChatID{ID: 2, Type: entity.DialogChatType}
+
+	broker := memory.NewBroker()
+	t.Cleanup(func() { _ = broker.Close() })
+
+	lister := service.NewMockChatParticipantLister(t)
+	lister.On("ListActiveUserIDs", mock.Anything, groupChatID).Return([]int{1, 2}, nil)
+	lister.On("ListActiveUserIDs", mock.Anything, dialogChatID).Return([]int{1, 3}, nil)
+
+	inboxPubSub := memory.NewMessageInboxPublishSubscriber(broker)
+	fanOut := service.NewInboxFanOut(service.InboxFanOutConfig{
+		ParticipantLister: lister,
+		Publisher:         inboxPubSub,
+		Subscriber:        inboxPubSub,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	firstCons := fanOut.Subscribe(ctxutil.WithUserID(ctx, "1"), groupChatID, dialogChatID)
+	t.Cleanup(func() { _ = firstCons.Close() })
+	firstCh, _ := firstCons.BeginConsume(ctx)
+
+	secondCons := fanOut.Subscribe(ctxutil.WithUserID(ctx, "2"), groupChatID)
+	t.Cleanup(func() { _ = secondCons.Close() })
+	secondCh, _ := secondCons.BeginConsume(ctx)
+
+	require.NoError(t, fanOut.Publish(ctx, entity.Message{ID: 1, ChatID: groupChatID}))
+
+	for _, ch := range []<-chan entity.Message{firstCh, secondCh} {
+		select {
+		case message := <-ch:
+			assert.Equal(t, 1, message.ID)
+		case <-time.After(receiveTimeout):
+			t.Fatal("Message wasn't delivered to the inbox")
+		}
+	}
+
+	// Unsubscribing only filters messages locally, the inbox is still delivered.
+	require.NoError(t, firstCons.Unsubscribe(ctx, groupChatID))
+	require.NoError(t, fanOut.Publish(ctx, entity.Message{ID: 2, ChatID: groupChatID}))
+	require.NoError(t, fanOut.Publish(ctx, entity.Message{ID: 3, ChatID: dialogChatID}))
+
+	select {
+	case message := <-firstCh:
+		assert.Equal(t, 3, message.ID)
+	case <-time.After(receiveTimeout):
+		t.Fatal("Message wasn't delivered to the inbox")
+	}
+
+	select {
+	case message := <-secondCh:
+		assert.Equal(t, 2, message.ID)
+	case <-time.After(receiveTimeout):
+		t.Fatal("Message wasn't delivered to the inbox")
+	}
+}
+
+type countingSubscriber struct {
+	service.MessageSubscriber
+	subscriptions atomic.Int32
+}
+
+func (s *countingSubscriber) Subscribe(ctx context.Context, chatIDs ...entity.ChatID) service.MessageConsumer { //nolint:ireturn,lll // that's a factory
+	s.subscriptions.Add(1)
+	return s.MessageSubscriber.Subscribe(ctx, chatIDs...)
+}
+
+func TestChannelFanOut(t *testing.T) {
+	groupChatID := entity.ChatID{ID: 1, Type: entity.GroupChatType}
+	channelChatID := entity.ChatID{ID: 2, Type: entity.ChannelChatType}
+
+	broker := memory.NewBroker()
+	t.Cleanup(func() { _ = broker.Close() })
+
+	pubSub := memory.NewMessagePublishSubscriber(broker)
+	bcastSub := &countingSubscriber{MessageSubscriber: pubSub}
+	fanOut := service.NewChannelFanOut(service.ChannelFanOutConfig{
+		Publisher:           pubSub,
+		Subscriber:          pubSub,
+		BroadcastPublisher:  pubSub,
+		BroadcastSubscriber: bcastSub,
+	})
+	t.Cleanup(func() { _ = fanOut.Close() })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	firstCons := fanOut.Subscribe(ctx, groupChatID, channelChatID)
+	t.Cleanup(func() { _ = firstCons.Close() })
+	firstCh, _ := firstCons.BeginConsume(ctx)
+
+	secondCons := fanOut.Subscribe(ctx, channelChatID)
+	t.Cleanup(func() { _ = secondCons.Close() })
+	secondCh, _ := secondCons.BeginConsume(ctx)
+
+	// Sessions of the node share the single subscription to channels.
+	assert.Equal(t, int32(1), bcastSub.subscriptions.Load())
+
+	require.NoError(t, fanOut.Publish(ctx, entity.Message{ID: 1, ChatID: channelChatID}))
+
+	for _, ch := range []<-chan entity.Message{firstCh, secondCh} {
+		select {
+		case message := <-ch:
+			assert.Equal(t, 1, message.ID)
+		case <-time.After(receiveTimeout):
+			t.Fatal("Channel message wasn't delivered to the subscriber")
+		}
+	}
+
+	require.NoError(t, firstCons.Unsubscribe(ctx, channelChatID))
+	require.NoError(t, fanOut.Publish(ctx, entity.Message{ID: 2, ChatID: channelChatID}))
+	require.NoError(t, fanOut.Publish(ctx, entity.Message{ID: 3, ChatID: groupChatID}))
+
+	select {
+	case message := <-firstCh:
+		assert.Equal(t, 3, message.ID)
+	case <-time.After(receiveTimeout):
+		t.Fatal("Group message wasn't delivered to the subscriber")
+	}
+
+	select {
+	case message := <-secondCh:
+		assert.Equal(t, 2, message.ID)
+	case <-time.After(receiveTimeout):
+		t.Fatal("Channel message wasn't delivered to the subscriber")
+	}
+}
+
+func TestChannelFanOut_SlowConsumer(t *testing.T) {
+	channelChatID := entity.ChatID{ID: 2, Type: entity.ChannelChatType}
+
+	broker := memory.NewBroker()
+	t.Cleanup(func() { _ = broker.Close() })
+
+	pubSub := memory.NewMessagePublishSubscriber(broker)
+	fanOut := service.NewChannelFanOut(service.ChannelFanOutConfig{
+		Publisher:           pubSub,
+		Subscriber:          pubSub,
+		BroadcastPublisher:  pubSub,
+		BroadcastSubscriber: pubSub,
+		ConsumerBufferSize:  1,
+	})
+	t.Cleanup(func() { _ = fanOut.Close() })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	cons := fanOut.Subscribe(ctx, channelChatID)
+	t.Cleanup(func() { _ = cons.Close() })
+	_, errCh := cons.BeginConsume(ctx)
+
+	// Nobody reads messages, so the buffer of the consumer is overflowed.
+	for id := 1; id <= 3; id++ {
+		require.NoError(t, fanOut.Publish(ctx, entity.Message{ID: id, ChatID: channelChatID}))
+	}
+
+	select {
+	case err := <-errCh:
+		assert.ErrorIs(t, err, entity.ErrMessageConsumerOverflow)
+	case <-time.After(receiveTimeout):
+		t.Fatal("Overflow of the consumer wasn't reported")
+	}
+}
+
+var errSubscription = errors.New("subscription error")
+
+type failingConsumer struct {
+	service.MessageConsumer
+	subscriptions atomic.Int32
+}
+
+func (c *failingConsumer) Subscribe(context.Context, ...entity.ChatID) error {
+	c.subscriptions.Add(1)
+	return errSubscription
+}
+
+type failingSubscriber struct {
+	service.MessageSubscriber
+	cons *failingConsumer
+}
+
+func (s *failingSubscriber) Subscribe(ctx context.Context, chatIDs ...entity.ChatID) service.MessageConsumer { //nolint:ireturn // that's a factory
+	s.cons.MessageConsumer = s.MessageSubscriber.Subscribe(ctx, chatIDs...)
+	return s.cons
+}
+
+func TestChannelFanOut_FailedSubscription(t *testing.T) {
+	firstChatID := entity.ChatID{ID: 2, Type: entity.ChannelChatType}
+	secondChatID := entity.ChatID{ID: 3, Type: entity.ChannelChatType}
+
+	broker := memory.NewBroker()
+	t.Cleanup(func() { _ = broker.Close() })
+
+	pubSub := memory.NewMessagePublishSubscriber(broker)
+	bcastSub := &failingSubscriber{MessageSubscriber: pubSub, cons: &failingConsumer{}}
+	fanOut := service.NewChannelFanOut(service.ChannelFanOutConfig{
+		Publisher:           pubSub,
+		Subscriber:          pubSub,
+		BroadcastPublisher:  pubSub,
+		BroadcastSubscriber: bcastSub,
+	})
+	t.Cleanup(func() { _ = fanOut.Close() })
+
+	ctx := context.Background()
+
+	firstCons := fanOut.Subscribe(ctx, firstChatID)
+	t.Cleanup(func() { _ = firstCons.Close() })
+
+	secondCons := fanOut.Subscribe(ctx, firstChatID)
+	t.Cleanup(func() { _ = secondCons.Close() })
+
+	// The failed subscription is rolled back, so it's retried by the next attempt.
+	for attempt := int32(1); attempt <= 2; attempt++ {
+		require.ErrorIs(t, secondCons.Subscribe(ctx, secondChatID), errSubscription)
+		assert.Equal(t, attempt, bcastSub.cons.subscriptions.Load())
+	}
+}
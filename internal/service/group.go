@@ -10,23 +10,51 @@ import (
 	"github.com/Chatyx/backend/pkg/ctxutil"
 )
 
+//go:generate mockery --inpackage --testonly --case underscore --name GroupRepository
 type GroupRepository interface {
 	List(ctx context.Context) ([]entity.Group, error)
 	Create(ctx context.Context, group *entity.Group) error
-	GetByID(ctx context.Context, id int) (entity.Group, error)
+	GetByID(ctx context.Context, id int, withLock bool) (entity.Group, error)
 	Update(ctx context.Context, group *entity.Group) error
 	Delete(ctx context.Context, id int) error
+	Restore(ctx context.Context, id int, deletedAfter time.Time) (entity.Group, error)
+	GetSettings(ctx context.Context, groupID int, withLock bool) (entity.GroupSettings, error)
+	UpdateSettings(ctx context.Context, groupID int, settings entity.GroupSettings) error
+}
+
+type GroupConfig struct {
+	Repository            GroupRepository
+	ParticipantRepository GroupParticipantRepository
+	EventProducer         GroupParticipantEventProducer
+	MessageCreator        ServiceMessageCreator
+	AuditLogRepository    GroupAuditLogRepository
+	TxManager             TransactionManager
+	RetentionPeriod       time.Duration
 }
 
 type Group struct {
-	repo GroupRepository
-	prod GroupParticipantEventProducer
+	repo            GroupRepository
+	participantRepo GroupParticipantRepository
+	prod            GroupParticipantEventProducer
+	msgCreator      ServiceMessageCreator
+	auditRepo       GroupAuditLogRepository
+	txm             TransactionManager
+	retention       time.Duration
 }
 
-func NewGroup(repo GroupRepository, prod GroupParticipantEventProducer) *Group {
+func NewGroup(conf GroupConfig) *Group {
+	if conf.RetentionPeriod == 0 {
+		conf.RetentionPeriod = defaultDeletedGroupRetentionPeriod
+	}
+
 	return &Group{
-		repo: repo,
-		prod: prod,
+		repo:            conf.Repository,
+		participantRepo: conf.ParticipantRepository,
+		prod:            conf.EventProducer,
+		msgCreator:      conf.MessageCreator,
+		auditRepo:       conf.AuditLogRepository,
+		txm:             conf.TxManager,
+		retention:       conf.RetentionPeriod,
 	}
 }
 
@@ -41,6 +69,7 @@ func (g *Group) List(ctx context.Context) ([]entity.Group, error) {
 
 func (g *Group) Create(ctx context.Context, obj dto.GroupCreate) (entity.Group, error) {
 	group := entity.Group{
+		Uname:       obj.Uname,
 		Name:        obj.Name,
 		Description: obj.Description,
 		CreatedAt:   time.Now(),
@@ -66,7 +95,7 @@ func (g *Group) Create(ctx context.Context, obj dto.GroupCreate) (entity.Group,
 }
 
 func (g *Group) GetByID(ctx context.Context, id int) (entity.Group, error) {
-	group, err := g.repo.GetByID(ctx, id)
+	group, err := g.repo.GetByID(ctx, id, false)
 	if err != nil {
 		return entity.Group{}, fmt.Errorf("get group by id: %w", err)
 	}
@@ -77,19 +106,196 @@ func (g *Group) GetByID(ctx context.Context, id int) (entity.Group, error) {
 func (g *Group) Update(ctx context.Context, obj dto.GroupUpdate) (entity.Group, error) {
 	group := entity.Group{
 		ID:          obj.ID,
+		Uname:       obj.Uname,
 		Name:        obj.Name,
 		Description: obj.Description,
 	}
 
-	if err := g.repo.Update(ctx, &group); err != nil {
-		return entity.Group{}, fmt.Errorf("update group: %w", err)
+	var prevGroup entity.Group
+
+	err := g.txm.Do(ctx, func(ctx context.Context) (err error) {
+		prevGroup, err = g.repo.GetByID(ctx, obj.ID, true)
+		if err != nil {
+			return fmt.Errorf("get group by id: %w", err)
+		}
+
+		if err = g.repo.Update(ctx, &group); err != nil {
+			return fmt.Errorf("update group: %w", err)
+		}
+
+		return recordGroupAudit(ctx, g.auditRepo, group.ID, nil, entity.GroupUpdatedAuditAction,
+			groupAuditState(prevGroup), groupAuditState(group))
+	})
+	if err != nil {
+		return entity.Group{}, fmt.Errorf("call transaction manager: %w", err)
 	}
+
+	if prevGroup.Name != group.Name {
+		action := entity.ServiceAction{
+			Type: entity.GroupRenamedServiceAction,
+			Params: map[string]string{
+				entity.NameServiceParam:     group.Name,
+				entity.PrevNameServiceParam: prevGroup.Name,
+			},
+		}
+
+		createServiceMessage(ctx, g.msgCreator, entity.ChatID{ID: group.ID, Type: entity.GroupChatType}, action)
+	}
+
 	return group, nil
 }
 
+// Delete deletes the group softly, so the owner can restore it until the retention
+// period is over. Participants are removed from the chat by events.
 func (g *Group) Delete(ctx context.Context, id int) error {
-	if err := g.repo.Delete(ctx, id); err != nil {
-		return fmt.Errorf("delete group: %w", err)
+	var participants []entity.GroupParticipant
+
+	err := g.txm.Do(ctx, func(ctx context.Context) error {
+		prevGroup, err := g.repo.GetByID(ctx, id, true)
+		if err != nil {
+			return fmt.Errorf("get group by id: %w", err)
+		}
+
+		participants, err = g.participantRepo.List(ctx, id)
+		if err != nil {
+			return fmt.Errorf("list of group participants: %w", err)
+		}
+
+		if err = g.repo.Delete(ctx, id); err != nil {
+			return fmt.Errorf("delete group: %w", err)
+		}
+
+		return recordGroupAudit(ctx, g.auditRepo, id, nil, entity.GroupDeletedAuditAction,
+			groupAuditState(prevGroup), nil)
+	})
+	if err != nil {
+		return fmt.Errorf("call transaction manager: %w", err)
+	}
+
+	return g.produceForParticipants(ctx, id, entity.RemovedParticipant, participants)
+}
+
+// Restore restores the deleted group. Only the owner can do it until the retention period is over.
+func (g *Group) Restore(ctx context.Context, id int) (entity.Group, error) {
+	var group entity.Group
+
+	err := g.txm.Do(ctx, func(ctx context.Context) error {
+		var err error
+		group, err = g.repo.Restore(ctx, id, time.Now().Add(-g.retention))
+		if err != nil {
+			return fmt.Errorf("restore group: %w", err)
+		}
+
+		return recordGroupAudit(ctx, g.auditRepo, id, nil, entity.GroupRestoredAuditAction,
+			nil, groupAuditState(group))
+	})
+	if err != nil {
+		return entity.Group{}, fmt.Errorf("call transaction manager: %w", err)
+	}
+
+	participants, err := g.participantRepo.List(ctx, id)
+	if err != nil {
+		return entity.Group{}, fmt.Errorf("list of group participants: %w", err)
+	}
+
+	if err = g.produceForParticipants(ctx, id, entity.AddedParticipant, participants); err != nil {
+		return entity.Group{}, err
+	}
+	return group, nil
+}
+
+func (g *Group) GetSettings(ctx context.Context, groupID int) (entity.GroupSettings, error) {
+	settings, err := g.repo.GetSettings(ctx, groupID, false)
+	if err != nil {
+		return entity.GroupSettings{}, fmt.Errorf("get group settings: %w", err)
+	}
+
+	return settings, nil
+}
+
+// UpdateSettings updates settings of the group. Participants are notified
+// about every changed setting by chat-updated events and service messages.
+func (g *Group) UpdateSettings(ctx context.Context, obj dto.GroupSettingsUpdate) (entity.GroupSettings, error) {
+	settings := entity.GroupSettings{
+		OnlyAdminsCanPost: obj.OnlyAdminsCanPost,
+		SlowModeInterval:  obj.SlowModeInterval,
+		MembersCanInvite:  obj.MembersCanInvite,
+	}
+
+	var prevSettings entity.GroupSettings
+	err := g.txm.Do(ctx, func(ctx context.Context) error {
+		var err error
+		prevSettings, err = g.repo.GetSettings(ctx, obj.GroupID, true)
+		if err != nil {
+			return fmt.Errorf("get group settings: %w", err)
+		}
+
+		if err = g.repo.UpdateSettings(ctx, obj.GroupID, settings); err != nil {
+			return fmt.Errorf("update group settings: %w", err)
+		}
+
+		if prevSettings == settings {
+			return nil
+		}
+		return recordGroupAudit(ctx, g.auditRepo, obj.GroupID, nil, entity.GroupSettingsUpdatedAuditAction,
+			groupSettingsAuditState(prevSettings), groupSettingsAuditState(settings))
+	})
+	if err != nil {
+		return entity.GroupSettings{}, fmt.Errorf("call transaction manager: %w", err)
+	}
+
+	actions := settingsChangeActions(prevSettings, settings)
+	if len(actions) == 0 {
+		return settings, nil
+	}
+
+	if err = g.produceChatUpdated(ctx, obj.GroupID); err != nil {
+		return entity.GroupSettings{}, err
+	}
+
+	chatID := entity.ChatID{ID: obj.GroupID, Type: entity.GroupChatType}
+	for _, action := range actions {
+		createServiceMessage(ctx, g.msgCreator, chatID, action)
+	}
+
+	return settings, nil
+}
+
+func (g *Group) produceChatUpdated(ctx context.Context, groupID int) error {
+	participants, err := g.participantRepo.List(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("list of group participants: %w", err)
+	}
+
+	return g.produceForParticipants(ctx, groupID, entity.ChatUpdated, participants)
+}
+
+// produceForParticipants produces the event to every participant who is in the group.
+func (g *Group) produceForParticipants(
+	ctx context.Context,
+	groupID int,
+	eventType entity.ParticipantEventType,
+	participants []entity.GroupParticipant,
+) error {
+	curUserID := ctxutil.UserIDFromContext(ctx).ToInt()
+
+	for _, participant := range participants {
+		if !participant.IsInGroup() {
+			continue
+		}
+
+		event := entity.ParticipantEvent{
+			Type: eventType,
+			ChatID: entity.ChatID{
+				ID:   groupID,
+				Type: entity.GroupChatType,
+			},
+			UserID:      participant.UserID,
+			InitiatorID: curUserID,
+		}
+		if err := g.prod.Produce(ctx, event); err != nil {
+			return fmt.Errorf("produce group participant event: %w", err)
+		}
 	}
 
 	return nil
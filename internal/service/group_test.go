@@ -0,0 +1,280 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Chatyx/backend/internal/dto"
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/ctxutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroup_UpdateSettings(t *testing.T) {
+	groupChatID := entity.ChatID{ID: 1, Type: entity.GroupChatType}
+
+	testCases := []struct {
+		name          string
+		obj           dto.GroupSettingsUpdate
+		mockBehavior  func(repo *MockGroupRepository, participantRepo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer, msgCreator *MockServiceMessageCreator)
+		expectedError error
+	}{
+		{
+			name: "Successful",
+			obj: dto.GroupSettingsUpdate{
+				GroupID:           1,
+				OnlyAdminsCanPost: true,
+				SlowModeInterval:  30 * time.Second,
+			},
+			mockBehavior: func(repo *MockGroupRepository, participantRepo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer, msgCreator *MockServiceMessageCreator) {
+				repo.On("GetSettings", mock.Anything, 1, true).Return(entity.GroupSettings{}, nil)
+				repo.On("UpdateSettings", mock.Anything, 1, entity.GroupSettings{
+					OnlyAdminsCanPost: true,
+					SlowModeInterval:  30 * time.Second,
+				}).Return(nil)
+				participantRepo.On("List", mock.Anything, 1).Return([]entity.GroupParticipant{
+					{GroupID: 1, UserID: 1, Role: entity.OwnerRole, Status: entity.JoinedStatus},
+					{GroupID: 1, UserID: 2, Role: entity.MemberRole, Status: entity.MutedStatus},
+					{GroupID: 1, UserID: 3, Role: entity.MemberRole, Status: entity.LeftStatus},
+				}, nil)
+				for _, userID := range []int{1, 2} {
+					prod.On("Produce", mock.Anything, entity.ParticipantEvent{
+						Type:        entity.ChatUpdated,
+						ChatID:      groupChatID,
+						UserID:      userID,
+						InitiatorID: 1,
+					}).Return(nil).Once()
+				}
+				msgCreator.On("CreateService", mock.Anything, groupChatID, settingChangeAction(onlyAdminsCanPostSetting, "true")).
+					Return(entity.Message{}, nil).Once()
+				msgCreator.On("CreateService", mock.Anything, groupChatID, settingChangeAction(slowModeIntervalSetting, "30")).
+					Return(entity.Message{}, nil).Once()
+			},
+		},
+		{
+			name: "Nothing is changed",
+			obj:  dto.GroupSettingsUpdate{GroupID: 1, MembersCanInvite: true},
+			mockBehavior: func(repo *MockGroupRepository, _ *MockGroupParticipantRepository, _ *MockGroupParticipantEventProducer, _ *MockServiceMessageCreator) {
+				repo.On("GetSettings", mock.Anything, 1, true).Return(entity.GroupSettings{MembersCanInvite: true}, nil)
+				repo.On("UpdateSettings", mock.Anything, 1, entity.GroupSettings{MembersCanInvite: true}).Return(nil)
+			},
+		},
+		{
+			name: "Current user can't edit the group",
+			obj:  dto.GroupSettingsUpdate{GroupID: 1, MembersCanInvite: true},
+			mockBehavior: func(repo *MockGroupRepository, _ *MockGroupParticipantRepository, _ *MockGroupParticipantEventProducer, _ *MockServiceMessageCreator) {
+				repo.On("GetSettings", mock.Anything, 1, true).Return(entity.GroupSettings{}, nil)
+				repo.On("UpdateSettings", mock.Anything, 1, entity.GroupSettings{MembersCanInvite: true}).
+					Return(entity.ErrGroupNotFound)
+			},
+			expectedError: entity.ErrGroupNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			repo := NewMockGroupRepository(t)
+			participantRepo := NewMockGroupParticipantRepository(t)
+			prod := NewMockGroupParticipantEventProducer(t)
+			msgCreator := NewMockServiceMessageCreator(t)
+			testCase.mockBehavior(repo, participantRepo, prod, msgCreator)
+
+			txm := NewMockTransactionManager(t)
+			txm.On("Do", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+				return fn(ctx)
+			})
+
+			service := NewGroup(GroupConfig{
+				Repository:            repo,
+				ParticipantRepository: participantRepo,
+				EventProducer:         prod,
+				MessageCreator:        msgCreator,
+				AuditLogRepository:    newMockGroupAuditLogRepository(t),
+				TxManager:             txm,
+			})
+			ctx := ctxutil.WithUserID(context.Background(), "1")
+
+			_, err := service.UpdateSettings(ctx, testCase.obj)
+			if testCase.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestGroup_Update(t *testing.T) {
+	repo := NewMockGroupRepository(t)
+	repo.On("GetByID", mock.Anything, 1, true).Return(entity.Group{
+		ID:          1,
+		Uname:       "group",
+		Name:        "Old name",
+		Description: "Description",
+	}, nil)
+	repo.On("Update", mock.Anything, &entity.Group{
+		ID:          1,
+		Uname:       "group",
+		Name:        "New name",
+		Description: "Description",
+	}).Return(nil)
+
+	auditRepo := NewMockGroupAuditLogRepository(t)
+	auditRepo.On("Create", mock.Anything, mock.MatchedBy(func(entry *entity.GroupAuditEntry) bool {
+		return entry.GroupID == 1 &&
+			entry.ActorID == 1 &&
+			entry.TargetUserID == nil &&
+			entry.Action == entity.GroupUpdatedAuditAction &&
+			entry.Before["name"] == "Old name" &&
+			entry.After["name"] == "New name"
+	})).Return(nil)
+
+	txm := NewMockTransactionManager(t)
+	txm.On("Do", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	})
+
+	msgCreator := NewMockServiceMessageCreator(t)
+	msgCreator.On("CreateService", mock.Anything, entity.ChatID{ID: 1, Type: entity.GroupChatType}, entity.ServiceAction{
+		Type: entity.GroupRenamedServiceAction,
+		Params: map[string]string{
+			entity.NameServiceParam:     "New name",
+			entity.PrevNameServiceParam: "Old name",
+		},
+	}).Return(entity.Message{}, nil)
+
+	service := NewGroup(GroupConfig{
+		Repository:         repo,
+		MessageCreator:     msgCreator,
+		AuditLogRepository: auditRepo,
+		TxManager:          txm,
+	})
+	ctx := ctxutil.WithUserID(context.Background(), "1")
+
+	group, err := service.Update(ctx, dto.GroupUpdate{
+		ID:          1,
+		Uname:       "group",
+		Name:        "New name",
+		Description: "Description",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "New name", group.Name)
+}
+
+func TestGroup_Delete(t *testing.T) {
+	groupChatID := entity.ChatID{ID: 1, Type: entity.GroupChatType}
+
+	repo := NewMockGroupRepository(t)
+	repo.On("GetByID", mock.Anything, 1, true).Return(entity.Group{ID: 1, Name: "Group"}, nil)
+	repo.On("Delete", mock.Anything, 1).Return(nil)
+
+	participantRepo := NewMockGroupParticipantRepository(t)
+	participantRepo.On("List", mock.Anything, 1).Return([]entity.GroupParticipant{
+		{GroupID: 1, UserID: 1, Role: entity.OwnerRole, Status: entity.JoinedStatus},
+		{GroupID: 1, UserID: 2, Role: entity.MemberRole, Status: entity.MutedStatus},
+		{GroupID: 1, UserID: 3, Role: entity.MemberRole, Status: entity.BannedStatus},
+	}, nil)
+
+	prod := NewMockGroupParticipantEventProducer(t)
+	for _, userID := range []int{1, 2} {
+		prod.On("Produce", mock.Anything, entity.ParticipantEvent{
+			Type:        entity.RemovedParticipant,
+			ChatID:      groupChatID,
+			UserID:      userID,
+			InitiatorID: 1,
+		}).Return(nil).Once()
+	}
+
+	txm := NewMockTransactionManager(t)
+	txm.On("Do", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	})
+
+	service := NewGroup(GroupConfig{
+		Repository:            repo,
+		ParticipantRepository: participantRepo,
+		EventProducer:         prod,
+		AuditLogRepository:    newMockGroupAuditLogRepository(t),
+		TxManager:             txm,
+	})
+	ctx := ctxutil.WithUserID(context.Background(), "1")
+
+	require.NoError(t, service.Delete(ctx, 1))
+}
+
+func TestGroup_Restore(t *testing.T) {
+	groupChatID := entity.ChatID{ID: 1, Type: entity.GroupChatType}
+	retention := 24 * time.Hour
+
+	testCases := []struct {
+		name          string
+		mockBehavior  func(repo *MockGroupRepository, participantRepo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer)
+		expectedGroup entity.Group
+		expectedError error
+	}{
+		{
+			name: "Successful",
+			mockBehavior: func(repo *MockGroupRepository, participantRepo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer) {
+				repo.On("Restore", mock.Anything, 1, mock.AnythingOfType("time.Time")).Return(entity.Group{ID: 1, Name: "Group"}, nil)
+				participantRepo.On("List", mock.Anything, 1).Return([]entity.GroupParticipant{
+					{GroupID: 1, UserID: 1, Role: entity.OwnerRole, Status: entity.JoinedStatus},
+					{GroupID: 1, UserID: 2, Role: entity.MemberRole, Status: entity.LeftStatus},
+				}, nil)
+				prod.On("Produce", mock.Anything, entity.ParticipantEvent{
+					Type:        entity.AddedParticipant,
+					ChatID:      groupChatID,
+					UserID:      1,
+					InitiatorID: 1,
+				}).Return(nil).Once()
+			},
+			expectedGroup: entity.Group{ID: 1, Name: "Group"},
+		},
+		{
+			name: "Retention period is over or current user isn't the owner",
+			mockBehavior: func(repo *MockGroupRepository, _ *MockGroupParticipantRepository, _ *MockGroupParticipantEventProducer) {
+				repo.On("Restore", mock.Anything, 1, mock.AnythingOfType("time.Time")).Return(entity.Group{}, entity.ErrGroupNotFound)
+			},
+			expectedError: entity.ErrGroupNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			repo := NewMockGroupRepository(t)
+			participantRepo := NewMockGroupParticipantRepository(t)
+			prod := NewMockGroupParticipantEventProducer(t)
+			testCase.mockBehavior(repo, participantRepo, prod)
+
+			txm := NewMockTransactionManager(t)
+			txm.On("Do", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+				return fn(ctx)
+			})
+
+			service := NewGroup(GroupConfig{
+				Repository:            repo,
+				ParticipantRepository: participantRepo,
+				EventProducer:         prod,
+				AuditLogRepository:    newMockGroupAuditLogRepository(t),
+				TxManager:             txm,
+				RetentionPeriod:       retention,
+			})
+			ctx := ctxutil.WithUserID(context.Background(), "1")
+
+			before := time.Now()
+			group, err := service.Restore(ctx, 1)
+			if testCase.expectedError == nil {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedGroup, group)
+
+				deletedAfter := repo.Calls[0].Arguments.Get(2).(time.Time)
+				assert.WithinDuration(t, before.Add(-retention), deletedAfter, time.Second)
+			} else {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
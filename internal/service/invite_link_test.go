@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Chatyx/backend/internal/dto"
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/ctxutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupInviteLink_Create(t *testing.T) {
+	testCases := []struct {
+		name          string
+		curRole       entity.GroupRole
+		mockBehavior  func(repo *MockGroupInviteLinkRepository)
+		expectedError error
+	}{
+		{
+			name:    "Successful",
+			curRole: entity.ModeratorRole,
+			mockBehavior: func(repo *MockGroupInviteLinkRepository) {
+				repo.On("Create", mock.Anything, mock.MatchedBy(func(link *entity.GroupInviteLink) bool {
+					return len(link.Token) == 2*inviteLinkTokenSize && link.GroupID == 1 &&
+						link.CreatorID == 1 && *link.MaxUses == 10
+				})).Return(nil)
+			},
+		},
+		{
+			name:          "Current user doesn't have invite permission",
+			curRole:       entity.MemberRole,
+			expectedError: entity.ErrForbiddenPerformAction,
+		},
+		{
+			name:    "Unexpected error while creating link",
+			curRole: entity.AdminRole,
+			mockBehavior: func(repo *MockGroupInviteLinkRepository) {
+				repo.On("Create", mock.Anything, mock.Anything).Return(errUnexpected)
+			},
+			expectedError: errUnexpected,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			repo := NewMockGroupInviteLinkRepository(t)
+			participantRepo := NewMockGroupParticipantRepository(t)
+
+			participantRepo.On("Get", mock.Anything, 1, 1, false).Return(entity.GroupParticipant{
+				GroupID: 1,
+				UserID:  1,
+				Role:    testCase.curRole,
+				Status:  entity.JoinedStatus,
+			}, nil)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(repo)
+			}
+
+			service := NewGroupInviteLink(GroupInviteLinkConfig{
+				Repository:            repo,
+				ParticipantRepository: participantRepo,
+			})
+			ctx := ctxutil.WithUserID(context.Background(), "1")
+
+			maxUses := 10
+			link, err := service.Create(ctx, dto.GroupInviteLinkCreate{GroupID: 1, MaxUses: &maxUses})
+			if testCase.expectedError == nil {
+				require.NoError(t, err)
+				assert.True(t, link.IsActive(time.Now()))
+			} else {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestGroupInviteLink_Revoke(t *testing.T) {
+	runTx := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	}
+
+	testCases := []struct {
+		name          string
+		link          entity.GroupInviteLink
+		mockBehavior  func(repo *MockGroupInviteLinkRepository)
+		expectedError error
+	}{
+		{
+			name: "Successful",
+			link: entity.GroupInviteLink{Token: "token", GroupID: 1},
+			mockBehavior: func(repo *MockGroupInviteLinkRepository) {
+				repo.On("Update", mock.Anything, mock.MatchedBy(func(link *entity.GroupInviteLink) bool {
+					return link.Token == "token" && link.RevokedAt != nil
+				})).Return(nil)
+			},
+		},
+		{
+			name: "Link is already revoked",
+			link: entity.GroupInviteLink{Token: "token", GroupID: 1, RevokedAt: &time.Time{}},
+		},
+		{
+			name:          "Link belongs to another group",
+			link:          entity.GroupInviteLink{Token: "token", GroupID: 2},
+			expectedError: entity.ErrGroupInviteLinkNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			txm := NewMockTransactionManager(t)
+			repo := NewMockGroupInviteLinkRepository(t)
+			participantRepo := NewMockGroupParticipantRepository(t)
+
+			txm.On("Do", mock.Anything, mock.Anything).Return(runTx)
+			participantRepo.On("Get", mock.Anything, 1, 1, false).Return(entity.GroupParticipant{
+				GroupID: 1,
+				UserID:  1,
+				Role:    entity.AdminRole,
+				Status:  entity.JoinedStatus,
+			}, nil)
+			repo.On("Get", mock.Anything, "token", true).Return(testCase.link, nil)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(repo)
+			}
+
+			service := NewGroupInviteLink(GroupInviteLinkConfig{
+				TxManager:             txm,
+				Repository:            repo,
+				ParticipantRepository: participantRepo,
+			})
+			ctx := ctxutil.WithUserID(context.Background(), "1")
+
+			err := service.Revoke(ctx, 1, "token")
+			if testCase.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
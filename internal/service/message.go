@@ -10,30 +10,71 @@ import (
 	"github.com/Chatyx/backend/pkg/ctxutil"
 )
 
+//go:generate mockery --inpackage --testonly --case underscore --name MessageRepository
 type MessageRepository interface {
 	List(ctx context.Context, obj dto.MessageList) ([]entity.Message, error)
+	Get(ctx context.Context, chatID entity.ChatID, id int) (entity.Message, error)
 	Create(ctx context.Context, message *entity.Message) error
 }
 
+//go:generate mockery --inpackage --testonly --case underscore --name InChatChecker
 type InChatChecker interface {
 	Check(ctx context.Context, chatID entity.ChatID, userID int) error
+	CheckWrite(ctx context.Context, chatID entity.ChatID, userID int) error
 }
 
 type MessagePublisher interface {
 	Publish(ctx context.Context, message entity.Message) error
 }
 
+//go:generate mockery --inpackage --testonly --case underscore --name GroupSettingsRepository
+type GroupSettingsRepository interface {
+	GetSettings(ctx context.Context, groupID int, withLock bool) (entity.GroupSettings, error)
+}
+
+//go:generate mockery --inpackage --testonly --case underscore --name GroupSettingsGetter
+type GroupSettingsGetter interface {
+	GetSettings(ctx context.Context, groupID int) (entity.GroupSettings, error)
+}
+
+//go:generate mockery --inpackage --testonly --case underscore --name EmailVerificationChecker
+type EmailVerificationChecker interface {
+	IsEmailVerified(ctx context.Context, userID int) (bool, error)
+}
+
+type MessageConfig struct {
+	TxManager             TransactionManager
+	Repository            MessageRepository
+	Publisher             MessagePublisher
+	Checker               InChatChecker
+	GroupSettings         GroupSettingsGetter
+	ParticipantRepository GroupParticipantRepository
+	VerificationChecker   EmailVerificationChecker
+	// RequireVerifiedEmail forbids users to send messages until their email is verified.
+	RequireVerifiedEmail bool
+}
+
 type Message struct {
-	repo      MessageRepository
-	publisher MessagePublisher
-	checker   InChatChecker
+	txm                  TransactionManager
+	repo                 MessageRepository
+	publisher            MessagePublisher
+	checker              InChatChecker
+	groupSettings        GroupSettingsGetter
+	participantRepo      GroupParticipantRepository
+	verificationChecker  EmailVerificationChecker
+	requireVerifiedEmail bool
 }
 
-func NewMessage(repo MessageRepository, publisher MessagePublisher, checker InChatChecker) *Message {
+func NewMessage(conf MessageConfig) *Message {
 	return &Message{
-		repo:      repo,
-		publisher: publisher,
-		checker:   checker,
+		txm:                  conf.TxManager,
+		repo:                 conf.Repository,
+		publisher:            conf.Publisher,
+		checker:              conf.Checker,
+		groupSettings:        conf.GroupSettings,
+		participantRepo:      conf.ParticipantRepository,
+		verificationChecker:  conf.VerificationChecker,
+		requireVerifiedEmail: conf.RequireVerifiedEmail,
 	}
 }
 
@@ -51,24 +92,148 @@ func (s *Message) List(ctx context.Context, obj dto.MessageList) ([]entity.Messa
 }
 
 func (s *Message) Create(ctx context.Context, obj dto.MessageCreate) (entity.Message, error) {
+	return s.create(ctx, entity.Message{
+		ChatID:      obj.ChatID,
+		Content:     obj.Content,
+		ContentType: obj.ContentType,
+	})
+}
+
+// Forward forwards the message of another chat the current user participates in,
+// e.g. to Saved Messages. The forwarded message keeps the author of the original one,
+// so forwarding of a forwarded message doesn't hide who wrote it.
+func (s *Message) Forward(ctx context.Context, obj dto.MessageForward) (entity.Message, error) {
 	userID := ctxutil.UserIDFromContext(ctx).ToInt()
-	if err := s.checker.Check(ctx, obj.ChatID, userID); err != nil {
-		return entity.Message{}, fmt.Errorf("check whether the current user is in the chat or not: %w", err)
+	if err := s.checker.Check(ctx, obj.FromChatID, userID); err != nil {
+		return entity.Message{}, fmt.Errorf("check whether the current user is in the source chat or not: %w", err)
+	}
+
+	original, err := s.repo.Get(ctx, obj.FromChatID, obj.MessageID)
+	if err != nil {
+		return entity.Message{}, fmt.Errorf("get message to forward: %w", err)
+	}
+
+	if original.IsService {
+		return entity.Message{}, fmt.Errorf("%w: service messages can't be forwarded", entity.ErrForbiddenPerformAction)
+	}
+
+	forwardedFromID := original.SenderID
+	if original.ForwardedFromID != nil {
+		forwardedFromID = *original.ForwardedFromID
+	}
+
+	return s.create(ctx, entity.Message{
+		ChatID:          obj.ChatID,
+		Content:         original.Content,
+		ContentType:     original.ContentType,
+		ForwardedFromID: &forwardedFromID,
+	})
+}
+
+func (s *Message) create(ctx context.Context, message entity.Message) (entity.Message, error) {
+	userID := ctxutil.UserIDFromContext(ctx).ToInt()
+	if s.requireVerifiedEmail {
+		verified, err := s.verificationChecker.IsEmailVerified(ctx, userID)
+		if err != nil {
+			return entity.Message{}, fmt.Errorf("check whether the current user has verified the email: %w", err)
+		}
+		if !verified {
+			return entity.Message{}, fmt.Errorf("%w: messaging requires a verified email", entity.ErrEmailNotVerified)
+		}
+	}
+
+	if err := s.checker.CheckWrite(ctx, message.ChatID, userID); err != nil {
+		return entity.Message{}, fmt.Errorf("check whether the current user can post to the chat or not: %w", err)
+	}
+
+	message.SenderID = userID
+	message.SentAt = time.Now()
+
+	err := s.txm.Do(ctx, func(ctx context.Context) error {
+		// Blocks in dialogs are enforced by the checker, since dialogs
+		// with blocked users are blocked along with the blocklist.
+		if message.ChatID.Type == entity.GroupChatType {
+			if err := s.checkGroupSettings(ctx, message.ChatID, userID); err != nil {
+				return err
+			}
+		}
+
+		if err := s.repo.Create(ctx, &message); err != nil {
+			return fmt.Errorf("create message: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return entity.Message{}, fmt.Errorf("call transaction manager: %w", err)
 	}
 
+	if err := s.publisher.Publish(ctx, message); err != nil {
+		return entity.Message{}, fmt.Errorf("publish message: %w", err)
+	}
+	return message, nil
+}
+
+// checkGroupSettings makes sure the group settings allow the user to post.
+// Admins aren't restricted by them. Slow mode takes the turn of the user
+// atomically, so concurrent messages of the same user can't slip through it.
+// It must be called inside the transaction creating the message, so the turn
+// isn't taken by a message which fails to be created.
+func (s *Message) checkGroupSettings(ctx context.Context, chatID entity.ChatID, userID int) error {
+	settings, err := s.groupSettings.GetSettings(ctx, chatID.ID)
+	if err != nil {
+		return fmt.Errorf("get group settings: %w", err)
+	}
+
+	if !settings.OnlyAdminsCanPost && settings.SlowModeInterval == 0 {
+		return nil
+	}
+
+	participant, err := s.participantRepo.Get(ctx, chatID.ID, userID, false)
+	if err != nil {
+		return fmt.Errorf("get group participant: %w", err)
+	}
+
+	if participant.IsAdmin() {
+		return nil
+	}
+
+	if settings.OnlyAdminsCanPost {
+		return fmt.Errorf("%w: only admins can post to the group", entity.ErrForbiddenPerformAction)
+	}
+
+	now := time.Now()
+	lastSentAt, ok, err := s.participantRepo.UpdateLastSentAt(ctx, chatID.ID, userID, now, now.Add(-settings.SlowModeInterval))
+	if err != nil {
+		return fmt.Errorf("update last message sending time: %w", err)
+	}
+
+	if !ok {
+		wait := settings.SlowModeInterval - now.Sub(lastSentAt)
+		return fmt.Errorf("%w: next message can be sent in %s", entity.ErrGroupSlowModeActive, wait.Round(time.Second))
+	}
+	return nil
+}
+
+// CreateService creates a message informing chat participants about some action
+// of the current user, e.g. changes of group membership. Besides the action itself
+// the message has its text, so clients not knowing the action can show it.
+func (s *Message) CreateService(ctx context.Context, chatID entity.ChatID, action entity.ServiceAction) (entity.Message, error) {
+	senderID := ctxutil.UserIDFromContext(ctx).ToInt()
 	message := entity.Message{
-		ChatID:      obj.ChatID,
-		SenderID:    userID,
-		Content:     obj.Content,
-		ContentType: obj.ContentType,
-		SentAt:      time.Now(),
+		ChatID:        chatID,
+		SenderID:      senderID,
+		Content:       serviceActionContent(senderID, action),
+		ContentType:   entity.TextContentType,
+		IsService:     true,
+		ServiceAction: &action,
+		SentAt:        time.Now(),
 	}
 	if err := s.repo.Create(ctx, &message); err != nil {
-		return entity.Message{}, fmt.Errorf("create message: %w", err)
+		return entity.Message{}, fmt.Errorf("create service message: %w", err)
 	}
 
 	if err := s.publisher.Publish(ctx, message); err != nil {
-		return entity.Message{}, fmt.Errorf("publish message: %w", err)
+		return entity.Message{}, fmt.Errorf("publish service message: %w", err)
 	}
 	return message, nil
 }
@@ -89,28 +254,36 @@ type MessageSubscriber interface {
 }
 
 type MessageServeManagerConfig struct {
-	Service          *Message
-	EventConsumer    ParticipantEventConsumer
-	Subscriber       MessageSubscriber
-	GroupRepository  GroupRepository
-	DialogRepository DialogRepository
+	Service           *Message
+	EventConsumer     ParticipantEventConsumer
+	Subscriber        MessageSubscriber
+	GroupRepository   GroupRepository
+	DialogRepository  DialogRepository
+	ChannelRepository ChannelRepository
+	// SettingsRepository provides chats muted by the user, messages of them are served
+	// marked as muted and notices of them aren't served at all.
+	SettingsRepository ChatSettingsRepository
 }
 
 type MessageServeManager struct {
-	msgSrv     *Message
-	eventCons  ParticipantEventConsumer
-	subscriber MessageSubscriber
-	groupRepo  GroupRepository
-	dialogRepo DialogRepository
+	msgSrv       *Message
+	eventCons    ParticipantEventConsumer
+	subscriber   MessageSubscriber
+	groupRepo    GroupRepository
+	dialogRepo   DialogRepository
+	channelRepo  ChannelRepository
+	settingsRepo ChatSettingsRepository
 }
 
 func NewMessageServeManager(conf MessageServeManagerConfig) *MessageServeManager {
 	return &MessageServeManager{
-		msgSrv:     conf.Service,
-		eventCons:  conf.EventConsumer,
-		subscriber: conf.Subscriber,
-		groupRepo:  conf.GroupRepository,
-		dialogRepo: conf.DialogRepository,
+		msgSrv:       conf.Service,
+		eventCons:    conf.EventConsumer,
+		subscriber:   conf.Subscriber,
+		groupRepo:    conf.GroupRepository,
+		dialogRepo:   conf.DialogRepository,
+		channelRepo:  conf.ChannelRepository,
+		settingsRepo: conf.SettingsRepository,
 	}
 }
 
@@ -120,20 +293,29 @@ func (sm *MessageServeManager) BeginServe(ctx context.Context, inCh <-chan dto.M
 		return nil, nil, err
 	}
 
-	outCh, errCh := sm.serve(ctx, chatIDs, inCh)
+	mutedSettings, err := sm.settingsRepo.ListMuted(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("list of muted chat settings: %w", err)
+	}
+
+	muted := make(map[entity.ChatID]entity.ChatSettings, len(mutedSettings))
+	for _, settings := range mutedSettings {
+		muted[settings.ChatID] = settings
+	}
+
+	outCh, errCh := sm.serve(ctx, chatIDs, muted, inCh)
 	return outCh, errCh, nil
 }
 
 //nolint:lll // too long naming
-func (sm *MessageServeManager) serve(ctx context.Context, chatIDs []entity.ChatID, inCh <-chan dto.MessageCreate) (chan entity.Message, chan error) {
+func (sm *MessageServeManager) serve(ctx context.Context, chatIDs []entity.ChatID, muted map[entity.ChatID]entity.ChatSettings, inCh <-chan dto.MessageCreate) (chan entity.Message, chan error) {
 	curUserID := ctxutil.UserIDFromContext(ctx).ToInt()
 	outCh, errCh := make(chan entity.Message), make(chan error)
+	msgCons := sm.subscriber.Subscribe(ctx, chatIDs...)
 
 	go func() {
 		defer close(outCh)
 		defer close(errCh)
-
-		msgCons := sm.subscriber.Subscribe(ctx, chatIDs...)
 		defer msgCons.Close()
 
 		msgCh, msgErrCh := msgCons.BeginConsume(ctx)
@@ -154,14 +336,33 @@ func (sm *MessageServeManager) serve(ctx context.Context, chatIDs []entity.ChatI
 					return
 				}
 
+				msg.Muted = muted[msg.ChatID].IsMuted(time.Now())
 				outCh <- msg
 			case event, ok := <-eventCh:
 				if !ok {
 					return
 				}
 
-				if err := sm.applyEvent(ctx, msgCons, event); err != nil {
-					errCh <- err
+				switch event.Type {
+				case entity.JoinRequested:
+					if !muted[event.ChatID].IsMuted(time.Now()) {
+						outCh <- joinRequestNotice(event)
+					}
+				case entity.ChatSettingsUpdated:
+					settings, err := sm.settingsRepo.Get(ctx, event.ChatID)
+					if err != nil {
+						errCh <- fmt.Errorf("get chat settings: %w", err)
+					} else {
+						muted[event.ChatID] = settings
+					}
+
+					outCh <- settingsNotice(event, entity.ChatSettingsChangedServiceAction)
+				case entity.ChatFoldersUpdated:
+					outCh <- settingsNotice(event, entity.ChatFoldersChangedServiceAction)
+				default:
+					if err := sm.applyEvent(ctx, msgCons, event); err != nil {
+						errCh <- err
+					}
 				}
 			case err := <-msgErrCh:
 				errCh <- err
@@ -175,7 +376,8 @@ func (sm *MessageServeManager) serve(ctx context.Context, chatIDs []entity.ChatI
 }
 
 func (sm *MessageServeManager) listActiveChatIDs(ctx context.Context) ([]entity.ChatID, error) {
-	groupsCh, errCh := make(chan []entity.Group), make(chan error)
+	groupsCh, channelsCh := make(chan []entity.Group, 1), make(chan []entity.Channel, 1)
+	errCh := make(chan error, 2)
 	go func() {
 		groups, groupsErr := sm.groupRepo.List(ctx)
 		if groupsErr != nil {
@@ -185,26 +387,45 @@ func (sm *MessageServeManager) listActiveChatIDs(ctx context.Context) ([]entity.
 
 		groupsCh <- groups
 	}()
+	go func() {
+		channels, channelsErr := sm.channelRepo.List(ctx)
+		if channelsErr != nil {
+			errCh <- fmt.Errorf("list of channels: %w", channelsErr)
+			return
+		}
 
-	dialogs, err := sm.dialogRepo.List(ctx)
+		channelsCh <- channels
+	}()
+
+	// Hidden dialogs and message requests are served too, so new messages reach the user.
+	dialogs, err := sm.dialogRepo.ListAll(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("list of dialogs: %w", err)
 	}
 
-	var groups []entity.Group
-	select {
-	case groups = <-groupsCh:
-	case err = <-errCh:
-		return nil, err
+	var (
+		groups   []entity.Group
+		channels []entity.Channel
+	)
+	for i := 0; i < 2; i++ {
+		select {
+		case groups = <-groupsCh:
+		case channels = <-channelsCh:
+		case err = <-errCh:
+			return nil, err
+		}
 	}
 
-	chatIDs := make([]entity.ChatID, 0, len(dialogs)+len(groups))
+	chatIDs := make([]entity.ChatID, 0, len(dialogs)+len(groups)+len(channels))
 	for _, dialog := range dialogs {
 		chatIDs = append(chatIDs, entity.ChatID{ID: dialog.ID, Type: entity.DialogChatType})
 	}
 	for _, group := range groups {
 		chatIDs = append(chatIDs, entity.ChatID{ID: group.ID, Type: entity.GroupChatType})
 	}
+	for _, channel := range channels {
+		chatIDs = append(chatIDs, entity.ChatID{ID: channel.ID, Type: entity.ChannelChatType})
+	}
 
 	return chatIDs, nil
 }
@@ -223,3 +444,36 @@ func (sm *MessageServeManager) applyEvent(ctx context.Context, cons MessageConsu
 
 	return nil
 }
+
+// joinRequestNotice builds a transient service message which notifies the approver
+// about a join request. It isn't stored, so it has no identity.
+func joinRequestNotice(event entity.ParticipantEvent) entity.Message {
+	action := userServiceAction(entity.JoinRequestedServiceAction, event.InitiatorID)
+
+	return entity.Message{
+		ChatID:        event.ChatID,
+		SenderID:      event.InitiatorID,
+		Content:       serviceActionContent(event.InitiatorID, action),
+		ContentType:   entity.TextContentType,
+		IsService:     true,
+		ServiceAction: &action,
+		SentAt:        time.Now(),
+	}
+}
+
+// settingsNotice builds a transient service message which notifies a device of the user
+// that settings are changed on another one, so the device refetches them. The notice
+// about chat folders has no chat.
+func settingsNotice(event entity.ParticipantEvent, actionType entity.ServiceActionType) entity.Message {
+	action := entity.ServiceAction{Type: actionType}
+
+	return entity.Message{
+		ChatID:        event.ChatID,
+		SenderID:      event.InitiatorID,
+		Content:       serviceActionContent(event.InitiatorID, action),
+		ContentType:   entity.TextContentType,
+		IsService:     true,
+		ServiceAction: &action,
+		SentAt:        time.Now(),
+	}
+}
@@ -0,0 +1,485 @@
+package service_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Chatyx/backend/internal/dto"
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/internal/infrastructure/sysbus/memory"
+	"github.com/Chatyx/backend/internal/service"
+	"github.com/Chatyx/backend/pkg/ctxutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const receiveTimeout = time.Second
+
+type txKey struct{}
+
+// newMockTxManager returns the transaction manager marking the context of the transaction,
+// so calls expected to be made inside the transaction can be matched by inTx.
+func newMockTxManager(t *testing.T) *service.MockTransactionManager {
+	txm := service.NewMockTransactionManager(t)
+	txm.On("Do", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(context.WithValue(ctx, txKey{}, true))
+		}).Maybe()
+
+	return txm
+}
+
+func inTx(ctx context.Context) bool {
+	ok, _ := ctx.Value(txKey{}).(bool)
+	return ok
+}
+
+func TestMessageServeManager_BeginServe(t *testing.T) {
+	groupChatID := entity.ChatID{ID: 1, Type: entity.GroupChatType}
+	dialogChatID := entity.ChatID{ID: 2, Type: entity.DialogChatType}
+	channelChatID := entity.ChatID{ID: 3, Type: entity.ChannelChatType}
+
+	broker := memory.NewBroker()
+	t.Cleanup(func() { _ = broker.Close() })
+
+	chatPubSub := memory.NewMessagePublishSubscriber(broker)
+	pubSub := service.NewChannelFanOut(service.ChannelFanOutConfig{
+		Publisher:           chatPubSub,
+		Subscriber:          chatPubSub,
+		BroadcastPublisher:  chatPubSub,
+		BroadcastSubscriber: chatPubSub,
+	})
+	t.Cleanup(func() { _ = pubSub.Close() })
+	prodCons := memory.NewParticipantEventProduceConsumer(broker)
+
+	repo := service.NewMockMessageRepository(t)
+	repo.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+	checker := service.NewMockInChatChecker(t)
+	checker.On("CheckWrite", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	groupRepo := service.NewMockGroupRepository(t)
+	groupRepo.On("List", mock.Anything).Return([]entity.Group{{ID: groupChatID.ID}}, nil)
+
+	groupSettings := service.NewMockGroupSettingsGetter(t)
+	groupSettings.On("GetSettings", mock.Anything, groupChatID.ID).Return(entity.GroupSettings{}, nil)
+
+	dialogRepo := service.NewMockDialogRepository(t)
+	dialogRepo.On("ListAll", mock.Anything).Return([]entity.Dialog{{ID: dialogChatID.ID}}, nil)
+
+	channelRepo := service.NewMockChannelRepository(t)
+	channelRepo.On("List", mock.Anything).Return([]entity.Channel{{ID: channelChatID.ID}}, nil)
+
+	mutedUntil := time.Now().Add(time.Hour)
+	settingsRepo := service.NewMockChatSettingsRepository(t)
+	settingsRepo.On("ListMuted", mock.Anything).Return(nil, nil)
+	settingsRepo.On("Get", mock.Anything, groupChatID).Return(entity.ChatSettings{
+		ChatID:     groupChatID,
+		MutedUntil: &mutedUntil,
+	}, nil)
+
+	msgService := service.NewMessage(service.MessageConfig{
+		TxManager:     newMockTxManager(t),
+		Repository:    repo,
+		Publisher:     pubSub,
+		Checker:       checker,
+		GroupSettings: groupSettings,
+	})
+	manager := service.NewMessageServeManager(service.MessageServeManagerConfig{
+		Service:            msgService,
+		EventConsumer:      prodCons,
+		Subscriber:         pubSub,
+		GroupRepository:    groupRepo,
+		DialogRepository:   dialogRepo,
+		ChannelRepository:  channelRepo,
+		SettingsRepository: settingsRepo,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	firstCtx := ctxutil.WithUserID(ctx, ctxutil.UserID(strconv.Itoa(1)))
+	firstInCh := make(chan dto.MessageCreate)
+	firstOutCh, _, err := manager.BeginServe(firstCtx, firstInCh)
+	require.NoError(t, err)
+
+	secondCtx := ctxutil.WithUserID(ctx, ctxutil.UserID(strconv.Itoa(2)))
+	secondInCh := make(chan dto.MessageCreate)
+	secondOutCh, _, err := manager.BeginServe(secondCtx, secondInCh)
+	require.NoError(t, err)
+
+	firstInCh <- dto.MessageCreate{
+		ChatID:      groupChatID,
+		Content:     "Hello everyone!",
+		ContentType: entity.TextContentType,
+	}
+
+	for _, outCh := range []<-chan entity.Message{firstOutCh, secondOutCh} {
+		select {
+		case message := <-outCh:
+			assert.Equal(t, groupChatID, message.ChatID)
+			assert.Equal(t, 1, message.SenderID)
+			assert.Equal(t, "Hello everyone!", message.Content)
+			assert.False(t, message.Muted)
+		case <-time.After(receiveTimeout):
+			t.Fatal("Message wasn't delivered to the subscriber")
+		}
+	}
+
+	_, err = msgService.Create(firstCtx, dto.MessageCreate{
+		ChatID:      channelChatID,
+		Content:     "News",
+		ContentType: entity.TextContentType,
+	})
+	require.NoError(t, err)
+
+	for _, outCh := range []<-chan entity.Message{firstOutCh, secondOutCh} {
+		select {
+		case message := <-outCh:
+			assert.Equal(t, channelChatID, message.ChatID)
+			assert.Equal(t, "News", message.Content)
+		case <-time.After(receiveTimeout):
+			t.Fatal("Channel message wasn't delivered to the subscriber")
+		}
+	}
+
+	_, err = msgService.Create(secondCtx, dto.MessageCreate{
+		ChatID:      dialogChatID,
+		Content:     "Hi!",
+		ContentType: entity.TextContentType,
+	})
+	require.NoError(t, err)
+
+	for _, outCh := range []<-chan entity.Message{firstOutCh, secondOutCh} {
+		select {
+		case message := <-outCh:
+			assert.Equal(t, dialogChatID, message.ChatID)
+			assert.Equal(t, 2, message.SenderID)
+		case <-time.After(receiveTimeout):
+			t.Fatal("Message wasn't delivered to the subscriber")
+		}
+	}
+
+	err = prodCons.Produce(ctx, entity.ParticipantEvent{
+		Type:        entity.JoinRequested,
+		ChatID:      groupChatID,
+		UserID:      1,
+		InitiatorID: 3,
+	})
+	require.NoError(t, err)
+
+	select {
+	case message := <-firstOutCh:
+		assert.Equal(t, groupChatID, message.ChatID)
+		assert.Equal(t, 3, message.SenderID)
+		assert.True(t, message.IsService)
+	case <-time.After(receiveTimeout):
+		t.Fatal("Join request notice wasn't delivered to the approver")
+	}
+
+	for _, event := range []entity.ParticipantEvent{
+		{Type: entity.ChatSettingsUpdated, ChatID: groupChatID, UserID: 1, InitiatorID: 1},
+		{Type: entity.JoinRequested, ChatID: groupChatID, UserID: 1, InitiatorID: 3},
+		{Type: entity.ChatFoldersUpdated, UserID: 1, InitiatorID: 1},
+	} {
+		require.NoError(t, prodCons.Produce(ctx, event))
+	}
+
+	// The join request notice of the muted group is skipped.
+	for _, expectedAction := range []entity.ServiceActionType{
+		entity.ChatSettingsChangedServiceAction,
+		entity.ChatFoldersChangedServiceAction,
+	} {
+		select {
+		case message := <-firstOutCh:
+			require.NotNil(t, message.ServiceAction)
+			assert.Equal(t, expectedAction, message.ServiceAction.Type)
+		case <-time.After(receiveTimeout):
+			t.Fatal("Settings notice wasn't delivered to the user")
+		}
+	}
+
+	_, err = msgService.Create(secondCtx, dto.MessageCreate{
+		ChatID:      groupChatID,
+		Content:     "Anybody here?",
+		ContentType: entity.TextContentType,
+	})
+	require.NoError(t, err)
+
+	// Messages of the muted group are still delivered, but marked as muted for the first user only.
+	for outCh, expectedMuted := range map[<-chan entity.Message]bool{firstOutCh: true, secondOutCh: false} {
+		select {
+		case message := <-outCh:
+			assert.Equal(t, "Anybody here?", message.Content)
+			assert.Equal(t, expectedMuted, message.Muted)
+		case <-time.After(receiveTimeout):
+			t.Fatal("Message of the muted group wasn't delivered to the subscriber")
+		}
+	}
+}
+
+func TestMessage_Create_GroupSettings(t *testing.T) {
+	groupChatID := entity.ChatID{ID: 1, Type: entity.GroupChatType}
+
+	testCases := []struct {
+		name          string
+		settings      entity.GroupSettings
+		role          entity.GroupRole
+		lastSentAt    time.Time
+		expectedError error
+	}{
+		{
+			name:          "Only admins can post, member",
+			settings:      entity.GroupSettings{OnlyAdminsCanPost: true},
+			role:          entity.MemberRole,
+			expectedError: entity.ErrForbiddenPerformAction,
+		},
+		{
+			name:     "Only admins can post, admin",
+			settings: entity.GroupSettings{OnlyAdminsCanPost: true},
+			role:     entity.AdminRole,
+		},
+		{
+			name:          "Slow mode, member posted recently",
+			settings:      entity.GroupSettings{SlowModeInterval: time.Minute},
+			role:          entity.ModeratorRole,
+			lastSentAt:    time.Now().Add(-10 * time.Second),
+			expectedError: entity.ErrGroupSlowModeActive,
+		},
+		{
+			name:       "Slow mode, member posted long ago",
+			settings:   entity.GroupSettings{SlowModeInterval: time.Minute},
+			role:       entity.MemberRole,
+			lastSentAt: time.Now().Add(-2 * time.Minute),
+		},
+		{
+			name:       "Slow mode, owner posted recently",
+			settings:   entity.GroupSettings{SlowModeInterval: time.Minute},
+			role:       entity.OwnerRole,
+			lastSentAt: time.Now(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			repo := service.NewMockMessageRepository(t)
+			repo.On("Create", mock.MatchedBy(inTx), mock.Anything).Return(nil).Maybe()
+
+			checker := service.NewMockInChatChecker(t)
+			checker.On("CheckWrite", mock.Anything, groupChatID, 1).Return(nil)
+
+			groupSettings := service.NewMockGroupSettingsGetter(t)
+			groupSettings.On("GetSettings", mock.Anything, groupChatID.ID).Return(testCase.settings, nil)
+
+			participantRepo := service.NewMockGroupParticipantRepository(t)
+			participantRepo.On("Get", mock.Anything, groupChatID.ID, 1, false).Return(entity.GroupParticipant{
+				GroupID: groupChatID.ID,
+				UserID:  1,
+				Role:    testCase.role,
+				Status:  entity.JoinedStatus,
+			}, nil)
+			participantRepo.On("UpdateLastSentAt", mock.MatchedBy(inTx), groupChatID.ID, 1, mock.Anything, mock.Anything).
+				Return(func(_ context.Context, _, _ int, sentAt, since time.Time) (time.Time, bool, error) {
+					if testCase.lastSentAt.After(since) {
+						return testCase.lastSentAt, false, nil
+					}
+					return sentAt, true, nil
+				}).Maybe()
+
+			broker := memory.NewBroker()
+			t.Cleanup(func() { _ = broker.Close() })
+
+			msgService := service.NewMessage(service.MessageConfig{
+				TxManager:             newMockTxManager(t),
+				Repository:            repo,
+				Publisher:             memory.NewMessagePublishSubscriber(broker),
+				Checker:               checker,
+				GroupSettings:         groupSettings,
+				ParticipantRepository: participantRepo,
+			})
+			ctx := ctxutil.WithUserID(context.Background(), "1")
+
+			_, err := msgService.Create(ctx, dto.MessageCreate{
+				ChatID:      groupChatID,
+				Content:     "Hello",
+				ContentType: entity.TextContentType,
+			})
+			if testCase.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestMessage_CreateService(t *testing.T) {
+	groupChatID := entity.ChatID{ID: 1, Type: entity.GroupChatType}
+	action := entity.ServiceAction{
+		Type:   entity.ParticipantKickedServiceAction,
+		Params: map[string]string{entity.UserIDServiceParam: "2"},
+	}
+
+	repo := service.NewMockMessageRepository(t)
+	repo.On("Create", mock.Anything, mock.MatchedBy(func(message *entity.Message) bool {
+		return message.ChatID == groupChatID &&
+			message.SenderID == 1 &&
+			message.IsService &&
+			message.Content == "User 1 kicked user 2" &&
+			assert.ObjectsAreEqual(&action, message.ServiceAction)
+	})).Return(nil)
+
+	broker := memory.NewBroker()
+	t.Cleanup(func() { _ = broker.Close() })
+
+	msgService := service.NewMessage(service.MessageConfig{
+		TxManager:  newMockTxManager(t),
+		Repository: repo,
+		Publisher:  memory.NewMessagePublishSubscriber(broker),
+	})
+	ctx := ctxutil.WithUserID(context.Background(), "1")
+
+	message, err := msgService.CreateService(ctx, groupChatID, action)
+	require.NoError(t, err)
+	assert.Equal(t, entity.ParticipantKickedServiceAction, message.ServiceAction.Type)
+}
+
+func TestMessage_Create_BlockedInDialog(t *testing.T) {
+	dialogChatID := entity.ChatID{ID: 2, Type: entity.DialogChatType}
+
+	// The dialog is blocked along with the blocklist, so the checker denies access to it.
+	checker := service.NewMockInChatChecker(t)
+	checker.On("CheckWrite", mock.Anything, dialogChatID, 1).Return(entity.ErrDialogNotFound)
+
+	msgService := service.NewMessage(service.MessageConfig{
+		TxManager:  newMockTxManager(t),
+		Repository: service.NewMockMessageRepository(t),
+		Checker:    checker,
+	})
+	ctx := ctxutil.WithUserID(context.Background(), "1")
+
+	_, err := msgService.Create(ctx, dto.MessageCreate{
+		ChatID:      dialogChatID,
+		Content:     "Hello",
+		ContentType: entity.TextContentType,
+	})
+	assert.ErrorIs(t, err, entity.ErrDialogNotFound)
+}
+
+func TestMessage_Create_UnverifiedEmail(t *testing.T) {
+	verificationChecker := service.NewMockEmailVerificationChecker(t)
+	verificationChecker.On("IsEmailVerified", mock.Anything, 1).Return(false, nil)
+
+	msgService := service.NewMessage(service.MessageConfig{
+		TxManager:            newMockTxManager(t),
+		Repository:           service.NewMockMessageRepository(t),
+		Checker:              service.NewMockInChatChecker(t),
+		VerificationChecker:  verificationChecker,
+		RequireVerifiedEmail: true,
+	})
+	ctx := ctxutil.WithUserID(context.Background(), "1")
+
+	_, err := msgService.Create(ctx, dto.MessageCreate{
+		ChatID:      entity.ChatID{ID: 2, Type: entity.DialogChatType},
+		Content:     "Hello",
+		ContentType: entity.TextContentType,
+	})
+	assert.ErrorIs(t, err, entity.ErrEmailNotVerified)
+}
+
+func TestMessage_Forward(t *testing.T) {
+	savedChatID := entity.ChatID{ID: 3, Type: entity.DialogChatType}
+	groupChatID := entity.ChatID{ID: 1, Type: entity.GroupChatType}
+	originalAuthorID := 5
+
+	testCases := []struct {
+		name                    string
+		original                entity.Message
+		sourceErr               error
+		getErr                  error
+		expectedForwardedFromID int
+		expectedError           error
+	}{
+		{
+			name: "Successful forwarding",
+			original: entity.Message{
+				ID: 10, ChatID: groupChatID, SenderID: 2,
+				Content: "Hello", ContentType: entity.TextContentType,
+			},
+			expectedForwardedFromID: 2,
+		},
+		{
+			name: "Forwarding of a forwarded message keeps the original author",
+			original: entity.Message{
+				ID: 10, ChatID: groupChatID, SenderID: 2, ForwardedFromID: &originalAuthorID,
+				Content: "Hello", ContentType: entity.TextContentType,
+			},
+			expectedForwardedFromID: originalAuthorID,
+		},
+		{
+			name:          "The current user isn't in the source chat",
+			sourceErr:     entity.ErrGroupNotFound,
+			expectedError: entity.ErrGroupNotFound,
+		},
+		{
+			name:          "Message is not found",
+			getErr:        entity.ErrMessageNotFound,
+			expectedError: entity.ErrMessageNotFound,
+		},
+		{
+			name: "Service message",
+			original: entity.Message{
+				ID: 10, ChatID: groupChatID, SenderID: 2, IsService: true,
+				Content: "User 2 joined the group", ContentType: entity.TextContentType,
+			},
+			expectedError: entity.ErrForbiddenPerformAction,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			checker := service.NewMockInChatChecker(t)
+			checker.On("Check", mock.Anything, groupChatID, 1).Return(testCase.sourceErr)
+			checker.On("CheckWrite", mock.Anything, savedChatID, 1).Return(nil).Maybe()
+
+			repo := service.NewMockMessageRepository(t)
+			repo.On("Get", mock.Anything, groupChatID, 10).Return(testCase.original, testCase.getErr).Maybe()
+			repo.On("Create", mock.Anything, mock.MatchedBy(func(message *entity.Message) bool {
+				return message.ChatID == savedChatID &&
+					message.SenderID == 1 &&
+					message.Content == testCase.original.Content &&
+					message.ForwardedFromID != nil &&
+					*message.ForwardedFromID == testCase.expectedForwardedFromID
+			})).Return(nil).Maybe()
+
+			broker := memory.NewBroker()
+			t.Cleanup(func() { _ = broker.Close() })
+
+			msgService := service.NewMessage(service.MessageConfig{
+				TxManager:  newMockTxManager(t),
+				Repository: repo,
+				Publisher:  memory.NewMessagePublishSubscriber(broker),
+				Checker:    checker,
+			})
+			ctx := ctxutil.WithUserID(context.Background(), "1")
+
+			message, err := msgService.Forward(ctx, dto.MessageForward{
+				ChatID:     savedChatID,
+				FromChatID: groupChatID,
+				MessageID:  10,
+			})
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, err, testCase.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedForwardedFromID, *message.ForwardedFromID)
+			repo.AssertCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
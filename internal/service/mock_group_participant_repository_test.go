@@ -7,6 +7,8 @@ import (
 
 	entity "github.com/Chatyx/backend/internal/entity"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MockGroupParticipantRepository is an autogenerated mock type for the GroupParticipantRepository type
@@ -14,6 +16,34 @@ type MockGroupParticipantRepository struct {
 	mock.Mock
 }
 
+// Count provides a mock function with given fields: ctx, groupID, withLock
+func (_m *MockGroupParticipantRepository) Count(ctx context.Context, groupID int, withLock bool) (int, error) {
+	ret := _m.Called(ctx, groupID, withLock)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Count")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, bool) (int, error)); ok {
+		return rf(ctx, groupID, withLock)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, bool) int); ok {
+		r0 = rf(ctx, groupID, withLock)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, bool) error); ok {
+		r1 = rf(ctx, groupID, withLock)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Create provides a mock function with given fields: ctx, p
 func (_m *MockGroupParticipantRepository) Create(ctx context.Context, p *entity.GroupParticipant) error {
 	ret := _m.Called(ctx, p)
@@ -108,6 +138,41 @@ func (_m *MockGroupParticipantRepository) Update(ctx context.Context, p *entity.
 	return r0
 }
 
+// UpdateLastSentAt provides a mock function with given fields: ctx, groupID, userID, sentAt, since
+func (_m *MockGroupParticipantRepository) UpdateLastSentAt(ctx context.Context, groupID int, userID int, sentAt time.Time, since time.Time) (time.Time, bool, error) {
+	ret := _m.Called(ctx, groupID, userID, sentAt, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLastSentAt")
+	}
+
+	var r0 time.Time
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, time.Time, time.Time) (time.Time, bool, error)); ok {
+		return rf(ctx, groupID, userID, sentAt, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, time.Time, time.Time) time.Time); ok {
+		r0 = rf(ctx, groupID, userID, sentAt, since)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, time.Time, time.Time) bool); ok {
+		r1 = rf(ctx, groupID, userID, sentAt, since)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int, int, time.Time, time.Time) error); ok {
+		r2 = rf(ctx, groupID, userID, sentAt, since)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // NewMockGroupParticipantRepository creates a new instance of MockGroupParticipantRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockGroupParticipantRepository(t interface {
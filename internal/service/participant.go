@@ -4,9 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/Chatyx/backend/internal/dto"
 	"github.com/Chatyx/backend/internal/entity"
 	"github.com/Chatyx/backend/pkg/ctxutil"
+	"github.com/Chatyx/backend/pkg/log"
 )
 
 type GroupParticipantFunc func(p *entity.GroupParticipant) error
@@ -17,6 +20,8 @@ type GroupParticipantRepository interface {
 	Get(ctx context.Context, groupID, userID int, withLock bool) (entity.GroupParticipant, error)
 	Create(ctx context.Context, p *entity.GroupParticipant) error
 	Update(ctx context.Context, p *entity.GroupParticipant) error
+	Count(ctx context.Context, groupID int, withLock bool) (int, error)
+	UpdateLastSentAt(ctx context.Context, groupID, userID int, sentAt, since time.Time) (time.Time, bool, error)
 }
 
 //go:generate mockery --inpackage --testonly --case underscore --name GroupParticipantEventProducer
@@ -24,32 +29,69 @@ type GroupParticipantEventProducer interface {
 	Produce(ctx context.Context, event entity.ParticipantEvent) error
 }
 
+//go:generate mockery --inpackage --testonly --case underscore --name PublicGroupRepository
+type PublicGroupRepository interface {
+	GetByUname(ctx context.Context, uname string) (entity.Group, error)
+}
+
+//go:generate mockery --inpackage --testonly --case underscore --name ServiceMessageCreator
+type ServiceMessageCreator interface {
+	CreateService(ctx context.Context, chatID entity.ChatID, action entity.ServiceAction) (entity.Message, error)
+}
+
 //go:generate mockery --inpackage --testonly --case underscore --name TransactionManager
 type TransactionManager interface {
 	Do(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
+const defaultMaxGroupParticipants = 100
+
 type StatusMatrix interface {
 	IsCorrectTransit(from, to entity.GroupParticipantStatus) bool
 }
 
 type GroupParticipantConfig struct {
-	TxManager     TransactionManager
-	Repository    GroupParticipantRepository
-	EventProducer GroupParticipantEventProducer
+	TxManager            TransactionManager
+	Repository           GroupParticipantRepository
+	GroupRepository      PublicGroupRepository
+	SettingsRepository   GroupSettingsRepository
+	InviteLinkRepository GroupInviteLinkRepository
+	EventProducer        GroupParticipantEventProducer
+	MessageCreator       ServiceMessageCreator
+	AuditLogRepository   GroupAuditLogRepository
+	BlockChecker         UserBlockChecker
+	MaxParticipants      int
 }
 
 type GroupParticipant struct {
-	txm  TransactionManager
-	repo GroupParticipantRepository
-	prod GroupParticipantEventProducer
+	txm             TransactionManager
+	repo            GroupParticipantRepository
+	groupRepo       PublicGroupRepository
+	settingsRepo    GroupSettingsRepository
+	linkRepo        GroupInviteLinkRepository
+	prod            GroupParticipantEventProducer
+	msgCreator      ServiceMessageCreator
+	auditRepo       GroupAuditLogRepository
+	blockChecker    UserBlockChecker
+	maxParticipants int
 }
 
 func NewGroupParticipant(conf GroupParticipantConfig) *GroupParticipant {
+	if conf.MaxParticipants == 0 {
+		conf.MaxParticipants = defaultMaxGroupParticipants
+	}
+
 	return &GroupParticipant{
-		txm:  conf.TxManager,
-		repo: conf.Repository,
-		prod: conf.EventProducer,
+		txm:             conf.TxManager,
+		repo:            conf.Repository,
+		groupRepo:       conf.GroupRepository,
+		settingsRepo:    conf.SettingsRepository,
+		linkRepo:        conf.InviteLinkRepository,
+		prod:            conf.EventProducer,
+		msgCreator:      conf.MessageCreator,
+		auditRepo:       conf.AuditLogRepository,
+		blockChecker:    conf.BlockChecker,
+		maxParticipants: conf.MaxParticipants,
 	}
 }
 
@@ -77,7 +119,7 @@ func (p *GroupParticipant) List(ctx context.Context, groupID int) ([]entity.Grou
 
 func (p *GroupParticipant) Get(ctx context.Context, groupID, userID int) (entity.GroupParticipant, error) {
 	curUserID := ctxutil.UserIDFromContext(ctx).ToInt()
-	if err := p.checkPermission(ctx, groupID, curUserID, false); err != nil {
+	if _, err := p.checkPermission(ctx, groupID, curUserID); err != nil {
 		return entity.GroupParticipant{}, fmt.Errorf("check permission: %w", err)
 	}
 
@@ -90,20 +132,38 @@ func (p *GroupParticipant) Get(ctx context.Context, groupID, userID int) (entity
 
 func (p *GroupParticipant) Invite(ctx context.Context, groupID, userID int) (entity.GroupParticipant, error) {
 	curUserID := ctxutil.UserIDFromContext(ctx).ToInt()
-	if err := p.checkPermission(ctx, groupID, curUserID, true); err != nil {
+	if err := p.checkInvitePermission(ctx, groupID, curUserID); err != nil {
 		return entity.GroupParticipant{}, fmt.Errorf("check permission: %w", err)
 	}
 
+	if err := p.checkInviteBlocked(ctx, curUserID, userID); err != nil {
+		return entity.GroupParticipant{}, err
+	}
+
 	invitedParticipant := entity.GroupParticipant{
 		GroupID: groupID,
 		UserID:  userID,
+		Role:    entity.MemberRole,
 		Status:  entity.JoinedStatus,
 	}
-	if err := p.repo.Create(ctx, &invitedParticipant); err != nil {
-		return entity.GroupParticipant{}, fmt.Errorf("create participant: %w", err)
+
+	err := p.txm.Do(ctx, func(ctx context.Context) error {
+		if err := p.checkLimit(ctx, groupID); err != nil {
+			return err
+		}
+
+		if err := p.repo.Create(ctx, &invitedParticipant); err != nil {
+			return fmt.Errorf("create participant: %w", err)
+		}
+
+		return p.recordAudit(ctx, groupID, userID, entity.ParticipantInvitedAuditAction,
+			nil, participantAuditState(invitedParticipant))
+	})
+	if err != nil {
+		return entity.GroupParticipant{}, fmt.Errorf("call transaction manager: %w", err)
 	}
 
-	err := p.prod.Produce(ctx, entity.ParticipantEvent{
+	err = p.prod.Produce(ctx, entity.ParticipantEvent{
 		Type: entity.AddedParticipant,
 		ChatID: entity.ChatID{
 			ID:   groupID,
@@ -115,48 +175,484 @@ func (p *GroupParticipant) Invite(ctx context.Context, groupID, userID int) (ent
 		return entity.GroupParticipant{}, fmt.Errorf("produce group participant event: %w", err)
 	}
 
+	p.createServiceMessage(ctx, groupID, userServiceAction(entity.ParticipantInvitedServiceAction, userID))
+
 	return invitedParticipant, nil
 }
 
-func (p *GroupParticipant) UpdateStatus(ctx context.Context, groupID, userID int, status entity.GroupParticipantStatus) error {
+// checkInviteBlocked makes sure the invited user hasn't blocked the inviting one.
+func (p *GroupParticipant) checkInviteBlocked(ctx context.Context, curUserID, userID int) error {
+	blocked, err := p.blockChecker.Exists(ctx, userID, curUserID)
+	if err != nil {
+		return fmt.Errorf("check user block: %w", err)
+	}
+	if blocked {
+		return fmt.Errorf("%w: user %d has blocked user %d", entity.ErrUserBlocked, userID, curUserID)
+	}
+
+	return nil
+}
+
+// InviteMany invites several users at once. Users who can't be invited are reported
+// in the results instead of failing the whole invite.
+func (p *GroupParticipant) InviteMany(ctx context.Context, groupID int, userIDs []int) ([]entity.GroupInviteResult, error) {
+	curUserID := ctxutil.UserIDFromContext(ctx).ToInt()
+	if err := p.checkInvitePermission(ctx, groupID, curUserID); err != nil {
+		return nil, fmt.Errorf("check permission: %w", err)
+	}
+
+	var results []entity.GroupInviteResult
+
+	err := p.txm.Do(ctx, func(ctx context.Context) error {
+		results = make([]entity.GroupInviteResult, 0, len(userIDs))
+
+		count, err := p.repo.Count(ctx, groupID, true)
+		if err != nil {
+			return fmt.Errorf("count group participants: %w", err)
+		}
+
+		seen := make(map[int]bool, len(userIDs))
+		for _, userID := range userIDs {
+			if seen[userID] {
+				continue
+			}
+			seen[userID] = true
+
+			result := entity.GroupInviteResult{UserID: userID}
+			if count >= p.maxParticipants {
+				result.Status = entity.LimitReachedInviteStatus
+				results = append(results, result)
+				continue
+			}
+
+			if err = p.checkInviteBlocked(ctx, curUserID, userID); err != nil {
+				if !errors.Is(err, entity.ErrUserBlocked) {
+					return err
+				}
+
+				result.Status = entity.BlockedInviteStatus
+				results = append(results, result)
+				continue
+			}
+
+			// Every participant is created in a nested transaction,
+			// so a failed one doesn't abort the others.
+			err = p.txm.Do(ctx, func(ctx context.Context) error {
+				participant := entity.GroupParticipant{
+					GroupID: groupID,
+					UserID:  userID,
+					Role:    entity.MemberRole,
+					Status:  entity.JoinedStatus,
+				}
+				if err := p.repo.Create(ctx, &participant); err != nil {
+					return err
+				}
+
+				return p.recordAudit(ctx, groupID, userID, entity.ParticipantInvitedAuditAction,
+					nil, participantAuditState(participant))
+			})
+
+			switch {
+			case err == nil:
+				result.Status = entity.AddedInviteStatus
+				count++
+			case errors.Is(err, entity.ErrSuchGroupParticipantAlreadyExists):
+				result.Status = entity.AlreadyExistsInviteStatus
+			case errors.Is(err, entity.ErrAddNonExistentUserToGroup):
+				result.Status = entity.NonExistentUserInviteStatus
+			default:
+				return fmt.Errorf("create participant: %w", err)
+			}
+
+			results = append(results, result)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("call transaction manager: %w", err)
+	}
+
+	var invitedUserIDs []int
+	for _, result := range results {
+		if result.Status != entity.AddedInviteStatus {
+			continue
+		}
+
+		err = p.prod.Produce(ctx, entity.ParticipantEvent{
+			Type: entity.AddedParticipant,
+			ChatID: entity.ChatID{
+				ID:   groupID,
+				Type: entity.GroupChatType,
+			},
+			UserID: result.UserID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("produce group participant event: %w", err)
+		}
+
+		invitedUserIDs = append(invitedUserIDs, result.UserID)
+	}
+
+	if len(invitedUserIDs) != 0 {
+		action := usersServiceAction(entity.ParticipantInvitedServiceAction, invitedUserIDs)
+		p.createServiceMessage(ctx, groupID, action)
+	}
+
+	return results, nil
+}
+
+// checkLimit makes sure one more participant can be added to the group. It must be called
+// inside a transaction, so the group is locked until the participant is added.
+func (p *GroupParticipant) checkLimit(ctx context.Context, groupID int) error {
+	count, err := p.repo.Count(ctx, groupID, true)
+	if err != nil {
+		return fmt.Errorf("count group participants: %w", err)
+	}
+
+	if count >= p.maxParticipants {
+		return fmt.Errorf("%w: group has %d participants", entity.ErrGroupParticipantLimitReached, count)
+	}
+	return nil
+}
+
+// Join adds the current user to a group by an invite link token or by a public group handle.
+// If the invite link requires approval, the participant is pending until an admin reviews the request.
+func (p *GroupParticipant) Join(ctx context.Context, code string) (entity.GroupParticipant, error) {
+	var participant entity.GroupParticipant
+
+	curUserID := ctxutil.UserIDFromContext(ctx).ToInt()
+
+	err := p.txm.Do(ctx, func(ctx context.Context) error {
+		groupID, requiresApproval, err := p.resolveJoinCode(ctx, code)
+		if err != nil {
+			return err
+		}
+
+		status := entity.JoinedStatus
+		if requiresApproval {
+			status = entity.PendingStatus
+		}
+
+		participant, err = p.repo.Get(ctx, groupID, curUserID, true)
+		if err != nil {
+			if !errors.Is(err, entity.ErrGroupParticipantNotFound) {
+				return fmt.Errorf("get group participant: %w", err)
+			}
+
+			if status == entity.JoinedStatus {
+				if err = p.checkLimit(ctx, groupID); err != nil {
+					return err
+				}
+			}
+
+			participant = entity.GroupParticipant{
+				GroupID: groupID,
+				UserID:  curUserID,
+				Role:    entity.MemberRole,
+				Status:  status,
+			}
+			if err = p.repo.Create(ctx, &participant); err != nil {
+				return fmt.Errorf("create group participant: %w", err)
+			}
+			return nil
+		}
+
+		switch participant.Status {
+		case entity.JoinedStatus, entity.MutedStatus:
+			return fmt.Errorf("%w: current user is already in the group", entity.ErrSuchGroupParticipantAlreadyExists)
+		case entity.PendingStatus:
+			return fmt.Errorf("%w: current user has already requested to join the group", entity.ErrSuchGroupParticipantAlreadyExists)
+		}
+
+		// A join request can be filed only here, so the transition from left to pending
+		// isn't a part of the self action matrix, unlike joining the group again.
+		canJoin := participant.Status == entity.LeftStatus
+		if status == entity.JoinedStatus {
+			canJoin = entity.MxActionOnOneself.IsCorrectTransit(participant.Status, status)
+		}
+		if !canJoin {
+			return fmt.Errorf("%w: participant with status %s can't join the group", entity.ErrForbiddenPerformAction, participant.Status)
+		}
+
+		if status == entity.JoinedStatus {
+			if err = p.checkLimit(ctx, groupID); err != nil {
+				return err
+			}
+		}
+
+		participant.Status = status
+		if err = p.repo.Update(ctx, &participant); err != nil {
+			return fmt.Errorf("update group participant: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return entity.GroupParticipant{}, fmt.Errorf("call transaction manager: %w", err)
+	}
+
+	if participant.Status == entity.PendingStatus {
+		if err = p.notifyApprovers(ctx, participant.GroupID); err != nil {
+			return entity.GroupParticipant{}, err
+		}
+		return participant, nil
+	}
+
+	err = p.prod.Produce(ctx, entity.ParticipantEvent{
+		Type: entity.AddedParticipant,
+		ChatID: entity.ChatID{
+			ID:   participant.GroupID,
+			Type: entity.GroupChatType,
+		},
+		UserID: curUserID,
+	})
+	if err != nil {
+		return entity.GroupParticipant{}, fmt.Errorf("produce group participant event: %w", err)
+	}
+
+	action := userServiceAction(entity.ParticipantJoinedServiceAction, curUserID)
+	p.createServiceMessage(ctx, participant.GroupID, action)
+
+	return participant, nil
+}
+
+// resolveJoinCode returns the group identity for the invite link token or the public group handle
+// and whether joining requires approval. The use of the invite link is counted, so it must be called
+// inside a transaction.
+func (p *GroupParticipant) resolveJoinCode(ctx context.Context, code string) (int, bool, error) {
+	link, err := p.linkRepo.Get(ctx, code, true)
+	if err != nil {
+		if !errors.Is(err, entity.ErrGroupInviteLinkNotFound) {
+			return 0, false, fmt.Errorf("get group invite link: %w", err)
+		}
+
+		group, err := p.groupRepo.GetByUname(ctx, code)
+		if err != nil {
+			return 0, false, fmt.Errorf("get group by uname: %w", err)
+		}
+		return group.ID, false, nil
+	}
+
+	if !link.IsActive(time.Now()) {
+		return 0, false, fmt.Errorf("%w: token %s", entity.ErrInactiveGroupInviteLink, link.Token)
+	}
+
+	link.Uses++
+	if err = p.linkRepo.Update(ctx, &link); err != nil {
+		return 0, false, fmt.Errorf("update group invite link: %w", err)
+	}
+	return link.GroupID, link.RequiresApproval, nil
+}
+
+// notifyApprovers sends an event about the join request of the current user
+// to every participant who can approve it.
+func (p *GroupParticipant) notifyApprovers(ctx context.Context, groupID int) error {
+	participants, err := p.repo.List(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("list of group participants: %w", err)
+	}
+
+	curUserID := ctxutil.UserIDFromContext(ctx).ToInt()
+	for _, participant := range participants {
+		if !participant.IsInGroup() || !participant.Can(entity.InvitePermission) {
+			continue
+		}
+
+		err = p.prod.Produce(ctx, entity.ParticipantEvent{
+			Type: entity.JoinRequested,
+			ChatID: entity.ChatID{
+				ID:   groupID,
+				Type: entity.GroupChatType,
+			},
+			UserID:      participant.UserID,
+			InitiatorID: curUserID,
+		})
+		if err != nil {
+			return fmt.Errorf("produce join request event: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ListJoinRequests lists participants waiting for approval to join the group.
+func (p *GroupParticipant) ListJoinRequests(ctx context.Context, groupID int) ([]entity.GroupParticipant, error) {
+	curUserID := ctxutil.UserIDFromContext(ctx).ToInt()
+	if _, err := p.checkPermission(ctx, groupID, curUserID, entity.InvitePermission); err != nil {
+		return nil, fmt.Errorf("check permission: %w", err)
+	}
+
+	participants, err := p.repo.List(ctx, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("list of group participants: %w", err)
+	}
+
+	requests := make([]entity.GroupParticipant, 0, len(participants))
+	for _, participant := range participants {
+		if participant.Status == entity.PendingStatus {
+			requests = append(requests, participant)
+		}
+	}
+	return requests, nil
+}
+
+func (p *GroupParticipant) ApproveJoinRequest(ctx context.Context, groupID, userID int) error {
+	return p.reviewJoinRequest(ctx, groupID, userID, entity.JoinedStatus)
+}
+
+func (p *GroupParticipant) RejectJoinRequest(ctx context.Context, groupID, userID int) error {
+	return p.reviewJoinRequest(ctx, groupID, userID, entity.LeftStatus)
+}
+
+func (p *GroupParticipant) reviewJoinRequest(ctx context.Context, groupID, userID int, status entity.GroupParticipantStatus) error {
+	curUserID := ctxutil.UserIDFromContext(ctx).ToInt()
+	if _, err := p.checkPermission(ctx, groupID, curUserID, entity.InvitePermission); err != nil {
+		return fmt.Errorf("check permission: %w", err)
+	}
+
+	err := p.txm.Do(ctx, func(ctx context.Context) error {
+		participant, err := p.repo.Get(ctx, groupID, userID, true)
+		if err != nil {
+			return fmt.Errorf("get group participant: %w", err)
+		}
+
+		if participant.Status != entity.PendingStatus {
+			return fmt.Errorf("%w: there is no join request of the participant", entity.ErrGroupParticipantNotFound)
+		}
+
+		if !entity.MxActionOnSomeone.IsCorrectTransit(participant.Status, status) {
+			return fmt.Errorf("%w: transit from %s to %s", entity.ErrIncorrectGroupParticipantStatusTransit, participant.Status, status)
+		}
+
+		if status == entity.JoinedStatus {
+			if err = p.checkLimit(ctx, groupID); err != nil {
+				return err
+			}
+		}
+
+		before := participantAuditState(participant)
+
+		participant.Status = status
+		if err = p.repo.Update(ctx, &participant); err != nil {
+			return fmt.Errorf("update group participant: %w", err)
+		}
+
+		return p.recordAudit(ctx, groupID, userID, entity.ParticipantStatusChangedAuditAction,
+			before, participantAuditState(participant))
+	})
+	if err != nil {
+		return fmt.Errorf("call transaction manager: %w", err)
+	}
+
+	if status != entity.JoinedStatus {
+		return nil
+	}
+
+	err = p.prod.Produce(ctx, entity.ParticipantEvent{
+		Type: entity.AddedParticipant,
+		ChatID: entity.ChatID{
+			ID:   groupID,
+			Type: entity.GroupChatType,
+		},
+		UserID: userID,
+	})
+	if err != nil {
+		return fmt.Errorf("produce group participant event: %w", err)
+	}
+
+	p.createServiceMessage(ctx, groupID, userServiceAction(entity.JoinRequestApprovedServiceAction, userID))
+	return nil
+}
+
+// UpdateStatus changes the status of the participant. Participants can be muted or banned
+// permanently or until the specified time, expired restrictions are lifted by RestrictionLifter.
+func (p *GroupParticipant) UpdateStatus(ctx context.Context, obj dto.GroupParticipantStatusUpdate) error {
+	var curParticipant entity.GroupParticipant
+
+	groupID, userID, status := obj.GroupID, obj.UserID, obj.Status
 	statusMatrix := entity.MxActionOnOneself
 	curUserID := ctxutil.UserIDFromContext(ctx).ToInt()
 	actionOnSomeone := curUserID != userID
 
 	if actionOnSomeone {
-		if err := p.checkPermission(ctx, groupID, curUserID, true); err != nil {
+		permission := entity.KickPermission
+		if status == entity.JoinedStatus {
+			permission = entity.InvitePermission
+		}
+
+		var err error
+		if curParticipant, err = p.checkPermission(ctx, groupID, curUserID, permission); err != nil {
 			return fmt.Errorf("check permission: %w", err)
 		}
 
 		statusMatrix = entity.MxActionOnSomeone
 	}
 
+	var (
+		newOwnerID int
+		prevStatus entity.GroupParticipantStatus
+	)
+
 	err := p.txm.Do(ctx, func(ctx context.Context) error {
 		participant, err := p.repo.Get(ctx, groupID, userID, true)
 		if err != nil {
 			return fmt.Errorf("get group participant: %w", err)
 		}
+		prevStatus = participant.Status
+		before := participantAuditState(participant)
+
+		if actionOnSomeone && !curParticipant.Role.IsHigherThan(participant.Role) {
+			return fmt.Errorf("%w: participant with role %s can't be managed by %s", entity.ErrForbiddenPerformAction, participant.Role, curParticipant.Role)
+		}
 
 		if !statusMatrix.IsCorrectTransit(participant.Status, status) {
 			return fmt.Errorf("%w: transit from %s to %s", entity.ErrIncorrectGroupParticipantStatusTransit, participant.Status, status)
 		}
 
+		if !participant.IsInGroup() && status.IsInGroup() {
+			if err = p.checkLimit(ctx, groupID); err != nil {
+				return err
+			}
+		}
+
+		if participant.Role == entity.OwnerRole {
+			if newOwnerID, err = p.passOwnership(ctx, groupID); err != nil {
+				return err
+			}
+		}
+
+		// Roles aren't kept for those who are out of the group or restricted, so
+		// a participant returns to the group as an ordinary member.
+		if status != entity.JoinedStatus {
+			participant.Role = entity.MemberRole
+		}
+
+		participant.RestrictedUntil = nil
+		if status.IsRestriction() {
+			participant.RestrictedUntil = obj.RestrictedUntil
+		}
+
 		participant.Status = status
 		if err = p.repo.Update(ctx, &participant); err != nil {
 			return fmt.Errorf("update group participant: %w", err)
 		}
-		return nil
+
+		// Leaving and returning on one's own aren't administrative actions.
+		if !actionOnSomeone {
+			return nil
+		}
+		return p.recordAudit(ctx, groupID, userID, entity.ParticipantStatusChangedAuditAction,
+			before, participantAuditState(participant))
 	})
 	if err != nil {
 		return fmt.Errorf("call transaction manager: %w", err)
 	}
 
-	var eventType entity.ParticipantEventType
-	switch status {
-	case entity.JoinedStatus:
-		eventType = entity.AddedParticipant
-	case entity.KickedStatus, entity.LeftStatus:
-		eventType = entity.RemovedParticipant
+	eventType, ok := statusChangeEventType(prevStatus, status)
+	// Nothing to notify about if neither membership nor restrictions of the participant
+	// are changed, e.g. a pending join request is withdrawn.
+	if !ok {
+		return nil
 	}
 
 	err = p.prod.Produce(ctx, entity.ParticipantEvent{
@@ -171,24 +667,270 @@ func (p *GroupParticipant) UpdateStatus(ctx context.Context, groupID, userID int
 		return fmt.Errorf("produce group participant event: %w", err)
 	}
 
+	actions := []entity.ServiceAction{statusChangeAction(curUserID, userID, prevStatus, status, obj.RestrictedUntil)}
+	if newOwnerID != 0 {
+		actions = append(actions, userServiceAction(entity.OwnershipPassedServiceAction, newOwnerID))
+	}
+
+	for _, action := range actions {
+		p.createServiceMessage(ctx, groupID, action)
+	}
+
 	return nil
 }
 
-func (p *GroupParticipant) checkPermission(ctx context.Context, groupID, userID int, checkAdmin bool) error {
-	curParticipant, err := p.repo.Get(ctx, groupID, userID, false)
+func statusChangeEventType(from, to entity.GroupParticipantStatus) (entity.ParticipantEventType, bool) {
+	switch {
+	case !from.IsInGroup() && to.IsInGroup():
+		return entity.AddedParticipant, true
+	case from.IsInGroup() && !to.IsInGroup():
+		return entity.RemovedParticipant, true
+	case from.IsRestriction() || to.IsRestriction():
+		return entity.RestrictedParticipant, true
+	}
+	return "", false
+}
+
+// TransferOwnership makes the participant the owner of the group,
+// the current owner becomes an admin.
+func (p *GroupParticipant) TransferOwnership(ctx context.Context, groupID, userID int) error {
+	curUserID := ctxutil.UserIDFromContext(ctx).ToInt()
+	if curUserID == userID {
+		return fmt.Errorf("%w: participant is already the owner", entity.ErrIncorrectGroupParticipantRoleChange)
+	}
+
+	if _, err := p.checkPermission(ctx, groupID, curUserID); err != nil {
+		return fmt.Errorf("check permission: %w", err)
+	}
+
+	err := p.txm.Do(ctx, func(ctx context.Context) error {
+		curParticipant, err := p.repo.Get(ctx, groupID, curUserID, true)
+		if err != nil {
+			return fmt.Errorf("get current group participant: %w", err)
+		}
+
+		if curParticipant.Role != entity.OwnerRole {
+			return fmt.Errorf("%w: current participant isn't the owner of the group", entity.ErrForbiddenPerformAction)
+		}
+
+		participant, err := p.repo.Get(ctx, groupID, userID, true)
+		if err != nil {
+			return fmt.Errorf("get group participant: %w", err)
+		}
+
+		if !participant.IsInGroup() {
+			return fmt.Errorf("%w: participant isn't in the group", entity.ErrGroupParticipantNotFound)
+		}
+
+		curBefore, before := participantAuditState(curParticipant), participantAuditState(participant)
+		curParticipant.Role = entity.AdminRole
+		participant.Role = entity.OwnerRole
+
+		for _, gp := range []*entity.GroupParticipant{&curParticipant, &participant} {
+			if err = p.repo.Update(ctx, gp); err != nil {
+				return fmt.Errorf("update group participant: %w", err)
+			}
+		}
+
+		err = p.recordAudit(ctx, groupID, curUserID, entity.ParticipantRoleChangedAuditAction,
+			curBefore, participantAuditState(curParticipant))
+		if err != nil {
+			return err
+		}
+		return p.recordAudit(ctx, groupID, userID, entity.ParticipantRoleChangedAuditAction,
+			before, participantAuditState(participant))
+	})
+	if err != nil {
+		return fmt.Errorf("call transaction manager: %w", err)
+	}
+
+	p.createServiceMessage(ctx, groupID, userServiceAction(entity.OwnershipTransferredServiceAction, userID))
+	return nil
+}
+
+// passOwnership promotes the longest-standing admin to the owner when the owner
+// is going out of the group. The owner can't leave if there are no admins left.
+func (p *GroupParticipant) passOwnership(ctx context.Context, groupID int) (int, error) {
+	participants, err := p.repo.List(ctx, groupID)
+	if err != nil {
+		return 0, fmt.Errorf("list of group participants: %w", err)
+	}
+
+	var successor *entity.GroupParticipant
+	for i := range participants {
+		participant := &participants[i]
+		if !participant.IsInGroup() || participant.Role != entity.AdminRole {
+			continue
+		}
+
+		if successor == nil || participant.JoinedAt.Before(successor.JoinedAt) {
+			successor = participant
+		}
+	}
+
+	if successor == nil {
+		return 0, fmt.Errorf("%w: there are no admins to pass ownership", entity.ErrLastGroupOwnerLeaving)
+	}
+
+	before := participantAuditState(*successor)
+
+	successor.Role = entity.OwnerRole
+	if err = p.repo.Update(ctx, successor); err != nil {
+		return 0, fmt.Errorf("update group participant: %w", err)
+	}
+
+	err = p.recordAudit(ctx, groupID, successor.UserID, entity.ParticipantRoleChangedAuditAction,
+		before, participantAuditState(*successor))
+	if err != nil {
+		return 0, err
+	}
+	return successor.UserID, nil
+}
+
+func (p *GroupParticipant) createServiceMessage(ctx context.Context, groupID int, action entity.ServiceAction) {
+	createServiceMessage(ctx, p.msgCreator, entity.ChatID{ID: groupID, Type: entity.GroupChatType}, action)
+}
+
+// createServiceMessage creates the service message after the action is committed.
+// The action can't be undone at that point, so a failure is only logged
+// instead of reporting the whole action as failed.
+func createServiceMessage(ctx context.Context, creator ServiceMessageCreator, chatID entity.ChatID, action entity.ServiceAction) {
+	if _, err := creator.CreateService(ctx, chatID, action); err != nil {
+		log.FromContext(ctx).WithError(err).Warnf("Failed to create service message %s", action.Type)
+	}
+}
+
+// Promote raises the role of the participant. Only participants having the promote
+// permission can do that, and they can't grant roles equal to or higher than their own.
+func (p *GroupParticipant) Promote(ctx context.Context, groupID, userID int, role entity.GroupRole) error {
+	return p.changeRole(ctx, groupID, userID, role, true)
+}
+
+// Demote lowers the role of the participant. The same restrictions as for Promote are applied.
+func (p *GroupParticipant) Demote(ctx context.Context, groupID, userID int, role entity.GroupRole) error {
+	return p.changeRole(ctx, groupID, userID, role, false)
+}
+
+func (p *GroupParticipant) changeRole(ctx context.Context, groupID, userID int, role entity.GroupRole, raise bool) error {
+	curUserID := ctxutil.UserIDFromContext(ctx).ToInt()
+	if curUserID == userID {
+		return fmt.Errorf("%w: participant can't change own role", entity.ErrForbiddenPerformAction)
+	}
+
+	curParticipant, err := p.checkPermission(ctx, groupID, curUserID, entity.PromotePermission)
+	if err != nil {
+		return fmt.Errorf("check permission: %w", err)
+	}
+
+	if !curParticipant.Role.IsHigherThan(role) {
+		return fmt.Errorf("%w: %s can't grant role %s", entity.ErrForbiddenPerformAction, curParticipant.Role, role)
+	}
+
+	err = p.txm.Do(ctx, func(ctx context.Context) error {
+		participant, err := p.repo.Get(ctx, groupID, userID, true)
+		if err != nil {
+			return fmt.Errorf("get group participant: %w", err)
+		}
+
+		if !participant.IsInGroup() {
+			return fmt.Errorf("%w: participant isn't in the group", entity.ErrGroupParticipantNotFound)
+		}
+		if !curParticipant.Role.IsHigherThan(participant.Role) {
+			return fmt.Errorf("%w: participant with role %s can't be managed by %s", entity.ErrForbiddenPerformAction, participant.Role, curParticipant.Role)
+		}
+
+		isCorrectChange := role.IsHigherThan(participant.Role)
+		if !raise {
+			isCorrectChange = participant.Role.IsHigherThan(role)
+		}
+		if !isCorrectChange {
+			return fmt.Errorf("%w: from %s to %s", entity.ErrIncorrectGroupParticipantRoleChange, participant.Role, role)
+		}
+
+		before := participantAuditState(participant)
+
+		participant.Role = role
+		if err = p.repo.Update(ctx, &participant); err != nil {
+			return fmt.Errorf("update group participant: %w", err)
+		}
+
+		return p.recordAudit(ctx, groupID, userID, entity.ParticipantRoleChangedAuditAction,
+			before, participantAuditState(participant))
+	})
+	if err != nil {
+		return fmt.Errorf("call transaction manager: %w", err)
+	}
+
+	return nil
+}
+
+func (p *GroupParticipant) recordAudit(
+	ctx context.Context,
+	groupID, userID int,
+	action entity.GroupAuditAction,
+	before, after map[string]any,
+) error {
+	return recordGroupAudit(ctx, p.auditRepo, groupID, &userID, action, before, after)
+}
+
+func (p *GroupParticipant) checkPermission(ctx context.Context, groupID, userID int, permissions ...entity.GroupPermission) (entity.GroupParticipant, error) {
+	return checkGroupPermission(ctx, p.repo, groupID, userID, permissions...)
+}
+
+// checkInvitePermission makes sure the user can invite to the group. Joined participants
+// without the invite permission can do it as well if the group settings allow members to invite.
+func (p *GroupParticipant) checkInvitePermission(ctx context.Context, groupID, userID int) error {
+	curParticipant, err := p.checkPermission(ctx, groupID, userID)
+	if err != nil {
+		return err
+	}
+
+	if curParticipant.Can(entity.InvitePermission) {
+		return nil
+	}
+
+	if curParticipant.Status == entity.JoinedStatus {
+		var settings entity.GroupSettings
+
+		settings, err = p.settingsRepo.GetSettings(ctx, groupID, false)
+		if err != nil {
+			return fmt.Errorf("get group settings: %w", err)
+		}
+
+		if settings.MembersCanInvite {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: current participant with role %s doesn't have %s permission",
+		entity.ErrForbiddenPerformAction, curParticipant.Role, entity.InvitePermission)
+}
+
+// checkGroupPermission makes sure the user is in the group and has all the permissions.
+func checkGroupPermission(
+	ctx context.Context,
+	repo GroupParticipantRepository,
+	groupID, userID int,
+	permissions ...entity.GroupPermission,
+) (entity.GroupParticipant, error) {
+	curParticipant, err := repo.Get(ctx, groupID, userID, false)
 	if err != nil {
 		if errors.Is(err, entity.ErrGroupParticipantNotFound) {
-			return fmt.Errorf("%w: current participant isn't in the group", entity.ErrGroupNotFound)
+			return entity.GroupParticipant{}, fmt.Errorf("%w: current participant isn't in the group", entity.ErrGroupNotFound)
 		}
-		return fmt.Errorf("get current group participant: %w", err)
+		return entity.GroupParticipant{}, fmt.Errorf("get current group participant: %w", err)
 	}
 
 	if !curParticipant.IsInGroup() {
-		return fmt.Errorf("%w: current participant isn't in the group", entity.ErrGroupNotFound)
+		return entity.GroupParticipant{}, fmt.Errorf("%w: current participant isn't in the group", entity.ErrGroupNotFound)
 	}
-	if checkAdmin && !curParticipant.IsAdmin {
-		return fmt.Errorf("%w: current participant isn't admin in the group", entity.ErrForbiddenPerformAction)
+
+	for _, permission := range permissions {
+		if !curParticipant.Can(permission) {
+			return entity.GroupParticipant{}, fmt.Errorf("%w: current participant with role %s doesn't have %s permission",
+				entity.ErrForbiddenPerformAction, curParticipant.Role, permission)
+		}
 	}
 
-	return nil
+	return curParticipant, nil
 }
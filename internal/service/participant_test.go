@@ -5,7 +5,9 @@ import (
 	"errors"
 	"strconv"
 	"testing"
+	"time"
 
+	"github.com/Chatyx/backend/internal/dto"
 	"github.com/Chatyx/backend/internal/entity"
 	"github.com/Chatyx/backend/pkg/ctxutil"
 
@@ -23,19 +25,19 @@ func TestGroupParticipant_List(t *testing.T) {
 		{
 			GroupID: 1,
 			UserID:  1,
-			IsAdmin: true,
+			Role:    entity.AdminRole,
 			Status:  entity.JoinedStatus,
 		},
 		{
 			GroupID: 1,
 			UserID:  2,
-			IsAdmin: false,
+			Role:    entity.MemberRole,
 			Status:  entity.JoinedStatus,
 		},
 		{
 			GroupID: 1,
 			UserID:  3,
-			IsAdmin: false,
+			Role:    entity.MemberRole,
 			Status:  entity.KickedStatus,
 		},
 	}
@@ -114,7 +116,7 @@ func TestGroupParticipant_Get(t *testing.T) {
 	defaultParticipant := entity.GroupParticipant{
 		GroupID: 1,
 		UserID:  2,
-		IsAdmin: false,
+		Role:    entity.MemberRole,
 		Status:  entity.JoinedStatus,
 	}
 
@@ -132,7 +134,7 @@ func TestGroupParticipant_Get(t *testing.T) {
 				repo.On("Get", mock.Anything, 1, 1, false).Return(entity.GroupParticipant{
 					GroupID: 1,
 					UserID:  1,
-					IsAdmin: true,
+					Role:    entity.AdminRole,
 					Status:  entity.JoinedStatus,
 				}, nil)
 
@@ -148,7 +150,7 @@ func TestGroupParticipant_Get(t *testing.T) {
 				repo.On("Get", mock.Anything, 1, 1, false).Return(entity.GroupParticipant{
 					GroupID: 1,
 					UserID:  1,
-					IsAdmin: true,
+					Role:    entity.AdminRole,
 					Status:  entity.JoinedStatus,
 				}, nil)
 
@@ -171,7 +173,7 @@ func TestGroupParticipant_Get(t *testing.T) {
 				repo.On("Get", mock.Anything, 1, 1, false).Return(entity.GroupParticipant{
 					GroupID: 1,
 					UserID:  1,
-					IsAdmin: false,
+					Role:    entity.MemberRole,
 					Status:  entity.KickedStatus,
 				}, nil)
 			},
@@ -213,27 +215,32 @@ func TestGroupParticipant_Get(t *testing.T) {
 }
 
 func TestGroupParticipant_Invite(t *testing.T) {
+	runTx := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	}
 	defaultInvitedParticipant := entity.GroupParticipant{
 		GroupID: 1,
 		UserID:  2,
-		IsAdmin: false,
+		Role:    entity.MemberRole,
 		Status:  entity.JoinedStatus,
 	}
 
 	testCases := []struct {
 		name                string
 		currentUserID       int
-		mockBehavior        func(repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer)
+		settings            entity.GroupSettings
+		blocked             bool
+		mockBehavior        func(repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer, msgCreator *MockServiceMessageCreator)
 		expectedParticipant entity.GroupParticipant
 		expectedError       error
 	}{
 		{
 			name: "Successful",
-			mockBehavior: func(repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer) {
+			mockBehavior: func(repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer, msgCreator *MockServiceMessageCreator) {
 				repo.On("Get", mock.Anything, 1, 1, false).Return(entity.GroupParticipant{
 					GroupID: 1,
 					UserID:  1,
-					IsAdmin: true,
+					Role:    entity.AdminRole,
 					Status:  entity.JoinedStatus,
 				}, nil)
 
@@ -247,23 +254,39 @@ func TestGroupParticipant_Invite(t *testing.T) {
 					},
 					UserID: 2,
 				}).Return(nil)
+
+				msgCreator.On("CreateService", mock.Anything, entity.ChatID{ID: 1, Type: entity.GroupChatType}, userServiceAction(entity.ParticipantInvitedServiceAction, 2)).
+					Return(entity.Message{}, nil)
 			},
 			expectedParticipant: defaultInvitedParticipant,
 		},
+		{
+			name:    "Invited user has blocked the current user",
+			blocked: true,
+			mockBehavior: func(repo *MockGroupParticipantRepository, _ *MockGroupParticipantEventProducer, _ *MockServiceMessageCreator) {
+				repo.On("Get", mock.Anything, 1, 1, false).Return(entity.GroupParticipant{
+					GroupID: 1,
+					UserID:  1,
+					Role:    entity.AdminRole,
+					Status:  entity.JoinedStatus,
+				}, nil)
+			},
+			expectedError: entity.ErrUserBlocked,
+		},
 		{
 			name: "Current user isn't in the group",
-			mockBehavior: func(repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer) {
+			mockBehavior: func(repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer, msgCreator *MockServiceMessageCreator) {
 				repo.On("Get", mock.Anything, 1, 1, false).Return(entity.GroupParticipant{}, entity.ErrGroupParticipantNotFound)
 			},
 			expectedError: entity.ErrGroupNotFound,
 		},
 		{
 			name: "Current user is kicked from group",
-			mockBehavior: func(repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer) {
+			mockBehavior: func(repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer, msgCreator *MockServiceMessageCreator) {
 				repo.On("Get", mock.Anything, 1, 1, false).Return(entity.GroupParticipant{
 					GroupID: 1,
 					UserID:  1,
-					IsAdmin: false,
+					Role:    entity.MemberRole,
 					Status:  entity.KickedStatus,
 				}, nil)
 			},
@@ -271,30 +294,61 @@ func TestGroupParticipant_Invite(t *testing.T) {
 		},
 		{
 			name: "Current user isn't admin",
-			mockBehavior: func(repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer) {
+			mockBehavior: func(repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer, msgCreator *MockServiceMessageCreator) {
 				repo.On("Get", mock.Anything, 1, 1, false).Return(entity.GroupParticipant{
 					GroupID: 1,
 					UserID:  1,
-					IsAdmin: false,
+					Role:    entity.MemberRole,
 					Status:  entity.JoinedStatus,
 				}, nil)
 			},
 			expectedError: entity.ErrForbiddenPerformAction,
 		},
+		{
+			name:     "Current user is member and members can invite",
+			settings: entity.GroupSettings{MembersCanInvite: true},
+			mockBehavior: func(repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer, msgCreator *MockServiceMessageCreator) {
+				repo.On("Get", mock.Anything, 1, 1, false).Return(entity.GroupParticipant{
+					GroupID: 1,
+					UserID:  1,
+					Role:    entity.MemberRole,
+					Status:  entity.JoinedStatus,
+				}, nil)
+
+				repo.On("Create", mock.Anything, &defaultInvitedParticipant).Return(nil)
+				prod.On("Produce", mock.Anything, mock.Anything).Return(nil)
+				msgCreator.On("CreateService", mock.Anything, mock.Anything, userServiceAction(entity.ParticipantInvitedServiceAction, 2)).
+					Return(entity.Message{}, nil)
+			},
+			expectedParticipant: defaultInvitedParticipant,
+		},
+		{
+			name:     "Current user is muted member and members can invite",
+			settings: entity.GroupSettings{MembersCanInvite: true},
+			mockBehavior: func(repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer, msgCreator *MockServiceMessageCreator) {
+				repo.On("Get", mock.Anything, 1, 1, false).Return(entity.GroupParticipant{
+					GroupID: 1,
+					UserID:  1,
+					Role:    entity.MemberRole,
+					Status:  entity.MutedStatus,
+				}, nil)
+			},
+			expectedError: entity.ErrForbiddenPerformAction,
+		},
 		{
 			name: "Unexpected error while getting current participant",
-			mockBehavior: func(repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer) {
+			mockBehavior: func(repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer, msgCreator *MockServiceMessageCreator) {
 				repo.On("Get", mock.Anything, 1, 1, false).Return(entity.GroupParticipant{}, errUnexpected)
 			},
 			expectedError: errUnexpected,
 		},
 		{
 			name: "Unexpected error while creating participant",
-			mockBehavior: func(repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer) {
+			mockBehavior: func(repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer, msgCreator *MockServiceMessageCreator) {
 				repo.On("Get", mock.Anything, 1, 1, false).Return(entity.GroupParticipant{
 					GroupID: 1,
 					UserID:  1,
-					IsAdmin: true,
+					Role:    entity.AdminRole,
 					Status:  entity.JoinedStatus,
 				}, nil)
 
@@ -304,11 +358,11 @@ func TestGroupParticipant_Invite(t *testing.T) {
 		},
 		{
 			name: "Unexpected error while producing participant event",
-			mockBehavior: func(repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer) {
+			mockBehavior: func(repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer, msgCreator *MockServiceMessageCreator) {
 				repo.On("Get", mock.Anything, 1, 1, false).Return(entity.GroupParticipant{
 					GroupID: 1,
 					UserID:  1,
-					IsAdmin: true,
+					Role:    entity.AdminRole,
 					Status:  entity.JoinedStatus,
 				}, nil)
 
@@ -325,6 +379,19 @@ func TestGroupParticipant_Invite(t *testing.T) {
 			},
 			expectedError: errUnexpected,
 		},
+		{
+			name: "Participant limit is reached",
+			mockBehavior: func(repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer, msgCreator *MockServiceMessageCreator) {
+				repo.On("Get", mock.Anything, 1, 1, false).Return(entity.GroupParticipant{
+					GroupID: 1,
+					UserID:  1,
+					Role:    entity.AdminRole,
+					Status:  entity.JoinedStatus,
+				}, nil)
+				repo.On("Count", mock.Anything, 1, true).Return(100, nil)
+			},
+			expectedError: entity.ErrGroupParticipantLimitReached,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -332,14 +399,25 @@ func TestGroupParticipant_Invite(t *testing.T) {
 			txm := NewMockTransactionManager(t)
 			repo := NewMockGroupParticipantRepository(t)
 			prod := NewMockGroupParticipantEventProducer(t)
+			msgCreator := NewMockServiceMessageCreator(t)
 			if testCase.mockBehavior != nil {
-				testCase.mockBehavior(repo, prod)
+				testCase.mockBehavior(repo, prod, msgCreator)
 			}
+			txm.On("Do", mock.Anything, mock.Anything).Return(runTx).Maybe()
+			repo.On("Count", mock.Anything, 1, true).Return(1, nil).Maybe()
+			settingsRepo := NewMockGroupSettingsRepository(t)
+			settingsRepo.On("GetSettings", mock.Anything, 1, false).Return(testCase.settings, nil).Maybe()
+			blockChecker := NewMockUserBlockChecker(t)
+			blockChecker.On("Exists", mock.Anything, 2, 1).Return(testCase.blocked, nil).Maybe()
 
 			service := NewGroupParticipant(GroupParticipantConfig{
-				TxManager:     txm,
-				Repository:    repo,
-				EventProducer: prod,
+				TxManager:          txm,
+				Repository:         repo,
+				AuditLogRepository: newMockGroupAuditLogRepository(t),
+				SettingsRepository: settingsRepo,
+				EventProducer:      prod,
+				MessageCreator:     msgCreator,
+				BlockChecker:       blockChecker,
 			})
 			ctx := ctxutil.WithUserID(context.Background(), "1")
 
@@ -355,26 +433,37 @@ func TestGroupParticipant_Invite(t *testing.T) {
 }
 
 func TestGroupParticipant_UpdateStatus(t *testing.T) {
+	runTx := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	}
+
 	testCases := []struct {
 		name            string
 		userIDForUpdate int
 		statusForUpdate entity.GroupParticipantStatus
-		mockBehavior    func(txm *MockTransactionManager, repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer)
+		mockBehavior    func(txm *MockTransactionManager, repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer, msgCreator *MockServiceMessageCreator)
 		expectedError   error
 	}{
 		{
 			name:            "Successful kick another user",
 			userIDForUpdate: 2,
 			statusForUpdate: entity.KickedStatus,
-			mockBehavior: func(txm *MockTransactionManager, repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer) {
+			mockBehavior: func(txm *MockTransactionManager, repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer, msgCreator *MockServiceMessageCreator) {
 				repo.On("Get", mock.Anything, 1, 1, false).Return(entity.GroupParticipant{
 					GroupID: 1,
 					UserID:  1,
-					IsAdmin: true,
+					Role:    entity.AdminRole,
 					Status:  entity.JoinedStatus,
 				}, nil)
 
-				txm.On("Do", mock.Anything, mock.Anything).Return(nil)
+				txm.On("Do", mock.Anything, mock.Anything).Return(runTx)
+				repo.On("Get", mock.Anything, 1, 2, true).Return(entity.GroupParticipant{
+					GroupID: 1,
+					UserID:  2,
+					Role:    entity.MemberRole,
+					Status:  entity.JoinedStatus,
+				}, nil)
+				repo.On("Update", mock.Anything, mock.Anything).Return(nil)
 
 				prod.On("Produce", mock.Anything, entity.ParticipantEvent{
 					Type: entity.RemovedParticipant,
@@ -384,17 +473,20 @@ func TestGroupParticipant_UpdateStatus(t *testing.T) {
 					},
 					UserID: 2,
 				}).Return(nil)
+
+				msgCreator.On("CreateService", mock.Anything, entity.ChatID{ID: 1, Type: entity.GroupChatType}, userServiceAction(entity.ParticipantKickedServiceAction, 2)).
+					Return(entity.Message{}, nil)
 			},
 		},
 		{
 			name:            "Successful return of another user",
 			userIDForUpdate: 2,
 			statusForUpdate: entity.JoinedStatus,
-			mockBehavior: func(txm *MockTransactionManager, repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer) {
+			mockBehavior: func(txm *MockTransactionManager, repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer, msgCreator *MockServiceMessageCreator) {
 				repo.On("Get", mock.Anything, 1, 1, false).Return(entity.GroupParticipant{
 					GroupID: 1,
 					UserID:  1,
-					IsAdmin: true,
+					Role:    entity.AdminRole,
 					Status:  entity.JoinedStatus,
 				}, nil)
 
@@ -408,14 +500,24 @@ func TestGroupParticipant_UpdateStatus(t *testing.T) {
 					},
 					UserID: 2,
 				}).Return(nil)
+
+				msgCreator.On("CreateService", mock.Anything, entity.ChatID{ID: 1, Type: entity.GroupChatType}, userServiceAction(entity.ParticipantReturnedServiceAction, 2)).
+					Return(entity.Message{}, nil)
 			},
 		},
 		{
 			name:            "Successful leave from the group",
 			userIDForUpdate: 1,
 			statusForUpdate: entity.LeftStatus,
-			mockBehavior: func(txm *MockTransactionManager, repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer) {
-				txm.On("Do", mock.Anything, mock.Anything).Return(nil)
+			mockBehavior: func(txm *MockTransactionManager, repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer, msgCreator *MockServiceMessageCreator) {
+				txm.On("Do", mock.Anything, mock.Anything).Return(runTx)
+				repo.On("Get", mock.Anything, 1, 1, true).Return(entity.GroupParticipant{
+					GroupID: 1,
+					UserID:  1,
+					Role:    entity.MemberRole,
+					Status:  entity.JoinedStatus,
+				}, nil)
+				repo.On("Update", mock.Anything, mock.Anything).Return(nil)
 
 				prod.On("Produce", mock.Anything, entity.ParticipantEvent{
 					Type: entity.RemovedParticipant,
@@ -425,13 +527,16 @@ func TestGroupParticipant_UpdateStatus(t *testing.T) {
 					},
 					UserID: 1,
 				}).Return(nil)
+
+				msgCreator.On("CreateService", mock.Anything, entity.ChatID{ID: 1, Type: entity.GroupChatType}, userServiceAction(entity.ParticipantLeftServiceAction, 1)).
+					Return(entity.Message{}, nil)
 			},
 		},
 		{
 			name:            "Current user isn't in the group",
 			userIDForUpdate: 2,
 			statusForUpdate: entity.KickedStatus,
-			mockBehavior: func(txm *MockTransactionManager, repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer) {
+			mockBehavior: func(txm *MockTransactionManager, repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer, msgCreator *MockServiceMessageCreator) {
 				repo.On("Get", mock.Anything, 1, 1, false).Return(entity.GroupParticipant{}, entity.ErrGroupParticipantNotFound)
 			},
 			expectedError: entity.ErrGroupNotFound,
@@ -440,11 +545,11 @@ func TestGroupParticipant_UpdateStatus(t *testing.T) {
 			name:            "Current user is kicked from group",
 			userIDForUpdate: 2,
 			statusForUpdate: entity.KickedStatus,
-			mockBehavior: func(txm *MockTransactionManager, repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer) {
+			mockBehavior: func(txm *MockTransactionManager, repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer, msgCreator *MockServiceMessageCreator) {
 				repo.On("Get", mock.Anything, 1, 1, false).Return(entity.GroupParticipant{
 					GroupID: 1,
 					UserID:  1,
-					IsAdmin: false,
+					Role:    entity.MemberRole,
 					Status:  entity.KickedStatus,
 				}, nil)
 			},
@@ -454,11 +559,11 @@ func TestGroupParticipant_UpdateStatus(t *testing.T) {
 			name:            "Current user isn't admin",
 			userIDForUpdate: 2,
 			statusForUpdate: entity.KickedStatus,
-			mockBehavior: func(txm *MockTransactionManager, repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer) {
+			mockBehavior: func(txm *MockTransactionManager, repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer, msgCreator *MockServiceMessageCreator) {
 				repo.On("Get", mock.Anything, 1, 1, false).Return(entity.GroupParticipant{
 					GroupID: 1,
 					UserID:  1,
-					IsAdmin: false,
+					Role:    entity.MemberRole,
 					Status:  entity.JoinedStatus,
 				}, nil)
 			},
@@ -468,7 +573,7 @@ func TestGroupParticipant_UpdateStatus(t *testing.T) {
 			name:            "Unexpected error while getting current participant",
 			userIDForUpdate: 2,
 			statusForUpdate: entity.KickedStatus,
-			mockBehavior: func(txm *MockTransactionManager, repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer) {
+			mockBehavior: func(txm *MockTransactionManager, repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer, msgCreator *MockServiceMessageCreator) {
 				repo.On("Get", mock.Anything, 1, 1, false).Return(entity.GroupParticipant{}, errUnexpected)
 			},
 			expectedError: errUnexpected,
@@ -477,11 +582,11 @@ func TestGroupParticipant_UpdateStatus(t *testing.T) {
 			name:            "Unexpected error while updating participant status",
 			userIDForUpdate: 2,
 			statusForUpdate: entity.KickedStatus,
-			mockBehavior: func(txm *MockTransactionManager, repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer) {
+			mockBehavior: func(txm *MockTransactionManager, repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer, msgCreator *MockServiceMessageCreator) {
 				repo.On("Get", mock.Anything, 1, 1, false).Return(entity.GroupParticipant{
 					GroupID: 1,
 					UserID:  1,
-					IsAdmin: true,
+					Role:    entity.AdminRole,
 					Status:  entity.JoinedStatus,
 				}, nil)
 
@@ -493,15 +598,22 @@ func TestGroupParticipant_UpdateStatus(t *testing.T) {
 			name:            "Unexpected error while producing participant event",
 			userIDForUpdate: 2,
 			statusForUpdate: entity.KickedStatus,
-			mockBehavior: func(txm *MockTransactionManager, repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer) {
+			mockBehavior: func(txm *MockTransactionManager, repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer, msgCreator *MockServiceMessageCreator) {
 				repo.On("Get", mock.Anything, 1, 1, false).Return(entity.GroupParticipant{
 					GroupID: 1,
 					UserID:  1,
-					IsAdmin: true,
+					Role:    entity.AdminRole,
 					Status:  entity.JoinedStatus,
 				}, nil)
 
-				txm.On("Do", mock.Anything, mock.Anything).Return(nil)
+				txm.On("Do", mock.Anything, mock.Anything).Return(runTx)
+				repo.On("Get", mock.Anything, 1, 2, true).Return(entity.GroupParticipant{
+					GroupID: 1,
+					UserID:  2,
+					Role:    entity.MemberRole,
+					Status:  entity.JoinedStatus,
+				}, nil)
+				repo.On("Update", mock.Anything, mock.Anything).Return(nil)
 
 				prod.On("Produce", mock.Anything, entity.ParticipantEvent{
 					Type: entity.RemovedParticipant,
@@ -514,6 +626,41 @@ func TestGroupParticipant_UpdateStatus(t *testing.T) {
 			},
 			expectedError: errUnexpected,
 		},
+		{
+			name:            "Successful withdrawal of join request",
+			userIDForUpdate: 1,
+			statusForUpdate: entity.LeftStatus,
+			mockBehavior: func(txm *MockTransactionManager, repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer, msgCreator *MockServiceMessageCreator) {
+				txm.On("Do", mock.Anything, mock.Anything).Return(runTx)
+				repo.On("Get", mock.Anything, 1, 1, true).Return(entity.GroupParticipant{
+					GroupID: 1,
+					UserID:  1,
+					Role:    entity.MemberRole,
+					Status:  entity.PendingStatus,
+				}, nil)
+				repo.On("Update", mock.Anything, &entity.GroupParticipant{
+					GroupID: 1,
+					UserID:  1,
+					Role:    entity.MemberRole,
+					Status:  entity.LeftStatus,
+				}).Return(nil)
+			},
+		},
+		{
+			name:            "Left user can't request to join by the status update",
+			userIDForUpdate: 1,
+			statusForUpdate: entity.PendingStatus,
+			mockBehavior: func(txm *MockTransactionManager, repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer, msgCreator *MockServiceMessageCreator) {
+				txm.On("Do", mock.Anything, mock.Anything).Return(runTx)
+				repo.On("Get", mock.Anything, 1, 1, true).Return(entity.GroupParticipant{
+					GroupID: 1,
+					UserID:  1,
+					Role:    entity.MemberRole,
+					Status:  entity.LeftStatus,
+				}, nil)
+			},
+			expectedError: entity.ErrIncorrectGroupParticipantStatusTransit,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -521,18 +668,25 @@ func TestGroupParticipant_UpdateStatus(t *testing.T) {
 			txm := NewMockTransactionManager(t)
 			repo := NewMockGroupParticipantRepository(t)
 			prod := NewMockGroupParticipantEventProducer(t)
+			msgCreator := NewMockServiceMessageCreator(t)
 			if testCase.mockBehavior != nil {
-				testCase.mockBehavior(txm, repo, prod)
+				testCase.mockBehavior(txm, repo, prod, msgCreator)
 			}
 
 			service := NewGroupParticipant(GroupParticipantConfig{
-				TxManager:     txm,
-				Repository:    repo,
-				EventProducer: prod,
+				TxManager:          txm,
+				Repository:         repo,
+				AuditLogRepository: newMockGroupAuditLogRepository(t),
+				EventProducer:      prod,
+				MessageCreator:     msgCreator,
 			})
 			ctx := ctxutil.WithUserID(context.Background(), "1")
 
-			err := service.UpdateStatus(ctx, 1, testCase.userIDForUpdate, testCase.statusForUpdate)
+			err := service.UpdateStatus(ctx, dto.GroupParticipantStatusUpdate{
+				GroupID: 1,
+				UserID:  testCase.userIDForUpdate,
+				Status:  testCase.statusForUpdate,
+			})
 			if testCase.expectedError == nil {
 				require.NoError(t, err)
 			} else {
@@ -541,3 +695,949 @@ func TestGroupParticipant_UpdateStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestGroupParticipant_Promote(t *testing.T) {
+	runTx := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	}
+
+	testCases := []struct {
+		name          string
+		curRole       entity.GroupRole
+		targetRole    entity.GroupRole
+		newRole       entity.GroupRole
+		mockBehavior  func(txm *MockTransactionManager, repo *MockGroupParticipantRepository)
+		expectedError error
+	}{
+		{
+			name:       "Owner promotes member to admin",
+			curRole:    entity.OwnerRole,
+			targetRole: entity.MemberRole,
+			newRole:    entity.AdminRole,
+			mockBehavior: func(txm *MockTransactionManager, repo *MockGroupParticipantRepository) {
+				txm.On("Do", mock.Anything, mock.Anything).Return(runTx)
+				repo.On("Update", mock.Anything, &entity.GroupParticipant{
+					GroupID: 1,
+					UserID:  2,
+					Role:    entity.AdminRole,
+					Status:  entity.JoinedStatus,
+				}).Return(nil)
+			},
+		},
+		{
+			name:       "Admin promotes member to moderator",
+			curRole:    entity.AdminRole,
+			targetRole: entity.MemberRole,
+			newRole:    entity.ModeratorRole,
+			mockBehavior: func(txm *MockTransactionManager, repo *MockGroupParticipantRepository) {
+				txm.On("Do", mock.Anything, mock.Anything).Return(runTx)
+				repo.On("Update", mock.Anything, mock.Anything).Return(nil)
+			},
+		},
+		{
+			name:          "Admin can't grant admin role",
+			curRole:       entity.AdminRole,
+			targetRole:    entity.MemberRole,
+			newRole:       entity.AdminRole,
+			expectedError: entity.ErrForbiddenPerformAction,
+		},
+		{
+			name:          "Moderator doesn't have promote permission",
+			curRole:       entity.ModeratorRole,
+			targetRole:    entity.MemberRole,
+			newRole:       entity.ModeratorRole,
+			expectedError: entity.ErrForbiddenPerformAction,
+		},
+		{
+			name:       "Admin can't manage another admin",
+			curRole:    entity.AdminRole,
+			targetRole: entity.AdminRole,
+			newRole:    entity.ModeratorRole,
+			mockBehavior: func(txm *MockTransactionManager, repo *MockGroupParticipantRepository) {
+				txm.On("Do", mock.Anything, mock.Anything).Return(runTx)
+			},
+			expectedError: entity.ErrForbiddenPerformAction,
+		},
+		{
+			name:       "Promotion to the lower role",
+			curRole:    entity.OwnerRole,
+			targetRole: entity.AdminRole,
+			newRole:    entity.ModeratorRole,
+			mockBehavior: func(txm *MockTransactionManager, repo *MockGroupParticipantRepository) {
+				txm.On("Do", mock.Anything, mock.Anything).Return(runTx)
+			},
+			expectedError: entity.ErrIncorrectGroupParticipantRoleChange,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			txm := NewMockTransactionManager(t)
+			repo := NewMockGroupParticipantRepository(t)
+
+			repo.On("Get", mock.Anything, 1, 1, false).Return(entity.GroupParticipant{
+				GroupID: 1,
+				UserID:  1,
+				Role:    testCase.curRole,
+				Status:  entity.JoinedStatus,
+			}, nil)
+			repo.On("Get", mock.Anything, 1, 2, true).Return(entity.GroupParticipant{
+				GroupID: 1,
+				UserID:  2,
+				Role:    testCase.targetRole,
+				Status:  entity.JoinedStatus,
+			}, nil).Maybe()
+
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(txm, repo)
+			}
+
+			service := NewGroupParticipant(GroupParticipantConfig{
+				TxManager:          txm,
+				Repository:         repo,
+				AuditLogRepository: newMockGroupAuditLogRepository(t),
+			})
+			ctx := ctxutil.WithUserID(context.Background(), "1")
+
+			err := service.Promote(ctx, 1, 2, testCase.newRole)
+			if testCase.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestGroupParticipant_Demote(t *testing.T) {
+	txm := NewMockTransactionManager(t)
+	repo := NewMockGroupParticipantRepository(t)
+
+	repo.On("Get", mock.Anything, 1, 1, false).Return(entity.GroupParticipant{
+		GroupID: 1,
+		UserID:  1,
+		Role:    entity.OwnerRole,
+		Status:  entity.JoinedStatus,
+	}, nil)
+	repo.On("Get", mock.Anything, 1, 2, true).Return(entity.GroupParticipant{
+		GroupID: 1,
+		UserID:  2,
+		Role:    entity.ModeratorRole,
+		Status:  entity.JoinedStatus,
+	}, nil)
+	repo.On("Update", mock.Anything, &entity.GroupParticipant{
+		GroupID: 1,
+		UserID:  2,
+		Role:    entity.MemberRole,
+		Status:  entity.JoinedStatus,
+	}).Return(nil)
+	txm.On("Do", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	})
+
+	service := NewGroupParticipant(GroupParticipantConfig{
+		TxManager:          txm,
+		Repository:         repo,
+		AuditLogRepository: newMockGroupAuditLogRepository(t),
+	})
+	ctx := ctxutil.WithUserID(context.Background(), "1")
+
+	require.NoError(t, service.Demote(ctx, 1, 2, entity.MemberRole))
+	assert.ErrorIs(t, service.Demote(ctx, 1, 2, entity.AdminRole), entity.ErrIncorrectGroupParticipantRoleChange)
+}
+
+func TestGroupParticipant_OwnerLeaving(t *testing.T) {
+	runTx := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	}
+	owner := entity.GroupParticipant{
+		GroupID:  1,
+		UserID:   1,
+		Role:     entity.OwnerRole,
+		Status:   entity.JoinedStatus,
+		JoinedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	testCases := []struct {
+		name          string
+		participants  []entity.GroupParticipant
+		mockBehavior  func(repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer, msgCreator *MockServiceMessageCreator)
+		expectedError error
+	}{
+		{
+			name: "The longest-standing admin becomes the owner",
+			participants: []entity.GroupParticipant{
+				owner,
+				{GroupID: 1, UserID: 2, Role: entity.AdminRole, Status: entity.JoinedStatus, JoinedAt: owner.JoinedAt.Add(2 * time.Hour)},
+				{GroupID: 1, UserID: 3, Role: entity.AdminRole, Status: entity.JoinedStatus, JoinedAt: owner.JoinedAt.Add(time.Hour)},
+				{GroupID: 1, UserID: 4, Role: entity.AdminRole, Status: entity.LeftStatus},
+				{GroupID: 1, UserID: 5, Role: entity.MemberRole, Status: entity.JoinedStatus},
+			},
+			mockBehavior: func(repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer, msgCreator *MockServiceMessageCreator) {
+				repo.On("Update", mock.Anything, &entity.GroupParticipant{
+					GroupID:  1,
+					UserID:   3,
+					Role:     entity.OwnerRole,
+					Status:   entity.JoinedStatus,
+					JoinedAt: owner.JoinedAt.Add(time.Hour),
+				}).Return(nil)
+				repo.On("Update", mock.Anything, &entity.GroupParticipant{
+					GroupID:  1,
+					UserID:   1,
+					Role:     entity.MemberRole,
+					Status:   entity.LeftStatus,
+					JoinedAt: owner.JoinedAt,
+				}).Return(nil)
+
+				prod.On("Produce", mock.Anything, mock.Anything).Return(nil)
+
+				chatID := entity.ChatID{ID: 1, Type: entity.GroupChatType}
+				msgCreator.On("CreateService", mock.Anything, chatID, userServiceAction(entity.ParticipantLeftServiceAction, 1)).
+					Return(entity.Message{}, nil)
+				msgCreator.On("CreateService", mock.Anything, chatID, userServiceAction(entity.OwnershipPassedServiceAction, 3)).
+					Return(entity.Message{}, nil)
+			},
+		},
+		{
+			name: "The last owner can't leave",
+			participants: []entity.GroupParticipant{
+				owner,
+				{GroupID: 1, UserID: 2, Role: entity.ModeratorRole, Status: entity.JoinedStatus},
+			},
+			expectedError: entity.ErrLastGroupOwnerLeaving,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			txm := NewMockTransactionManager(t)
+			repo := NewMockGroupParticipantRepository(t)
+			prod := NewMockGroupParticipantEventProducer(t)
+			msgCreator := NewMockServiceMessageCreator(t)
+
+			txm.On("Do", mock.Anything, mock.Anything).Return(runTx)
+			repo.On("Get", mock.Anything, 1, 1, true).Return(owner, nil)
+			repo.On("List", mock.Anything, 1).Return(testCase.participants, nil)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(repo, prod, msgCreator)
+			}
+
+			service := NewGroupParticipant(GroupParticipantConfig{
+				TxManager:          txm,
+				Repository:         repo,
+				AuditLogRepository: newMockGroupAuditLogRepository(t),
+				EventProducer:      prod,
+				MessageCreator:     msgCreator,
+			})
+			ctx := ctxutil.WithUserID(context.Background(), "1")
+
+			err := service.UpdateStatus(ctx, dto.GroupParticipantStatusUpdate{
+				GroupID: 1,
+				UserID:  1,
+				Status:  entity.LeftStatus,
+			})
+			if testCase.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestGroupParticipant_TransferOwnership(t *testing.T) {
+	runTx := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	}
+
+	testCases := []struct {
+		name          string
+		curRole       entity.GroupRole
+		userID        int
+		targetStatus  entity.GroupParticipantStatus
+		mockBehavior  func(repo *MockGroupParticipantRepository, msgCreator *MockServiceMessageCreator)
+		expectedError error
+	}{
+		{
+			name:         "Successful",
+			curRole:      entity.OwnerRole,
+			userID:       2,
+			targetStatus: entity.JoinedStatus,
+			mockBehavior: func(repo *MockGroupParticipantRepository, msgCreator *MockServiceMessageCreator) {
+				repo.On("Update", mock.Anything, &entity.GroupParticipant{
+					GroupID: 1,
+					UserID:  1,
+					Role:    entity.AdminRole,
+					Status:  entity.JoinedStatus,
+				}).Return(nil)
+				repo.On("Update", mock.Anything, &entity.GroupParticipant{
+					GroupID: 1,
+					UserID:  2,
+					Role:    entity.OwnerRole,
+					Status:  entity.JoinedStatus,
+				}).Return(nil)
+
+				msgCreator.On("CreateService", mock.Anything, entity.ChatID{ID: 1, Type: entity.GroupChatType},
+					userServiceAction(entity.OwnershipTransferredServiceAction, 2)).Return(entity.Message{}, nil)
+			},
+		},
+		{
+			name:          "Transfer to yourself",
+			curRole:       entity.OwnerRole,
+			userID:        1,
+			expectedError: entity.ErrIncorrectGroupParticipantRoleChange,
+		},
+		{
+			name:          "Current user isn't the owner",
+			curRole:       entity.AdminRole,
+			userID:        2,
+			targetStatus:  entity.JoinedStatus,
+			expectedError: entity.ErrForbiddenPerformAction,
+		},
+		{
+			name:          "New owner isn't in the group",
+			curRole:       entity.OwnerRole,
+			userID:        2,
+			targetStatus:  entity.LeftStatus,
+			expectedError: entity.ErrGroupParticipantNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			txm := NewMockTransactionManager(t)
+			repo := NewMockGroupParticipantRepository(t)
+			msgCreator := NewMockServiceMessageCreator(t)
+
+			curParticipant := entity.GroupParticipant{
+				GroupID: 1,
+				UserID:  1,
+				Role:    testCase.curRole,
+				Status:  entity.JoinedStatus,
+			}
+			txm.On("Do", mock.Anything, mock.Anything).Return(runTx).Maybe()
+			repo.On("Get", mock.Anything, 1, 1, false).Return(curParticipant, nil).Maybe()
+			repo.On("Get", mock.Anything, 1, 1, true).Return(curParticipant, nil).Maybe()
+			repo.On("Get", mock.Anything, 1, 2, true).Return(entity.GroupParticipant{
+				GroupID: 1,
+				UserID:  2,
+				Role:    entity.MemberRole,
+				Status:  testCase.targetStatus,
+			}, nil).Maybe()
+
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(repo, msgCreator)
+			}
+
+			service := NewGroupParticipant(GroupParticipantConfig{
+				TxManager:          txm,
+				Repository:         repo,
+				AuditLogRepository: newMockGroupAuditLogRepository(t),
+				MessageCreator:     msgCreator,
+			})
+			ctx := ctxutil.WithUserID(context.Background(), "1")
+
+			err := service.TransferOwnership(ctx, 1, testCase.userID)
+			if testCase.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestGroupParticipant_Join(t *testing.T) {
+	runTx := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	}
+	activeLink := entity.GroupInviteLink{
+		Token:     "token",
+		GroupID:   1,
+		CreatorID: 2,
+	}
+	chatID := entity.ChatID{ID: 1, Type: entity.GroupChatType}
+
+	testCases := []struct {
+		name                string
+		mockBehavior        func(repo *MockGroupParticipantRepository, groupRepo *MockPublicGroupRepository, linkRepo *MockGroupInviteLinkRepository)
+		expectedParticipant entity.GroupParticipant
+		expectedError       error
+	}{
+		{
+			name: "Successful join by invite link",
+			mockBehavior: func(repo *MockGroupParticipantRepository, groupRepo *MockPublicGroupRepository, linkRepo *MockGroupInviteLinkRepository) {
+				linkRepo.On("Get", mock.Anything, "token", true).Return(activeLink, nil)
+				linkRepo.On("Update", mock.Anything, &entity.GroupInviteLink{
+					Token:     "token",
+					GroupID:   1,
+					CreatorID: 2,
+					Uses:      1,
+				}).Return(nil)
+				repo.On("Get", mock.Anything, 1, 1, true).Return(entity.GroupParticipant{}, entity.ErrGroupParticipantNotFound)
+				repo.On("Create", mock.Anything, &entity.GroupParticipant{
+					GroupID: 1,
+					UserID:  1,
+					Role:    entity.MemberRole,
+					Status:  entity.JoinedStatus,
+				}).Return(nil)
+			},
+			expectedParticipant: entity.GroupParticipant{
+				GroupID: 1,
+				UserID:  1,
+				Role:    entity.MemberRole,
+				Status:  entity.JoinedStatus,
+			},
+		},
+		{
+			name: "Successful return by public uname",
+			mockBehavior: func(repo *MockGroupParticipantRepository, groupRepo *MockPublicGroupRepository, linkRepo *MockGroupInviteLinkRepository) {
+				linkRepo.On("Get", mock.Anything, "token", true).Return(entity.GroupInviteLink{}, entity.ErrGroupInviteLinkNotFound)
+				groupRepo.On("GetByUname", mock.Anything, "token").Return(entity.Group{ID: 1, Uname: "token"}, nil)
+				repo.On("Get", mock.Anything, 1, 1, true).Return(entity.GroupParticipant{
+					GroupID: 1,
+					UserID:  1,
+					Role:    entity.MemberRole,
+					Status:  entity.LeftStatus,
+				}, nil)
+				repo.On("Update", mock.Anything, &entity.GroupParticipant{
+					GroupID: 1,
+					UserID:  1,
+					Role:    entity.MemberRole,
+					Status:  entity.JoinedStatus,
+				}).Return(nil)
+			},
+			expectedParticipant: entity.GroupParticipant{
+				GroupID: 1,
+				UserID:  1,
+				Role:    entity.MemberRole,
+				Status:  entity.JoinedStatus,
+			},
+		},
+		{
+			name: "Neither invite link nor public group",
+			mockBehavior: func(repo *MockGroupParticipantRepository, groupRepo *MockPublicGroupRepository, linkRepo *MockGroupInviteLinkRepository) {
+				linkRepo.On("Get", mock.Anything, "token", true).Return(entity.GroupInviteLink{}, entity.ErrGroupInviteLinkNotFound)
+				groupRepo.On("GetByUname", mock.Anything, "token").Return(entity.Group{}, entity.ErrGroupNotFound)
+			},
+			expectedError: entity.ErrGroupNotFound,
+		},
+		{
+			name: "Exhausted invite link",
+			mockBehavior: func(repo *MockGroupParticipantRepository, groupRepo *MockPublicGroupRepository, linkRepo *MockGroupInviteLinkRepository) {
+				maxUses := 3
+				link := activeLink
+				link.MaxUses = &maxUses
+				link.Uses = 3
+
+				linkRepo.On("Get", mock.Anything, "token", true).Return(link, nil)
+			},
+			expectedError: entity.ErrInactiveGroupInviteLink,
+		},
+		{
+			name: "Kicked participant can't join",
+			mockBehavior: func(repo *MockGroupParticipantRepository, groupRepo *MockPublicGroupRepository, linkRepo *MockGroupInviteLinkRepository) {
+				linkRepo.On("Get", mock.Anything, "token", true).Return(activeLink, nil)
+				linkRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+				repo.On("Get", mock.Anything, 1, 1, true).Return(entity.GroupParticipant{
+					GroupID: 1,
+					UserID:  1,
+					Role:    entity.MemberRole,
+					Status:  entity.KickedStatus,
+				}, nil)
+			},
+			expectedError: entity.ErrForbiddenPerformAction,
+		},
+		{
+			name: "Kicked participant can't request to join",
+			mockBehavior: func(repo *MockGroupParticipantRepository, groupRepo *MockPublicGroupRepository, linkRepo *MockGroupInviteLinkRepository) {
+				link := activeLink
+				link.RequiresApproval = true
+
+				linkRepo.On("Get", mock.Anything, "token", true).Return(link, nil)
+				linkRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+				repo.On("Get", mock.Anything, 1, 1, true).Return(entity.GroupParticipant{
+					GroupID: 1,
+					UserID:  1,
+					Role:    entity.MemberRole,
+					Status:  entity.KickedStatus,
+				}, nil)
+			},
+			expectedError: entity.ErrForbiddenPerformAction,
+		},
+		{
+			name: "Participant is already in the group",
+			mockBehavior: func(repo *MockGroupParticipantRepository, groupRepo *MockPublicGroupRepository, linkRepo *MockGroupInviteLinkRepository) {
+				linkRepo.On("Get", mock.Anything, "token", true).Return(activeLink, nil)
+				linkRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+				repo.On("Get", mock.Anything, 1, 1, true).Return(entity.GroupParticipant{
+					GroupID: 1,
+					UserID:  1,
+					Role:    entity.MemberRole,
+					Status:  entity.JoinedStatus,
+				}, nil)
+			},
+			expectedError: entity.ErrSuchGroupParticipantAlreadyExists,
+		},
+		{
+			name: "Join request is already sent",
+			mockBehavior: func(repo *MockGroupParticipantRepository, groupRepo *MockPublicGroupRepository, linkRepo *MockGroupInviteLinkRepository) {
+				link := activeLink
+				link.RequiresApproval = true
+
+				linkRepo.On("Get", mock.Anything, "token", true).Return(link, nil)
+				linkRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+				repo.On("Get", mock.Anything, 1, 1, true).Return(entity.GroupParticipant{
+					GroupID: 1,
+					UserID:  1,
+					Role:    entity.MemberRole,
+					Status:  entity.PendingStatus,
+				}, nil)
+			},
+			expectedError: entity.ErrSuchGroupParticipantAlreadyExists,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			txm := NewMockTransactionManager(t)
+			repo := NewMockGroupParticipantRepository(t)
+			groupRepo := NewMockPublicGroupRepository(t)
+			linkRepo := NewMockGroupInviteLinkRepository(t)
+			prod := NewMockGroupParticipantEventProducer(t)
+			msgCreator := NewMockServiceMessageCreator(t)
+
+			txm.On("Do", mock.Anything, mock.Anything).Return(runTx)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(repo, groupRepo, linkRepo)
+			}
+			repo.On("Count", mock.Anything, 1, true).Return(1, nil).Maybe()
+
+			if testCase.expectedError == nil {
+				prod.On("Produce", mock.Anything, entity.ParticipantEvent{
+					Type:   entity.AddedParticipant,
+					ChatID: chatID,
+					UserID: 1,
+				}).Return(nil)
+				msgCreator.On("CreateService", mock.Anything, chatID, userServiceAction(entity.ParticipantJoinedServiceAction, 1)).
+					Return(entity.Message{}, nil)
+			}
+
+			service := NewGroupParticipant(GroupParticipantConfig{
+				TxManager:            txm,
+				Repository:           repo,
+				GroupRepository:      groupRepo,
+				InviteLinkRepository: linkRepo,
+				EventProducer:        prod,
+				MessageCreator:       msgCreator,
+			})
+			ctx := ctxutil.WithUserID(context.Background(), "1")
+
+			participant, err := service.Join(ctx, "token")
+			if testCase.expectedError == nil {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedParticipant, participant)
+			} else {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestGroupParticipant_JoinWithApproval(t *testing.T) {
+	runTx := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	}
+	chatID := entity.ChatID{ID: 1, Type: entity.GroupChatType}
+
+	txm := NewMockTransactionManager(t)
+	repo := NewMockGroupParticipantRepository(t)
+	linkRepo := NewMockGroupInviteLinkRepository(t)
+	prod := NewMockGroupParticipantEventProducer(t)
+
+	txm.On("Do", mock.Anything, mock.Anything).Return(runTx)
+	linkRepo.On("Get", mock.Anything, "token", true).Return(entity.GroupInviteLink{
+		Token:            "token",
+		GroupID:          1,
+		CreatorID:        2,
+		RequiresApproval: true,
+	}, nil)
+	linkRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+	repo.On("Get", mock.Anything, 1, 1, true).Return(entity.GroupParticipant{}, entity.ErrGroupParticipantNotFound)
+	repo.On("Create", mock.Anything, &entity.GroupParticipant{
+		GroupID: 1,
+		UserID:  1,
+		Role:    entity.MemberRole,
+		Status:  entity.PendingStatus,
+	}).Return(nil)
+	repo.On("List", mock.Anything, 1).Return([]entity.GroupParticipant{
+		{GroupID: 1, UserID: 1, Role: entity.MemberRole, Status: entity.PendingStatus},
+		{GroupID: 1, UserID: 2, Role: entity.OwnerRole, Status: entity.JoinedStatus},
+		{GroupID: 1, UserID: 3, Role: entity.AdminRole, Status: entity.JoinedStatus},
+		{GroupID: 1, UserID: 4, Role: entity.MemberRole, Status: entity.JoinedStatus},
+	}, nil)
+
+	for _, approverID := range []int{2, 3} {
+		prod.On("Produce", mock.Anything, entity.ParticipantEvent{
+			Type:        entity.JoinRequested,
+			ChatID:      chatID,
+			UserID:      approverID,
+			InitiatorID: 1,
+		}).Return(nil).Once()
+	}
+
+	service := NewGroupParticipant(GroupParticipantConfig{
+		TxManager:            txm,
+		Repository:           repo,
+		InviteLinkRepository: linkRepo,
+		EventProducer:        prod,
+	})
+	ctx := ctxutil.WithUserID(context.Background(), "1")
+
+	participant, err := service.Join(ctx, "token")
+	require.NoError(t, err)
+	assert.Equal(t, entity.PendingStatus, participant.Status)
+}
+
+func TestGroupParticipant_ReviewJoinRequest(t *testing.T) {
+	runTx := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	}
+	chatID := entity.ChatID{ID: 1, Type: entity.GroupChatType}
+	admin := entity.GroupParticipant{GroupID: 1, UserID: 1, Role: entity.AdminRole, Status: entity.JoinedStatus}
+
+	testCases := []struct {
+		name          string
+		approve       bool
+		requester     entity.GroupParticipant
+		mockBehavior  func(repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer, msgCreator *MockServiceMessageCreator)
+		expectedError error
+	}{
+		{
+			name:      "Successful approval",
+			approve:   true,
+			requester: entity.GroupParticipant{GroupID: 1, UserID: 2, Role: entity.MemberRole, Status: entity.PendingStatus},
+			mockBehavior: func(repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer, msgCreator *MockServiceMessageCreator) {
+				repo.On("Update", mock.Anything, &entity.GroupParticipant{
+					GroupID: 1,
+					UserID:  2,
+					Role:    entity.MemberRole,
+					Status:  entity.JoinedStatus,
+				}).Return(nil)
+				prod.On("Produce", mock.Anything, entity.ParticipantEvent{
+					Type:   entity.AddedParticipant,
+					ChatID: chatID,
+					UserID: 2,
+				}).Return(nil)
+				msgCreator.On("CreateService", mock.Anything, chatID, userServiceAction(entity.JoinRequestApprovedServiceAction, 2)).
+					Return(entity.Message{}, nil)
+			},
+		},
+		{
+			name:      "Successful rejection",
+			requester: entity.GroupParticipant{GroupID: 1, UserID: 2, Role: entity.MemberRole, Status: entity.PendingStatus},
+			mockBehavior: func(repo *MockGroupParticipantRepository, prod *MockGroupParticipantEventProducer, msgCreator *MockServiceMessageCreator) {
+				repo.On("Update", mock.Anything, &entity.GroupParticipant{
+					GroupID: 1,
+					UserID:  2,
+					Role:    entity.MemberRole,
+					Status:  entity.LeftStatus,
+				}).Return(nil)
+			},
+		},
+		{
+			name:          "There is no join request",
+			approve:       true,
+			requester:     entity.GroupParticipant{GroupID: 1, UserID: 2, Role: entity.MemberRole, Status: entity.LeftStatus},
+			expectedError: entity.ErrGroupParticipantNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			txm := NewMockTransactionManager(t)
+			repo := NewMockGroupParticipantRepository(t)
+			prod := NewMockGroupParticipantEventProducer(t)
+			msgCreator := NewMockServiceMessageCreator(t)
+
+			txm.On("Do", mock.Anything, mock.Anything).Return(runTx)
+			repo.On("Get", mock.Anything, 1, 1, false).Return(admin, nil)
+			repo.On("Get", mock.Anything, 1, 2, true).Return(testCase.requester, nil)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(repo, prod, msgCreator)
+			}
+			repo.On("Count", mock.Anything, 1, true).Return(1, nil).Maybe()
+
+			service := NewGroupParticipant(GroupParticipantConfig{
+				TxManager:          txm,
+				Repository:         repo,
+				AuditLogRepository: newMockGroupAuditLogRepository(t),
+				EventProducer:      prod,
+				MessageCreator:     msgCreator,
+			})
+			ctx := ctxutil.WithUserID(context.Background(), "1")
+
+			var err error
+			if testCase.approve {
+				err = service.ApproveJoinRequest(ctx, 1, 2)
+			} else {
+				err = service.RejectJoinRequest(ctx, 1, 2)
+			}
+
+			if testCase.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestGroupParticipant_Restrict(t *testing.T) {
+	runTx := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	}
+	chatID := entity.ChatID{ID: 1, Type: entity.GroupChatType}
+	until := time.Date(2024, time.April, 14, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name            string
+		prevStatus      entity.GroupParticipantStatus
+		status          entity.GroupParticipantStatus
+		restrictedUntil *time.Time
+		expectedUpdate  *entity.GroupParticipant
+		expectedEvent   entity.ParticipantEventType
+		expectedAction  entity.ServiceAction
+		serviceMsgErr   error
+		expectedError   error
+	}{
+		{
+			name:            "Successful mute until the time",
+			prevStatus:      entity.JoinedStatus,
+			status:          entity.MutedStatus,
+			restrictedUntil: &until,
+			expectedUpdate: &entity.GroupParticipant{
+				GroupID:         1,
+				UserID:          2,
+				Role:            entity.MemberRole,
+				Status:          entity.MutedStatus,
+				RestrictedUntil: &until,
+			},
+			expectedEvent: entity.RestrictedParticipant,
+			expectedAction: entity.ServiceAction{
+				Type: entity.ParticipantMutedServiceAction,
+				Params: map[string]string{
+					entity.UserIDServiceParam: "2",
+					entity.UntilServiceParam:  "2024-04-14T12:00:00Z",
+				},
+			},
+		},
+		{
+			name:       "Successful unmute",
+			prevStatus: entity.MutedStatus,
+			status:     entity.JoinedStatus,
+			// The time is ignored for statuses which aren't restrictions.
+			restrictedUntil: &until,
+			expectedUpdate: &entity.GroupParticipant{
+				GroupID: 1,
+				UserID:  2,
+				Role:    entity.MemberRole,
+				Status:  entity.JoinedStatus,
+			},
+			expectedEvent:  entity.RestrictedParticipant,
+			expectedAction: userServiceAction(entity.ParticipantUnmutedServiceAction, 2),
+		},
+		{
+			name:       "Successful permanent ban",
+			prevStatus: entity.JoinedStatus,
+			status:     entity.BannedStatus,
+			expectedUpdate: &entity.GroupParticipant{
+				GroupID: 1,
+				UserID:  2,
+				Role:    entity.MemberRole,
+				Status:  entity.BannedStatus,
+			},
+			expectedEvent:  entity.RemovedParticipant,
+			expectedAction: userServiceAction(entity.ParticipantBannedServiceAction, 2),
+		},
+		{
+			name:       "Successful ban of the left participant",
+			prevStatus: entity.LeftStatus,
+			status:     entity.BannedStatus,
+			expectedUpdate: &entity.GroupParticipant{
+				GroupID: 1,
+				UserID:  2,
+				Role:    entity.MemberRole,
+				Status:  entity.BannedStatus,
+			},
+			expectedEvent:  entity.RestrictedParticipant,
+			expectedAction: userServiceAction(entity.ParticipantBannedServiceAction, 2),
+		},
+		{
+			name:       "Successful lift of the ban",
+			prevStatus: entity.BannedStatus,
+			status:     entity.LeftStatus,
+			expectedUpdate: &entity.GroupParticipant{
+				GroupID: 1,
+				UserID:  2,
+				Role:    entity.MemberRole,
+				Status:  entity.LeftStatus,
+			},
+			expectedEvent:  entity.RestrictedParticipant,
+			expectedAction: userServiceAction(entity.ParticipantUnbannedServiceAction, 2),
+		},
+		{
+			name:       "Failed service message doesn't fail the committed ban",
+			prevStatus: entity.JoinedStatus,
+			status:     entity.BannedStatus,
+			expectedUpdate: &entity.GroupParticipant{
+				GroupID: 1,
+				UserID:  2,
+				Role:    entity.MemberRole,
+				Status:  entity.BannedStatus,
+			},
+			expectedEvent:  entity.RemovedParticipant,
+			expectedAction: userServiceAction(entity.ParticipantBannedServiceAction, 2),
+			serviceMsgErr:  errUnexpected,
+		},
+		{
+			name:          "Left participant can't be muted",
+			prevStatus:    entity.LeftStatus,
+			status:        entity.MutedStatus,
+			expectedError: entity.ErrIncorrectGroupParticipantStatusTransit,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			txm := NewMockTransactionManager(t)
+			repo := NewMockGroupParticipantRepository(t)
+			prod := NewMockGroupParticipantEventProducer(t)
+			msgCreator := NewMockServiceMessageCreator(t)
+
+			txm.On("Do", mock.Anything, mock.Anything).Return(runTx)
+			repo.On("Get", mock.Anything, 1, 1, false).Return(entity.GroupParticipant{
+				GroupID: 1,
+				UserID:  1,
+				Role:    entity.ModeratorRole,
+				Status:  entity.JoinedStatus,
+			}, nil)
+			repo.On("Get", mock.Anything, 1, 2, true).Return(entity.GroupParticipant{
+				GroupID: 1,
+				UserID:  2,
+				Role:    entity.MemberRole,
+				Status:  testCase.prevStatus,
+			}, nil)
+
+			if testCase.expectedUpdate != nil {
+				repo.On("Update", mock.Anything, testCase.expectedUpdate).Return(nil)
+				prod.On("Produce", mock.Anything, entity.ParticipantEvent{
+					Type:   testCase.expectedEvent,
+					ChatID: chatID,
+					UserID: 2,
+				}).Return(nil)
+				msgCreator.On("CreateService", mock.Anything, chatID, testCase.expectedAction).
+					Return(entity.Message{}, testCase.serviceMsgErr)
+			}
+
+			service := NewGroupParticipant(GroupParticipantConfig{
+				TxManager:          txm,
+				Repository:         repo,
+				AuditLogRepository: newMockGroupAuditLogRepository(t),
+				EventProducer:      prod,
+				MessageCreator:     msgCreator,
+			})
+			ctx := ctxutil.WithUserID(context.Background(), "1")
+
+			err := service.UpdateStatus(ctx, dto.GroupParticipantStatusUpdate{
+				GroupID:         1,
+				UserID:          2,
+				Status:          testCase.status,
+				RestrictedUntil: testCase.restrictedUntil,
+			})
+			if testCase.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestGroupParticipant_InviteMany(t *testing.T) {
+	runTx := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	}
+	chatID := entity.ChatID{ID: 1, Type: entity.GroupChatType}
+	newParticipant := func(userID int) *entity.GroupParticipant {
+		return &entity.GroupParticipant{
+			GroupID: 1,
+			UserID:  userID,
+			Role:    entity.MemberRole,
+			Status:  entity.JoinedStatus,
+		}
+	}
+
+	txm := NewMockTransactionManager(t)
+	repo := NewMockGroupParticipantRepository(t)
+	prod := NewMockGroupParticipantEventProducer(t)
+	msgCreator := NewMockServiceMessageCreator(t)
+
+	txm.On("Do", mock.Anything, mock.Anything).Return(runTx)
+	repo.On("Get", mock.Anything, 1, 1, false).Return(entity.GroupParticipant{
+		GroupID: 1,
+		UserID:  1,
+		Role:    entity.AdminRole,
+		Status:  entity.JoinedStatus,
+	}, nil)
+	repo.On("Count", mock.Anything, 1, true).Return(1, nil).Once()
+	repo.On("Create", mock.Anything, newParticipant(2)).Return(nil).Once()
+	repo.On("Create", mock.Anything, newParticipant(3)).Return(entity.ErrSuchGroupParticipantAlreadyExists).Once()
+	repo.On("Create", mock.Anything, newParticipant(4)).Return(entity.ErrAddNonExistentUserToGroup).Once()
+	repo.On("Create", mock.Anything, newParticipant(5)).Return(nil).Once()
+
+	blockChecker := NewMockUserBlockChecker(t)
+	blockChecker.On("Exists", mock.Anything, mock.Anything, 1).Return(func(_ context.Context, userID, _ int) bool {
+		return userID == 7
+	}, nil)
+
+	for _, userID := range []int{2, 5} {
+		prod.On("Produce", mock.Anything, entity.ParticipantEvent{
+			Type:   entity.AddedParticipant,
+			ChatID: chatID,
+			UserID: userID,
+		}).Return(nil).Once()
+	}
+	msgCreator.On("CreateService", mock.Anything, chatID, usersServiceAction(entity.ParticipantInvitedServiceAction, []int{2, 5})).
+		Return(entity.Message{}, nil).Once()
+
+	service := NewGroupParticipant(GroupParticipantConfig{
+		TxManager:          txm,
+		Repository:         repo,
+		AuditLogRepository: newMockGroupAuditLogRepository(t),
+		EventProducer:      prod,
+		MessageCreator:     msgCreator,
+		BlockChecker:       blockChecker,
+		MaxParticipants:    3,
+	})
+	ctx := ctxutil.WithUserID(context.Background(), "1")
+
+	results, err := service.InviteMany(ctx, 1, []int{2, 3, 2, 4, 7, 5, 6})
+	require.NoError(t, err)
+	assert.Equal(t, []entity.GroupInviteResult{
+		{UserID: 2, Status: entity.AddedInviteStatus},
+		{UserID: 3, Status: entity.AlreadyExistsInviteStatus},
+		{UserID: 4, Status: entity.NonExistentUserInviteStatus},
+		{UserID: 7, Status: entity.BlockedInviteStatus},
+		{UserID: 5, Status: entity.AddedInviteStatus},
+		{UserID: 6, Status: entity.LimitReachedInviteStatus},
+	}, results)
+}
+
+// newMockGroupAuditLogRepository returns a mock accepting any audit entries
+// for tests which don't check the audit log.
+func newMockGroupAuditLogRepository(t *testing.T) *MockGroupAuditLogRepository {
+	repo := NewMockGroupAuditLogRepository(t)
+	repo.On("Create", mock.Anything, mock.Anything).Return(nil).Maybe()
+	return repo
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Chatyx/backend/pkg/log"
+)
+
+const (
+	defaultDeletedGroupRetentionPeriod = 30 * 24 * time.Hour
+	defaultGroupPurgeInterval          = time.Hour
+)
+
+//go:generate mockery --inpackage --testonly --case underscore --name GroupPurgeRepository
+type GroupPurgeRepository interface {
+	PurgeDeleted(ctx context.Context, deletedBefore time.Time) (int, error)
+}
+
+type GroupPurgerConfig struct {
+	Interval        time.Duration
+	RetentionPeriod time.Duration
+	Repository      GroupPurgeRepository
+}
+
+// GroupPurger periodically deletes for good the groups which were deleted
+// longer than the retention period ago, so they can't be restored anymore.
+type GroupPurger struct {
+	interval  time.Duration
+	retention time.Duration
+	repo      GroupPurgeRepository
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewGroupPurger(conf GroupPurgerConfig) *GroupPurger {
+	if conf.Interval == 0 {
+		conf.Interval = defaultGroupPurgeInterval
+	}
+	if conf.RetentionPeriod == 0 {
+		conf.RetentionPeriod = defaultDeletedGroupRetentionPeriod
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &GroupPurger{
+		interval:  conf.Interval,
+		retention: conf.RetentionPeriod,
+		repo:      conf.Repository,
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+}
+
+func (p *GroupPurger) Run() {
+	p.wg.Add(1)
+
+	go func() {
+		defer p.wg.Done()
+
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-p.ctx.Done():
+				return
+			case <-ticker.C:
+				if err := p.Purge(p.ctx, time.Now()); err != nil && p.ctx.Err() == nil {
+					log.WithError(err).Error("Failed to purge deleted groups")
+				}
+			}
+		}
+	}()
+}
+
+func (p *GroupPurger) Close() error {
+	p.cancel()
+	p.wg.Wait()
+	return nil
+}
+
+// Purge deletes the groups whose retention period is over by the time.
+func (p *GroupPurger) Purge(ctx context.Context, now time.Time) error {
+	count, err := p.repo.PurgeDeleted(ctx, now.Add(-p.retention))
+	if err != nil {
+		return fmt.Errorf("purge deleted groups: %w", err)
+	}
+
+	if count != 0 {
+		log.FromContext(ctx).Infof("Purged %d deleted groups", count)
+	}
+	return nil
+}
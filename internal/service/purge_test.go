@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupPurger_Purge(t *testing.T) {
+	now := time.Now()
+	retention := 24 * time.Hour
+
+	testCases := []struct {
+		name          string
+		mockBehavior  func(repo *MockGroupPurgeRepository)
+		expectedError error
+	}{
+		{
+			name: "Successful",
+			mockBehavior: func(repo *MockGroupPurgeRepository) {
+				repo.On("PurgeDeleted", mock.Anything, now.Add(-retention)).Return(2, nil)
+			},
+		},
+		{
+			name: "Unexpected error while purging groups",
+			mockBehavior: func(repo *MockGroupPurgeRepository) {
+				repo.On("PurgeDeleted", mock.Anything, now.Add(-retention)).Return(0, errUnexpected)
+			},
+			expectedError: errUnexpected,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			repo := NewMockGroupPurgeRepository(t)
+			testCase.mockBehavior(repo)
+
+			purger := NewGroupPurger(GroupPurgerConfig{
+				RetentionPeriod: retention,
+				Repository:      repo,
+			})
+
+			err := purger.Purge(context.Background(), now)
+			if testCase.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
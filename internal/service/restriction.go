@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/log"
+)
+
+const defaultRestrictionLiftInterval = time.Minute
+
+//go:generate mockery --inpackage --testonly --case underscore --name GroupRestrictionRepository
+type GroupRestrictionRepository interface {
+	LiftExpiredRestrictions(ctx context.Context, now time.Time) ([]entity.GroupParticipant, error)
+}
+
+type RestrictionLifterConfig struct {
+	Interval      time.Duration
+	Repository    GroupRestrictionRepository
+	EventProducer GroupParticipantEventProducer
+}
+
+// RestrictionLifter periodically unmutes participants and lifts bans which have expired.
+// It's safe to run it on several application nodes, every restriction is lifted only once.
+type RestrictionLifter struct {
+	interval time.Duration
+	repo     GroupRestrictionRepository
+	prod     GroupParticipantEventProducer
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewRestrictionLifter(conf RestrictionLifterConfig) *RestrictionLifter {
+	if conf.Interval == 0 {
+		conf.Interval = defaultRestrictionLiftInterval
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &RestrictionLifter{
+		interval: conf.Interval,
+		repo:     conf.Repository,
+		prod:     conf.EventProducer,
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+}
+
+func (l *RestrictionLifter) Run() {
+	l.wg.Add(1)
+
+	go func() {
+		defer l.wg.Done()
+
+		ticker := time.NewTicker(l.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-l.ctx.Done():
+				return
+			case <-ticker.C:
+				if err := l.Lift(l.ctx, time.Now()); err != nil && l.ctx.Err() == nil {
+					log.WithError(err).Error("Failed to lift expired restrictions")
+				}
+			}
+		}
+	}()
+}
+
+func (l *RestrictionLifter) Close() error {
+	l.cancel()
+	l.wg.Wait()
+	return nil
+}
+
+// Lift lifts restrictions which have expired by the time and notifies about them.
+// Restrictions are already lifted when events are produced, so a failed event
+// doesn't prevent the others from being produced.
+func (l *RestrictionLifter) Lift(ctx context.Context, now time.Time) error {
+	participants, err := l.repo.LiftExpiredRestrictions(ctx, now)
+	if err != nil {
+		return fmt.Errorf("lift expired restrictions: %w", err)
+	}
+
+	var errs []error
+
+	for _, participant := range participants {
+		err = l.prod.Produce(ctx, entity.ParticipantEvent{
+			Type: entity.RestrictedParticipant,
+			ChatID: entity.ChatID{
+				ID:   participant.GroupID,
+				Type: entity.GroupChatType,
+			},
+			UserID: participant.UserID,
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("produce group participant event: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
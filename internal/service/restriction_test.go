@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Chatyx/backend/internal/entity"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestrictionLifter_Lift(t *testing.T) {
+	now := time.Now()
+
+	testCases := []struct {
+		name          string
+		mockBehavior  func(repo *MockGroupRestrictionRepository, prod *MockGroupParticipantEventProducer)
+		expectedError error
+	}{
+		{
+			name: "Successful",
+			mockBehavior: func(repo *MockGroupRestrictionRepository, prod *MockGroupParticipantEventProducer) {
+				repo.On("LiftExpiredRestrictions", mock.Anything, now).Return([]entity.GroupParticipant{
+					{GroupID: 1, UserID: 2, Role: entity.MemberRole, Status: entity.JoinedStatus},
+					{GroupID: 3, UserID: 4, Role: entity.MemberRole, Status: entity.LeftStatus},
+				}, nil)
+				prod.On("Produce", mock.Anything, entity.ParticipantEvent{
+					Type:   entity.RestrictedParticipant,
+					ChatID: entity.ChatID{ID: 1, Type: entity.GroupChatType},
+					UserID: 2,
+				}).Return(nil)
+				prod.On("Produce", mock.Anything, entity.ParticipantEvent{
+					Type:   entity.RestrictedParticipant,
+					ChatID: entity.ChatID{ID: 3, Type: entity.GroupChatType},
+					UserID: 4,
+				}).Return(nil)
+			},
+		},
+		{
+			name: "Failed event doesn't prevent the others",
+			mockBehavior: func(repo *MockGroupRestrictionRepository, prod *MockGroupParticipantEventProducer) {
+				repo.On("LiftExpiredRestrictions", mock.Anything, now).Return([]entity.GroupParticipant{
+					{GroupID: 1, UserID: 2, Role: entity.MemberRole, Status: entity.JoinedStatus},
+					{GroupID: 3, UserID: 4, Role: entity.MemberRole, Status: entity.LeftStatus},
+				}, nil)
+				prod.On("Produce", mock.Anything, mock.Anything).Return(errUnexpected).Once()
+				prod.On("Produce", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			expectedError: errUnexpected,
+		},
+		{
+			name: "Unexpected error while lifting restrictions",
+			mockBehavior: func(repo *MockGroupRestrictionRepository, prod *MockGroupParticipantEventProducer) {
+				repo.On("LiftExpiredRestrictions", mock.Anything, now).Return(nil, errUnexpected)
+			},
+			expectedError: errUnexpected,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			repo := NewMockGroupRestrictionRepository(t)
+			prod := NewMockGroupParticipantEventProducer(t)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(repo, prod)
+			}
+
+			lifter := NewRestrictionLifter(RestrictionLifterConfig{
+				Repository:    repo,
+				EventProducer: prod,
+			})
+
+			err := lifter.Lift(context.Background(), now)
+			if testCase.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
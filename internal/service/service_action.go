@@ -0,0 +1,185 @@
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Chatyx/backend/internal/entity"
+)
+
+// Group settings reported by service messages about setting changes.
+const (
+	onlyAdminsCanPostSetting = "only_admins_can_post"
+	slowModeIntervalSetting  = "slow_mode_interval"
+	membersCanInviteSetting  = "members_can_invite"
+)
+
+func userServiceAction(actionType entity.ServiceActionType, userID int) entity.ServiceAction {
+	return entity.ServiceAction{
+		Type:   actionType,
+		Params: map[string]string{entity.UserIDServiceParam: strconv.Itoa(userID)},
+	}
+}
+
+func usersServiceAction(actionType entity.ServiceActionType, userIDs []int) entity.ServiceAction {
+	ids := make([]string, len(userIDs))
+	for i, userID := range userIDs {
+		ids[i] = strconv.Itoa(userID)
+	}
+
+	return entity.ServiceAction{
+		Type:   actionType,
+		Params: map[string]string{entity.UserIDsServiceParam: strings.Join(ids, ",")},
+	}
+}
+
+func statusChangeAction(curUserID, userID int, from, to entity.GroupParticipantStatus, restrictedUntil *time.Time) entity.ServiceAction {
+	if curUserID == userID {
+		if to == entity.JoinedStatus {
+			return userServiceAction(entity.ParticipantReturnedServiceAction, userID)
+		}
+		return userServiceAction(entity.ParticipantLeftServiceAction, userID)
+	}
+
+	var action entity.ServiceAction
+
+	switch to {
+	case entity.JoinedStatus:
+		if from == entity.MutedStatus {
+			return userServiceAction(entity.ParticipantUnmutedServiceAction, userID)
+		}
+		return userServiceAction(entity.ParticipantReturnedServiceAction, userID)
+	case entity.MutedStatus:
+		action = userServiceAction(entity.ParticipantMutedServiceAction, userID)
+	case entity.BannedStatus:
+		action = userServiceAction(entity.ParticipantBannedServiceAction, userID)
+	case entity.LeftStatus:
+		return userServiceAction(entity.ParticipantUnbannedServiceAction, userID)
+	default:
+		return userServiceAction(entity.ParticipantKickedServiceAction, userID)
+	}
+
+	if restrictedUntil != nil {
+		action.Params[entity.UntilServiceParam] = restrictedUntil.UTC().Format(time.RFC3339)
+	}
+	return action
+}
+
+func settingChangeAction(setting, value string) entity.ServiceAction {
+	return entity.ServiceAction{
+		Type: entity.GroupSettingChangedServiceAction,
+		Params: map[string]string{
+			entity.SettingServiceParam:      setting,
+			entity.SettingValueServiceParam: value,
+		},
+	}
+}
+
+func settingsChangeActions(prev, cur entity.GroupSettings) []entity.ServiceAction {
+	var actions []entity.ServiceAction
+
+	if prev.OnlyAdminsCanPost != cur.OnlyAdminsCanPost {
+		actions = append(actions, settingChangeAction(onlyAdminsCanPostSetting, strconv.FormatBool(cur.OnlyAdminsCanPost)))
+	}
+
+	if prev.SlowModeInterval != cur.SlowModeInterval {
+		seconds := strconv.Itoa(int(cur.SlowModeInterval / time.Second))
+		actions = append(actions, settingChangeAction(slowModeIntervalSetting, seconds))
+	}
+
+	if prev.MembersCanInvite != cur.MembersCanInvite {
+		actions = append(actions, settingChangeAction(membersCanInviteSetting, strconv.FormatBool(cur.MembersCanInvite)))
+	}
+
+	return actions
+}
+
+// serviceActionContent renders the text of the service message about the action,
+// so clients which don't know the action can show the message as it is.
+func serviceActionContent(actorID int, action entity.ServiceAction) string {
+	params := action.Params
+	userID := params[entity.UserIDServiceParam]
+
+	switch action.Type {
+	case entity.ParticipantInvitedServiceAction:
+		if userIDs, ok := params[entity.UserIDsServiceParam]; ok {
+			return fmt.Sprintf("User %d invited users %s", actorID, strings.ReplaceAll(userIDs, ",", ", "))
+		}
+		return fmt.Sprintf("User %d invited user %s", actorID, userID)
+	case entity.ParticipantJoinedServiceAction:
+		return fmt.Sprintf("User %d joined the group", actorID)
+	case entity.ParticipantReturnedServiceAction:
+		if userID == strconv.Itoa(actorID) {
+			return fmt.Sprintf("User %d returned to the group", actorID)
+		}
+		return fmt.Sprintf("User %d returned user %s to the group", actorID, userID)
+	case entity.ParticipantLeftServiceAction:
+		return fmt.Sprintf("User %d left the group", actorID)
+	case entity.ParticipantKickedServiceAction:
+		return fmt.Sprintf("User %d kicked user %s", actorID, userID)
+	case entity.ParticipantMutedServiceAction:
+		return fmt.Sprintf("User %d muted user %s%s", actorID, userID, untilContent(params))
+	case entity.ParticipantUnmutedServiceAction:
+		return fmt.Sprintf("User %d unmuted user %s", actorID, userID)
+	case entity.ParticipantBannedServiceAction:
+		return fmt.Sprintf("User %d banned user %s%s", actorID, userID, untilContent(params))
+	case entity.ParticipantUnbannedServiceAction:
+		return fmt.Sprintf("User %d lifted the ban of user %s", actorID, userID)
+	case entity.JoinRequestedServiceAction:
+		return fmt.Sprintf("User %d requested to join the group", actorID)
+	case entity.JoinRequestApprovedServiceAction:
+		return fmt.Sprintf("User %d approved user %s to join the group", actorID, userID)
+	case entity.OwnershipTransferredServiceAction:
+		return fmt.Sprintf("User %d transferred ownership to user %s", actorID, userID)
+	case entity.OwnershipPassedServiceAction:
+		return fmt.Sprintf("User %s is the new owner of the group", userID)
+	case entity.GroupRenamedServiceAction:
+		return fmt.Sprintf("User %d renamed the group to %q", actorID, params[entity.NameServiceParam])
+	case entity.GroupSettingChangedServiceAction:
+		return settingChangeContent(actorID, params[entity.SettingServiceParam], params[entity.SettingValueServiceParam])
+	case entity.DialogBlockedServiceAction:
+		return fmt.Sprintf("User %d blocked user %s", actorID, userID)
+	case entity.DialogUnblockedServiceAction:
+		return fmt.Sprintf("User %d unblocked user %s", actorID, userID)
+	case entity.ChatSettingsChangedServiceAction:
+		return fmt.Sprintf("User %d changed settings of the chat", actorID)
+	case entity.ChatFoldersChangedServiceAction:
+		return fmt.Sprintf("User %d changed chat folders", actorID)
+	}
+
+	return fmt.Sprintf("User %d performed action %s", actorID, action.Type)
+}
+
+func untilContent(params map[string]string) string {
+	if until, ok := params[entity.UntilServiceParam]; ok {
+		return " until " + until
+	}
+	return ""
+}
+
+func settingChangeContent(actorID int, setting, value string) string {
+	enabled := value == strconv.FormatBool(true)
+
+	switch setting {
+	case onlyAdminsCanPostSetting:
+		if enabled {
+			return fmt.Sprintf("User %d allowed only admins to post", actorID)
+		}
+		return fmt.Sprintf("User %d allowed all participants to post", actorID)
+	case slowModeIntervalSetting:
+		seconds, _ := strconv.Atoi(value)
+		if seconds > 0 {
+			return fmt.Sprintf("User %d enabled slow mode with interval %s", actorID, time.Duration(seconds)*time.Second)
+		}
+		return fmt.Sprintf("User %d disabled slow mode", actorID)
+	case membersCanInviteSetting:
+		if enabled {
+			return fmt.Sprintf("User %d allowed members to invite", actorID)
+		}
+		return fmt.Sprintf("User %d forbade members to invite", actorID)
+	}
+
+	return fmt.Sprintf("User %d changed setting %s to %s", actorID, setting, value)
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Chatyx/backend/internal/entity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusChangeAction(t *testing.T) {
+	until := time.Date(2024, 4, 14, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name            string
+		curUserID       int
+		from            entity.GroupParticipantStatus
+		to              entity.GroupParticipantStatus
+		restrictedUntil *time.Time
+		expected        entity.ServiceAction
+	}{
+		{
+			name:      "Left by itself",
+			curUserID: 2,
+			from:      entity.JoinedStatus,
+			to:        entity.LeftStatus,
+			expected:  userServiceAction(entity.ParticipantLeftServiceAction, 2),
+		},
+		{
+			name:      "Returned by itself",
+			curUserID: 2,
+			from:      entity.LeftStatus,
+			to:        entity.JoinedStatus,
+			expected:  userServiceAction(entity.ParticipantReturnedServiceAction, 2),
+		},
+		{
+			name:      "Kicked",
+			curUserID: 1,
+			from:      entity.JoinedStatus,
+			to:        entity.KickedStatus,
+			expected:  userServiceAction(entity.ParticipantKickedServiceAction, 2),
+		},
+		{
+			name:            "Muted until the time",
+			curUserID:       1,
+			from:            entity.JoinedStatus,
+			to:              entity.MutedStatus,
+			restrictedUntil: &until,
+			expected: entity.ServiceAction{
+				Type: entity.ParticipantMutedServiceAction,
+				Params: map[string]string{
+					entity.UserIDServiceParam: "2",
+					entity.UntilServiceParam:  "2024-04-14T12:00:00Z",
+				},
+			},
+		},
+		{
+			name:      "Unmuted",
+			curUserID: 1,
+			from:      entity.MutedStatus,
+			to:        entity.JoinedStatus,
+			expected:  userServiceAction(entity.ParticipantUnmutedServiceAction, 2),
+		},
+		{
+			name:      "Unbanned",
+			curUserID: 1,
+			from:      entity.BannedStatus,
+			to:        entity.LeftStatus,
+			expected:  userServiceAction(entity.ParticipantUnbannedServiceAction, 2),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := statusChangeAction(testCase.curUserID, 2, testCase.from, testCase.to, testCase.restrictedUntil)
+			assert.Equal(t, testCase.expected, got)
+		})
+	}
+}
+
+func TestServiceActionContent(t *testing.T) {
+	testCases := []struct {
+		name     string
+		action   entity.ServiceAction
+		expected string
+	}{
+		{
+			name:     "Invited users",
+			action:   usersServiceAction(entity.ParticipantInvitedServiceAction, []int{2, 5}),
+			expected: "User 1 invited users 2, 5",
+		},
+		{
+			name: "Muted user",
+			action: entity.ServiceAction{
+				Type: entity.ParticipantMutedServiceAction,
+				Params: map[string]string{
+					entity.UserIDServiceParam: "2",
+					entity.UntilServiceParam:  "2024-04-14T12:00:00Z",
+				},
+			},
+			expected: "User 1 muted user 2 until 2024-04-14T12:00:00Z",
+		},
+		{
+			name: "Renamed group",
+			action: entity.ServiceAction{
+				Type: entity.GroupRenamedServiceAction,
+				Params: map[string]string{
+					entity.NameServiceParam:     "New name",
+					entity.PrevNameServiceParam: "Old name",
+				},
+			},
+			expected: `User 1 renamed the group to "New name"`,
+		},
+		{
+			name:     "Enabled slow mode",
+			action:   settingChangeAction(slowModeIntervalSetting, "30"),
+			expected: "User 1 enabled slow mode with interval 30s",
+		},
+		{
+			name:     "Blocked user",
+			action:   userServiceAction(entity.DialogBlockedServiceAction, 2),
+			expected: "User 1 blocked user 2",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, serviceActionContent(1, testCase.action))
+		})
+	}
+}
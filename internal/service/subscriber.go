@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/ctxutil"
+)
+
+//go:generate mockery --inpackage --testonly --case underscore --name ChannelSubscriberRepository
+type ChannelSubscriberRepository interface {
+	Get(ctx context.Context, channelID, userID int, withLock bool) (entity.ChannelSubscriber, error)
+	CountAdmins(ctx context.Context, channelID int, withLock bool) (int, error)
+	Create(ctx context.Context, subscriber *entity.ChannelSubscriber) error
+	Update(ctx context.Context, subscriber *entity.ChannelSubscriber) error
+	Delete(ctx context.Context, channelID, userID int) error
+}
+
+type ChannelSubscriberConfig struct {
+	TxManager     TransactionManager
+	Repository    ChannelSubscriberRepository
+	EventProducer GroupParticipantEventProducer
+}
+
+// ChannelSubscriber manages subscriptions to channels. Anyone can subscribe to a channel,
+// so the number of subscribers isn't limited unlike participants of groups.
+type ChannelSubscriber struct {
+	txm  TransactionManager
+	repo ChannelSubscriberRepository
+	prod GroupParticipantEventProducer
+}
+
+func NewChannelSubscriber(conf ChannelSubscriberConfig) *ChannelSubscriber {
+	return &ChannelSubscriber{
+		txm:  conf.TxManager,
+		repo: conf.Repository,
+		prod: conf.EventProducer,
+	}
+}
+
+func (s *ChannelSubscriber) Subscribe(ctx context.Context, channelID int) (entity.ChannelSubscriber, error) {
+	subscriber := entity.ChannelSubscriber{
+		ChannelID: channelID,
+		UserID:    ctxutil.UserIDFromContext(ctx).ToInt(),
+	}
+	if err := s.repo.Create(ctx, &subscriber); err != nil {
+		return entity.ChannelSubscriber{}, fmt.Errorf("create channel subscriber: %w", err)
+	}
+
+	if err := s.produceEvent(ctx, entity.AddedParticipant, channelID, subscriber.UserID); err != nil {
+		return entity.ChannelSubscriber{}, err
+	}
+	return subscriber, nil
+}
+
+// Unsubscribe unsubscribes the current user from the channel.
+// The last admin can't unsubscribe, otherwise nobody could post to the channel.
+func (s *ChannelSubscriber) Unsubscribe(ctx context.Context, channelID int) error {
+	curUserID := ctxutil.UserIDFromContext(ctx).ToInt()
+
+	err := s.txm.Do(ctx, func(ctx context.Context) error {
+		subscriber, err := s.getCurrent(ctx, channelID, curUserID, true)
+		if err != nil {
+			return err
+		}
+
+		if subscriber.IsAdmin {
+			if err = s.checkNotLastAdmin(ctx, channelID); err != nil {
+				return err
+			}
+		}
+
+		if err = s.repo.Delete(ctx, channelID, curUserID); err != nil {
+			return fmt.Errorf("delete channel subscriber: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("call transaction manager: %w", err)
+	}
+
+	return s.produceEvent(ctx, entity.RemovedParticipant, channelID, curUserID)
+}
+
+// SetAdmin grants or revokes rights of the subscriber to post to the channel and manage it.
+// Only admins can do it and the last admin can't be revoked.
+func (s *ChannelSubscriber) SetAdmin(ctx context.Context, channelID, userID int, isAdmin bool) error {
+	curUserID := ctxutil.UserIDFromContext(ctx).ToInt()
+
+	var changed bool
+
+	err := s.txm.Do(ctx, func(ctx context.Context) error {
+		// The current subscriber isn't locked, so two admins revoking each other
+		// don't deadlock. The channel lock taken to count admins serializes them.
+		curSubscriber, err := s.getCurrent(ctx, channelID, curUserID, false)
+		if err != nil {
+			return err
+		}
+
+		if !curSubscriber.IsAdmin {
+			return fmt.Errorf("%w: current subscriber isn't an admin of the channel", entity.ErrForbiddenPerformAction)
+		}
+
+		subscriber := curSubscriber
+		if userID != curUserID {
+			subscriber, err = s.repo.Get(ctx, channelID, userID, true)
+			if err != nil {
+				return fmt.Errorf("get channel subscriber: %w", err)
+			}
+		}
+
+		if subscriber.IsAdmin == isAdmin {
+			return nil
+		}
+
+		if !isAdmin {
+			if err = s.checkNotLastAdmin(ctx, channelID); err != nil {
+				return err
+			}
+		}
+
+		subscriber.IsAdmin = isAdmin
+		if err = s.repo.Update(ctx, &subscriber); err != nil {
+			return fmt.Errorf("update channel subscriber: %w", err)
+		}
+
+		changed = true
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("call transaction manager: %w", err)
+	}
+
+	if !changed {
+		return nil
+	}
+	return s.produceEvent(ctx, entity.RestrictedParticipant, channelID, userID)
+}
+
+func (s *ChannelSubscriber) getCurrent(ctx context.Context, channelID, curUserID int, withLock bool) (entity.ChannelSubscriber, error) {
+	subscriber, err := s.repo.Get(ctx, channelID, curUserID, withLock)
+	if err != nil {
+		if errors.Is(err, entity.ErrChannelSubscriberNotFound) {
+			return entity.ChannelSubscriber{}, fmt.Errorf("%w: current user isn't subscribed to the channel", entity.ErrChannelNotFound)
+		}
+		return entity.ChannelSubscriber{}, fmt.Errorf("get current channel subscriber: %w", err)
+	}
+
+	return subscriber, nil
+}
+
+func (s *ChannelSubscriber) checkNotLastAdmin(ctx context.Context, channelID int) error {
+	count, err := s.repo.CountAdmins(ctx, channelID, true)
+	if err != nil {
+		return fmt.Errorf("count channel admins: %w", err)
+	}
+
+	if count <= 1 {
+		return entity.ErrLastChannelAdminLeaving
+	}
+	return nil
+}
+
+func (s *ChannelSubscriber) produceEvent(ctx context.Context, eventType entity.ParticipantEventType, channelID, userID int) error {
+	event := entity.ParticipantEvent{
+		Type: eventType,
+		ChatID: entity.ChatID{
+			ID:   channelID,
+			Type: entity.ChannelChatType,
+		},
+		UserID: userID,
+	}
+	if err := s.prod.Produce(ctx, event); err != nil {
+		return fmt.Errorf("produce channel subscriber event: %w", err)
+	}
+	return nil
+}
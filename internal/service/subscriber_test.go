@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/ctxutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelSubscriber_Unsubscribe(t *testing.T) {
+	runTx := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	}
+
+	testCases := []struct {
+		name          string
+		mockBehavior  func(repo *MockChannelSubscriberRepository, prod *MockGroupParticipantEventProducer)
+		expectedError error
+	}{
+		{
+			name: "Successful",
+			mockBehavior: func(repo *MockChannelSubscriberRepository, prod *MockGroupParticipantEventProducer) {
+				repo.On("Get", mock.Anything, 1, 1, true).Return(entity.ChannelSubscriber{ChannelID: 1, UserID: 1}, nil)
+				repo.On("Delete", mock.Anything, 1, 1).Return(nil)
+				prod.On("Produce", mock.Anything, entity.ParticipantEvent{
+					Type:   entity.RemovedParticipant,
+					ChatID: entity.ChatID{ID: 1, Type: entity.ChannelChatType},
+					UserID: 1,
+				}).Return(nil)
+			},
+		},
+		{
+			name: "Successful admin while there are other admins",
+			mockBehavior: func(repo *MockChannelSubscriberRepository, prod *MockGroupParticipantEventProducer) {
+				repo.On("Get", mock.Anything, 1, 1, true).Return(entity.ChannelSubscriber{ChannelID: 1, UserID: 1, IsAdmin: true}, nil)
+				repo.On("CountAdmins", mock.Anything, 1, true).Return(2, nil)
+				repo.On("Delete", mock.Anything, 1, 1).Return(nil)
+				prod.On("Produce", mock.Anything, mock.Anything).Return(nil)
+			},
+		},
+		{
+			name: "Last admin",
+			mockBehavior: func(repo *MockChannelSubscriberRepository, _ *MockGroupParticipantEventProducer) {
+				repo.On("Get", mock.Anything, 1, 1, true).Return(entity.ChannelSubscriber{ChannelID: 1, UserID: 1, IsAdmin: true}, nil)
+				repo.On("CountAdmins", mock.Anything, 1, true).Return(1, nil)
+			},
+			expectedError: entity.ErrLastChannelAdminLeaving,
+		},
+		{
+			name: "Not subscribed",
+			mockBehavior: func(repo *MockChannelSubscriberRepository, _ *MockGroupParticipantEventProducer) {
+				repo.On("Get", mock.Anything, 1, 1, true).Return(entity.ChannelSubscriber{}, entity.ErrChannelSubscriberNotFound)
+			},
+			expectedError: entity.ErrChannelNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			txm := NewMockTransactionManager(t)
+			repo := NewMockChannelSubscriberRepository(t)
+			prod := NewMockGroupParticipantEventProducer(t)
+
+			txm.On("Do", mock.Anything, mock.Anything).Return(runTx)
+			testCase.mockBehavior(repo, prod)
+
+			service := NewChannelSubscriber(ChannelSubscriberConfig{
+				TxManager:     txm,
+				Repository:    repo,
+				EventProducer: prod,
+			})
+			ctx := ctxutil.WithUserID(context.Background(), "1")
+
+			err := service.Unsubscribe(ctx, 1)
+			if testCase.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestChannelSubscriber_SetAdmin(t *testing.T) {
+	runTx := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	}
+
+	testCases := []struct {
+		name          string
+		curIsAdmin    bool
+		userID        int
+		isAdmin       bool
+		mockBehavior  func(repo *MockChannelSubscriberRepository, prod *MockGroupParticipantEventProducer)
+		expectedError error
+	}{
+		{
+			name:       "Grant admin",
+			curIsAdmin: true,
+			userID:     2,
+			isAdmin:    true,
+			mockBehavior: func(repo *MockChannelSubscriberRepository, prod *MockGroupParticipantEventProducer) {
+				repo.On("Update", mock.Anything, &entity.ChannelSubscriber{ChannelID: 1, UserID: 2, IsAdmin: true}).Return(nil)
+				prod.On("Produce", mock.Anything, entity.ParticipantEvent{
+					Type:   entity.RestrictedParticipant,
+					ChatID: entity.ChatID{ID: 1, Type: entity.ChannelChatType},
+					UserID: 2,
+				}).Return(nil)
+			},
+		},
+		{
+			name:       "Grant admin to admin",
+			curIsAdmin: true,
+			userID:     1,
+			isAdmin:    true,
+		},
+		{
+			name:       "Revoke the last admin",
+			curIsAdmin: true,
+			userID:     1,
+			isAdmin:    false,
+			mockBehavior: func(repo *MockChannelSubscriberRepository, _ *MockGroupParticipantEventProducer) {
+				repo.On("CountAdmins", mock.Anything, 1, true).Return(1, nil)
+			},
+			expectedError: entity.ErrLastChannelAdminLeaving,
+		},
+		{
+			name:          "Grant admin without admin rights",
+			curIsAdmin:    false,
+			userID:        2,
+			isAdmin:       true,
+			expectedError: entity.ErrForbiddenPerformAction,
+		},
+		{
+			name:       "Grant admin to non-subscriber",
+			curIsAdmin: true,
+			userID:     3,
+			isAdmin:    true,
+			mockBehavior: func(repo *MockChannelSubscriberRepository, _ *MockGroupParticipantEventProducer) {
+				repo.On("Get", mock.Anything, 1, 3, true).Return(entity.ChannelSubscriber{}, entity.ErrChannelSubscriberNotFound)
+			},
+			expectedError: entity.ErrChannelSubscriberNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			txm := NewMockTransactionManager(t)
+			repo := NewMockChannelSubscriberRepository(t)
+			prod := NewMockGroupParticipantEventProducer(t)
+
+			txm.On("Do", mock.Anything, mock.Anything).Return(runTx)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(repo, prod)
+			}
+			repo.On("Get", mock.Anything, 1, 1, false).Return(entity.ChannelSubscriber{
+				ChannelID: 1,
+				UserID:    1,
+				IsAdmin:   testCase.curIsAdmin,
+			}, nil)
+			repo.On("Get", mock.Anything, 1, 2, true).Return(entity.ChannelSubscriber{ChannelID: 1, UserID: 2}, nil).Maybe()
+
+			service := NewChannelSubscriber(ChannelSubscriberConfig{
+				TxManager:     txm,
+				Repository:    repo,
+				EventProducer: prod,
+			})
+			ctx := ctxutil.WithUserID(context.Background(), "1")
+
+			err := service.SetAdmin(ctx, 1, testCase.userID, testCase.isAdmin)
+			if testCase.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
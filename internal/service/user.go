@@ -10,42 +10,79 @@ import (
 	"github.com/Chatyx/backend/internal/dto"
 	"github.com/Chatyx/backend/internal/entity"
 	"github.com/Chatyx/backend/pkg/hasher"
+	"github.com/Chatyx/backend/pkg/log"
+	"github.com/Chatyx/backend/pkg/token"
 )
 
+//go:generate mockery --inpackage --testonly --case underscore --name UserRepository
 type UserRepository interface {
-	List(ctx context.Context) ([]entity.User, error)
+	List(ctx context.Context, obj dto.UserList) ([]entity.User, error)
 	Create(ctx context.Context, user *entity.User) error
 	GetByID(ctx context.Context, id int) (entity.User, error)
 	GetByUsername(ctx context.Context, username string) (entity.User, error)
 	Update(ctx context.Context, user *entity.User) error
 	UpdatePassword(ctx context.Context, userID int, pwdHash string) error
+	VerifyEmail(ctx context.Context, userID int, email string, verifiedAt time.Time) error
 	Delete(ctx context.Context, id int) error
 }
 
+//go:generate mockery --inpackage --testonly --case underscore --name SessionRepository
 type SessionRepository interface {
 	DeleteAllByUserID(ctx context.Context, id string) error
 }
 
+//go:generate mockery --inpackage --testonly --case underscore --name EmailVerificationRepository
+type EmailVerificationRepository interface {
+	Create(ctx context.Context, verification entity.EmailVerification) error
+	ExistsUnexpired(ctx context.Context, userID int, now time.Time) (bool, error)
+	GetWithDelete(ctx context.Context, token string) (entity.EmailVerification, error)
+}
+
+//go:generate mockery --inpackage --testonly --case underscore --name Mailer
+type Mailer interface {
+	Send(ctx context.Context, mail entity.Mail) error
+}
+
+const verificationTokenSize = 32
+
 type UserConfig struct {
-	UserRepository    UserRepository
-	SessionRepository SessionRepository
+	UserRepository         UserRepository
+	SessionRepository      SessionRepository
+	VerificationRepository EmailVerificationRepository
+	TxManager              TransactionManager
+	Mailer                 Mailer
+	VerificationTokenTTL   time.Duration
+	// RequireVerifiedEmail forbids users to log in until their email is verified,
+	// an attempt to log in sends a new verification token if the previous one is expired.
+	RequireVerifiedEmail bool
 }
 
 type User struct {
-	userRepo UserRepository
-	sessRepo SessionRepository
-	hasher   hasher.BCrypt
+	userRepo             UserRepository
+	sessRepo             SessionRepository
+	verificationRepo     EmailVerificationRepository
+	txm                  TransactionManager
+	mailer               Mailer
+	hasher               hasher.BCrypt
+	tokenGen             token.Hex
+	verificationTokenTTL time.Duration
+	requireVerifiedEmail bool
 }
 
 func NewUser(conf UserConfig) *User {
 	return &User{
-		userRepo: conf.UserRepository,
-		sessRepo: conf.SessionRepository,
+		userRepo:             conf.UserRepository,
+		sessRepo:             conf.SessionRepository,
+		verificationRepo:     conf.VerificationRepository,
+		txm:                  conf.TxManager,
+		mailer:               conf.Mailer,
+		verificationTokenTTL: conf.VerificationTokenTTL,
+		requireVerifiedEmail: conf.RequireVerifiedEmail,
 	}
 }
 
-func (u *User) List(ctx context.Context) ([]entity.User, error) {
-	users, err := u.userRepo.List(ctx)
+func (u *User) List(ctx context.Context, obj dto.UserList) ([]entity.User, error) {
+	users, err := u.userRepo.List(ctx, obj)
 	if err != nil {
 		return nil, fmt.Errorf("list of users: %w", err)
 	}
@@ -70,9 +107,21 @@ func (u *User) Create(ctx context.Context, obj dto.UserCreate) (entity.User, err
 		CreatedAt: time.Now(),
 	}
 
-	if err = u.userRepo.Create(ctx, &user); err != nil {
-		return entity.User{}, fmt.Errorf("create user: %w", err)
+	var mail entity.Mail
+
+	err = u.txm.Do(ctx, func(ctx context.Context) error {
+		if err := u.userRepo.Create(ctx, &user); err != nil {
+			return fmt.Errorf("create user: %w", err)
+		}
+
+		mail, err = u.createVerification(ctx, user)
+		return err
+	})
+	if err != nil {
+		return entity.User{}, fmt.Errorf("call transaction manager: %w", err)
 	}
+
+	u.sendVerificationAfterCommit(ctx, mail)
 	return user, nil
 }
 
@@ -96,8 +145,44 @@ func (u *User) Update(ctx context.Context, obj dto.UserUpdate) (entity.User, err
 		Bio:       obj.Bio,
 	}
 
-	if err := u.userRepo.Update(ctx, &user); err != nil {
-		return entity.User{}, fmt.Errorf("update user: %w", err)
+	var (
+		mail         entity.Mail
+		emailChanged bool
+	)
+
+	err := u.txm.Do(ctx, func(ctx context.Context) error {
+		prevUser, err := u.userRepo.GetByID(ctx, obj.ID)
+		if err != nil {
+			return fmt.Errorf("get user by id: %w", err)
+		}
+
+		if err = u.userRepo.Update(ctx, &user); err != nil {
+			return fmt.Errorf("update user: %w", err)
+		}
+
+		if emailChanged = user.Email != prevUser.Email; !emailChanged {
+			return nil
+		}
+
+		mail, err = u.createVerification(ctx, user)
+		return err
+	})
+	if err != nil {
+		return entity.User{}, fmt.Errorf("call transaction manager: %w", err)
+	}
+
+	if !emailChanged {
+		return user, nil
+	}
+
+	u.sendVerificationAfterCommit(ctx, mail)
+
+	// The changed email isn't verified, so the user is logged out everywhere
+	// if logging in requires a verified email. Access tokens expire on their own.
+	if u.requireVerifiedEmail {
+		if err = u.sessRepo.DeleteAllByUserID(ctx, strconv.Itoa(user.ID)); err != nil {
+			return entity.User{}, fmt.Errorf("delete all user sessions: %w", err)
+		}
 	}
 	return user, nil
 }
@@ -136,9 +221,133 @@ func (u *User) CheckPassword(ctx context.Context, username, password string) (us
 	if !u.hasher.CompareHashAndPassword(user.PwdHash, password) {
 		return "", false, nil
 	}
+
+	if u.requireVerifiedEmail && !user.IsEmailVerified() {
+		if err = u.resendExpiredVerification(ctx, user); err != nil {
+			return "", false, err
+		}
+		return "", false, fmt.Errorf("%w: the verification token is sent to the email", entity.ErrEmailNotVerified)
+	}
 	return strconv.Itoa(user.ID), true, nil
 }
 
+// ResendVerification sends a new verification token to the email of the user
+// if the email isn't verified yet, e.g. when the previous mail is lost.
+func (u *User) ResendVerification(ctx context.Context, userID int) error {
+	user, err := u.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("get user by id: %w", err)
+	}
+
+	if user.IsEmailVerified() {
+		return fmt.Errorf("%w: the email is already verified", entity.ErrForbiddenPerformAction)
+	}
+
+	var mail entity.Mail
+
+	err = u.txm.Do(ctx, func(ctx context.Context) error {
+		mail, err = u.createVerification(ctx, user)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("call transaction manager: %w", err)
+	}
+
+	if err = u.mailer.Send(ctx, mail); err != nil {
+		return fmt.Errorf("send email verification: %w", err)
+	}
+	return nil
+}
+
+// resendExpiredVerification sends a new verification token on an attempt to log in only if
+// the previous one is expired, so repeated attempts don't replace the token which is already
+// sent and don't flood the mailbox. A failure to send the mail is only logged.
+func (u *User) resendExpiredVerification(ctx context.Context, user entity.User) error {
+	var mail entity.Mail
+
+	err := u.txm.Do(ctx, func(ctx context.Context) error {
+		exists, err := u.verificationRepo.ExistsUnexpired(ctx, user.ID, time.Now())
+		if err != nil {
+			return fmt.Errorf("check unexpired email verification: %w", err)
+		}
+		if exists {
+			return nil
+		}
+
+		mail, err = u.createVerification(ctx, user)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("call transaction manager: %w", err)
+	}
+
+	if mail.To != "" {
+		u.sendVerificationAfterCommit(ctx, mail)
+	}
+	return nil
+}
+
+// VerifyEmail verifies the email which the token is sent to. The token is invalid
+// if it's expired, already used or the user has changed the email since.
+func (u *User) VerifyEmail(ctx context.Context, token string) error {
+	return u.txm.Do(ctx, func(ctx context.Context) error {
+		verification, err := u.verificationRepo.GetWithDelete(ctx, token)
+		if err != nil {
+			return fmt.Errorf("get email verification: %w", err)
+		}
+
+		now := time.Now()
+		if verification.IsExpired(now) {
+			return fmt.Errorf("%w: the token is expired", entity.ErrInvalidVerificationToken)
+		}
+
+		err = u.userRepo.VerifyEmail(ctx, verification.UserID, verification.Email, now)
+		if err != nil {
+			if errors.Is(err, entity.ErrUserNotFound) {
+				return fmt.Errorf("%w: the user is deleted or the email is changed", entity.ErrInvalidVerificationToken)
+			}
+			return fmt.Errorf("verify user email: %w", err)
+		}
+		return nil
+	})
+}
+
+// createVerification creates a new verification token of the user and returns the mail with it.
+// The mail is sent after the token is committed, so the mail server doesn't hold the transaction.
+func (u *User) createVerification(ctx context.Context, user entity.User) (entity.Mail, error) {
+	verificationToken, err := u.tokenGen.Token(verificationTokenSize)
+	if err != nil {
+		return entity.Mail{}, fmt.Errorf("create email verification token: %w", err)
+	}
+
+	now := time.Now()
+	verification := entity.EmailVerification{
+		Token:     verificationToken,
+		UserID:    user.ID,
+		Email:     user.Email,
+		ExpiresAt: now.Add(u.verificationTokenTTL),
+		CreatedAt: now,
+	}
+	if err = u.verificationRepo.Create(ctx, verification); err != nil {
+		return entity.Mail{}, fmt.Errorf("create email verification: %w", err)
+	}
+
+	return entity.Mail{
+		To:      user.Email,
+		Subject: "Verify your email",
+		Body: fmt.Sprintf("Hi %s,\n\nYour email verification token is %s\nIt expires at %s.\n",
+			user.Username, verification.Token, verification.ExpiresAt.UTC().Format(time.RFC1123)),
+	}, nil
+}
+
+// sendVerificationAfterCommit sends the mail with the verification token once the change
+// of the user is committed, so a failure is only logged. The user can request a new token.
+func (u *User) sendVerificationAfterCommit(ctx context.Context, mail entity.Mail) {
+	if err := u.mailer.Send(ctx, mail); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to send email verification")
+	}
+}
+
 func (u *User) Delete(ctx context.Context, id int) error {
 	if err := u.userRepo.Delete(ctx, id); err != nil {
 		return fmt.Errorf("delete user: %w", err)
@@ -0,0 +1,336 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Chatyx/backend/internal/dto"
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/hasher"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUser_VerifyEmail(t *testing.T) {
+	const token = "0a1b2c3d"
+
+	testCases := []struct {
+		name          string
+		mockBehavior  func(userRepo *MockUserRepository, verificationRepo *MockEmailVerificationRepository)
+		expectedError error
+	}{
+		{
+			name: "Successful",
+			mockBehavior: func(userRepo *MockUserRepository, verificationRepo *MockEmailVerificationRepository) {
+				verificationRepo.On("GetWithDelete", mock.Anything, token).Return(entity.EmailVerification{
+					Token:     token,
+					UserID:    1,
+					Email:     "john1967@gmail.com",
+					ExpiresAt: time.Now().Add(time.Hour),
+				}, nil)
+				userRepo.On("VerifyEmail", mock.Anything, 1, "john1967@gmail.com", mock.AnythingOfType("time.Time")).Return(nil)
+			},
+		},
+		{
+			name: "Token is expired",
+			mockBehavior: func(_ *MockUserRepository, verificationRepo *MockEmailVerificationRepository) {
+				verificationRepo.On("GetWithDelete", mock.Anything, token).Return(entity.EmailVerification{
+					Token:     token,
+					UserID:    1,
+					Email:     "john1967@gmail.com",
+					ExpiresAt: time.Now().Add(-time.Minute),
+				}, nil)
+			},
+			expectedError: entity.ErrInvalidVerificationToken,
+		},
+		{
+			name: "Email is changed",
+			mockBehavior: func(userRepo *MockUserRepository, verificationRepo *MockEmailVerificationRepository) {
+				verificationRepo.On("GetWithDelete", mock.Anything, token).Return(entity.EmailVerification{
+					Token:     token,
+					UserID:    1,
+					Email:     "john1967@gmail.com",
+					ExpiresAt: time.Now().Add(time.Hour),
+				}, nil)
+				userRepo.On("VerifyEmail", mock.Anything, 1, "john1967@gmail.com", mock.AnythingOfType("time.Time")).
+					Return(entity.ErrUserNotFound)
+			},
+			expectedError: entity.ErrInvalidVerificationToken,
+		},
+		{
+			name: "Token isn't found",
+			mockBehavior: func(_ *MockUserRepository, verificationRepo *MockEmailVerificationRepository) {
+				verificationRepo.On("GetWithDelete", mock.Anything, token).
+					Return(entity.EmailVerification{}, entity.ErrInvalidVerificationToken)
+			},
+			expectedError: entity.ErrInvalidVerificationToken,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			txm := NewMockTransactionManager(t)
+			txm.On("Do", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+				return fn(ctx)
+			})
+
+			userRepo := NewMockUserRepository(t)
+			verificationRepo := NewMockEmailVerificationRepository(t)
+			testCase.mockBehavior(userRepo, verificationRepo)
+
+			service := NewUser(UserConfig{
+				UserRepository:         userRepo,
+				VerificationRepository: verificationRepo,
+				TxManager:              txm,
+			})
+
+			err := service.VerifyEmail(context.Background(), token)
+			if testCase.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestUser_Update(t *testing.T) {
+	testCases := []struct {
+		name         string
+		prevEmail    string
+		expectedMail bool
+		mailErr      error
+		requireEmail bool
+	}{
+		{
+			name:         "Email is changed",
+			prevEmail:    "john1940@gmail.com",
+			expectedMail: true,
+		},
+		{
+			name:         "Failed mail doesn't fail the committed change",
+			prevEmail:    "john1940@gmail.com",
+			expectedMail: true,
+			mailErr:      errUnexpected,
+		},
+		{
+			name:         "Email is changed when logging in requires a verified email",
+			prevEmail:    "john1940@gmail.com",
+			expectedMail: true,
+			requireEmail: true,
+		},
+		{
+			name:      "Email isn't changed",
+			prevEmail: "john1967@gmail.com",
+		},
+		{
+			name:         "Email isn't changed when logging in requires a verified email",
+			prevEmail:    "john1967@gmail.com",
+			requireEmail: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			inTx := false
+			txm := NewMockTransactionManager(t)
+			txm.On("Do", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+				inTx = true
+				defer func() { inTx = false }()
+				return fn(ctx)
+			})
+
+			userRepo := NewMockUserRepository(t)
+			userRepo.On("GetByID", mock.Anything, 1).Return(entity.User{ID: 1, Username: "john", Email: testCase.prevEmail}, nil)
+			userRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+			verificationRepo := NewMockEmailVerificationRepository(t)
+			mailer := NewMockMailer(t)
+			if testCase.expectedMail {
+				verificationRepo.On("Create", mock.Anything, mock.MatchedBy(func(verification entity.EmailVerification) bool {
+					return verification.UserID == 1 &&
+						verification.Email == "john1967@gmail.com" &&
+						len(verification.Token) == 2*verificationTokenSize &&
+						verification.ExpiresAt.Sub(verification.CreatedAt) == time.Hour
+				})).Return(nil)
+				// The mail is sent only after the transaction is committed.
+				mailer.On("Send", mock.Anything, mock.MatchedBy(func(mail entity.Mail) bool {
+					return mail.To == "john1967@gmail.com" && !inTx
+				})).Return(testCase.mailErr)
+			}
+
+			// Sessions are revoked only if the unverified email blocks logging in.
+			sessRepo := NewMockSessionRepository(t)
+			if testCase.expectedMail && testCase.requireEmail {
+				sessRepo.On("DeleteAllByUserID", mock.Anything, "1").Return(nil)
+			}
+
+			service := NewUser(UserConfig{
+				UserRepository:         userRepo,
+				SessionRepository:      sessRepo,
+				VerificationRepository: verificationRepo,
+				TxManager:              txm,
+				Mailer:                 mailer,
+				VerificationTokenTTL:   time.Hour,
+				RequireVerifiedEmail:   testCase.requireEmail,
+			})
+
+			user, err := service.Update(context.Background(), dto.UserUpdate{
+				ID:       1,
+				Username: "john",
+				Email:    "john1967@gmail.com",
+			})
+			require.NoError(t, err)
+			assert.Equal(t, "john1967@gmail.com", user.Email)
+		})
+	}
+}
+
+func TestUser_ResendVerification(t *testing.T) {
+	verifiedAt := time.Now()
+
+	testCases := []struct {
+		name          string
+		user          entity.User
+		expectedMail  bool
+		expectedError error
+	}{
+		{
+			name:         "Successful",
+			user:         entity.User{ID: 1, Username: "john", Email: "john1967@gmail.com"},
+			expectedMail: true,
+		},
+		{
+			name:          "Email is already verified",
+			user:          entity.User{ID: 1, Username: "john", Email: "john1967@gmail.com", EmailVerifiedAt: &verifiedAt},
+			expectedError: entity.ErrForbiddenPerformAction,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			txm := NewMockTransactionManager(t)
+			userRepo := NewMockUserRepository(t)
+			userRepo.On("GetByID", mock.Anything, 1).Return(testCase.user, nil)
+
+			verificationRepo := NewMockEmailVerificationRepository(t)
+			mailer := NewMockMailer(t)
+			if testCase.expectedMail {
+				txm.On("Do", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+				verificationRepo.On("Create", mock.Anything, mock.MatchedBy(func(verification entity.EmailVerification) bool {
+					return verification.UserID == 1 && verification.Email == "john1967@gmail.com"
+				})).Return(nil)
+				mailer.On("Send", mock.Anything, mock.MatchedBy(func(mail entity.Mail) bool {
+					return mail.To == "john1967@gmail.com"
+				})).Return(nil)
+			}
+
+			service := NewUser(UserConfig{
+				UserRepository:         userRepo,
+				VerificationRepository: verificationRepo,
+				TxManager:              txm,
+				Mailer:                 mailer,
+				VerificationTokenTTL:   time.Hour,
+			})
+
+			err := service.ResendVerification(context.Background(), 1)
+			if testCase.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestUser_CheckPassword(t *testing.T) {
+	verifiedAt := time.Now()
+	pwdHash, hashErr := hasher.BCrypt{}.Hash("qwerty12345")
+	require.NoError(t, hashErr)
+
+	testCases := []struct {
+		name          string
+		verifiedAt    *time.Time
+		mockBehavior  func(txm *MockTransactionManager, verificationRepo *MockEmailVerificationRepository, mailer *MockMailer)
+		expectedOK    bool
+		expectedError error
+	}{
+		{
+			name:       "Successful",
+			verifiedAt: &verifiedAt,
+			expectedOK: true,
+		},
+		{
+			name: "Unexpired token isn't replaced",
+			mockBehavior: func(txm *MockTransactionManager, verificationRepo *MockEmailVerificationRepository, mailer *MockMailer) {
+				verificationRepo.On("ExistsUnexpired", mock.Anything, 1, mock.Anything).Return(true, nil)
+			},
+			expectedError: entity.ErrEmailNotVerified,
+		},
+		{
+			name: "Expired token is replaced",
+			mockBehavior: func(txm *MockTransactionManager, verificationRepo *MockEmailVerificationRepository, mailer *MockMailer) {
+				verificationRepo.On("ExistsUnexpired", mock.Anything, 1, mock.Anything).Return(false, nil)
+				verificationRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+				mailer.On("Send", mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedError: entity.ErrEmailNotVerified,
+		},
+		{
+			name: "Failed mail doesn't fail the login attempt",
+			mockBehavior: func(txm *MockTransactionManager, verificationRepo *MockEmailVerificationRepository, mailer *MockMailer) {
+				verificationRepo.On("ExistsUnexpired", mock.Anything, 1, mock.Anything).Return(false, nil)
+				verificationRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+				mailer.On("Send", mock.Anything, mock.Anything).Return(errUnexpected)
+			},
+			expectedError: entity.ErrEmailNotVerified,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			txm := NewMockTransactionManager(t)
+			txm.On("Do", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+				return fn(ctx)
+			}).Maybe()
+
+			userRepo := NewMockUserRepository(t)
+			userRepo.On("GetByUsername", mock.Anything, "john").Return(entity.User{
+				ID:              1,
+				Username:        "john",
+				Email:           "john1967@gmail.com",
+				PwdHash:         pwdHash,
+				EmailVerifiedAt: testCase.verifiedAt,
+			}, nil)
+
+			verificationRepo := NewMockEmailVerificationRepository(t)
+			mailer := NewMockMailer(t)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(txm, verificationRepo, mailer)
+			}
+
+			service := NewUser(UserConfig{
+				UserRepository:         userRepo,
+				VerificationRepository: verificationRepo,
+				TxManager:              txm,
+				Mailer:                 mailer,
+				VerificationTokenTTL:   time.Hour,
+				RequireVerifiedEmail:   true,
+			})
+
+			userID, ok, err := service.CheckPassword(context.Background(), "john", "qwerty12345")
+			if testCase.expectedError == nil {
+				require.NoError(t, err)
+				assert.Equal(t, "1", userID)
+			} else {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			}
+			assert.Equal(t, testCase.expectedOK, ok)
+		})
+	}
+}
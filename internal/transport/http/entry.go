@@ -1,6 +1,7 @@
 package http
 
 import (
+	"expvar"
 	"fmt"
 	"net/http"
 	"runtime/debug"
@@ -22,6 +23,13 @@ type Controller interface {
 	Register(mux *httprouter.Router)
 }
 
+// PriorityController registers routes which can't live in the same router with the others
+// because of conflicts with their wildcards, e.g. /groups/join/:code and /groups/:group_id.
+// These routes are looked up before the others.
+type PriorityController interface {
+	RegisterPriority(mux *httprouter.Router)
+}
+
 type Config struct {
 	config.Server
 	Debug bool
@@ -47,13 +55,18 @@ type Config struct {
 //	@in							header
 //	@name						Authorization
 func NewServer(conf Config, cs ...Controller) *httputil.Server {
-	mux := httprouter.New()
-	mux.PanicHandler = func(w http.ResponseWriter, req *http.Request, i interface{}) {
+	panicHandler := func(w http.ResponseWriter, req *http.Request, i interface{}) {
 		log.FromContext(req.Context()).Debug(string(debug.Stack()))
 
 		err := fmt.Errorf("panic caught: %v", i)
 		httputil.RespondError(req.Context(), w, httputil.ErrInternalServer.Wrap(err))
 	}
+
+	priorityMux := httprouter.New()
+	priorityMux.PanicHandler = panicHandler
+
+	mux := httprouter.New()
+	mux.PanicHandler = panicHandler
 	mux.GlobalOPTIONS = http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		w.WriteHeader(http.StatusNoContent)
 	})
@@ -62,6 +75,10 @@ func NewServer(conf Config, cs ...Controller) *httputil.Server {
 	mux.Handler(http.MethodGet, "/", http.RedirectHandler("/swagger/index.html", http.StatusMovedPermanently))
 	mux.Handler(http.MethodGet, "/swagger", http.RedirectHandler("/swagger/index.html", http.StatusMovedPermanently))
 
+	if conf.Debug {
+		mux.Handler(http.MethodGet, "/debug/vars", expvar.Handler())
+	}
+
 	corsObj := cors.New(cors.Options{
 		AllowedOrigins: conf.Cors.AllowedOrigins,
 		AllowedMethods: []string{
@@ -76,14 +93,32 @@ func NewServer(conf Config, cs ...Controller) *httputil.Server {
 
 	for _, c := range cs {
 		c.Register(mux)
+
+		if pc, ok := c.(PriorityController); ok {
+			pc.RegisterPriority(priorityMux)
+		}
 	}
 
 	return httputil.NewServer(conf.Server,
 		middleware.Chain(
-			mux,
+			router{priority: priorityMux, main: mux},
 			middleware.RequestID,
 			middleware.Log,
 			corsObj.Handler,
 		),
 	)
 }
+
+type router struct {
+	priority *httprouter.Router
+	main     *httprouter.Router
+}
+
+func (r router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if handle, _, _ := r.priority.Lookup(req.Method, req.URL.Path); handle != nil {
+		r.priority.ServeHTTP(w, req)
+		return
+	}
+
+	r.main.ServeHTTP(w, req)
+}
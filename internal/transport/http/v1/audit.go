@@ -0,0 +1,183 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/Chatyx/backend/internal/dto"
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/httputil"
+	"github.com/Chatyx/backend/pkg/httputil/middleware"
+	"github.com/Chatyx/backend/pkg/validator"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	groupAuditLogPath = "/audit-log"
+)
+
+const (
+	auditActionParam       = "action"
+	auditActorIDParam      = "actor_id"
+	auditTargetUserIDParam = "target_user_id"
+)
+
+type GroupAuditEntry struct {
+	ID           int                     `json:"id"`
+	ActorID      int                     `json:"actor_id"`
+	TargetUserID *int                    `json:"target_user_id,omitempty"`
+	Action       entity.GroupAuditAction `json:"action"`
+	Before       map[string]any          `json:"before,omitempty"`
+	After        map[string]any          `json:"after,omitempty"`
+	CreatedAt    time.Time               `json:"created_at"`
+}
+
+func NewGroupAuditEntry(entry entity.GroupAuditEntry) GroupAuditEntry {
+	return GroupAuditEntry{
+		ID:           entry.ID,
+		ActorID:      entry.ActorID,
+		TargetUserID: entry.TargetUserID,
+		Action:       entry.Action,
+		Before:       entry.Before,
+		After:        entry.After,
+		CreatedAt:    entry.CreatedAt,
+	}
+}
+
+type GroupAuditLog struct {
+	Total int               `json:"total"`
+	Data  []GroupAuditEntry `json:"data"`
+}
+
+func NewGroupAuditLog(entries []entity.GroupAuditEntry) GroupAuditLog {
+	data := make([]GroupAuditEntry, len(entries))
+	for i, entry := range entries {
+		data[i] = NewGroupAuditEntry(entry)
+	}
+
+	return GroupAuditLog{
+		Total: len(entries),
+		Data:  data,
+	}
+}
+
+//go:generate mockery --inpackage --testonly --case underscore --name GroupAuditLogService
+type GroupAuditLogService interface {
+	List(ctx context.Context, obj dto.GroupAuditLogList) ([]entity.GroupAuditEntry, error)
+}
+
+type GroupAuditLogControllerConfig struct {
+	Service   GroupAuditLogService
+	Authorize middleware.Middleware
+	Validator validator.Validator
+}
+
+type GroupAuditLogController struct {
+	service   GroupAuditLogService
+	authorize middleware.Middleware
+	validator validator.Validator
+}
+
+func NewGroupAuditLogController(conf GroupAuditLogControllerConfig) *GroupAuditLogController {
+	return &GroupAuditLogController{
+		service:   conf.Service,
+		authorize: conf.Authorize,
+		validator: conf.Validator,
+	}
+}
+
+func (ac *GroupAuditLogController) Register(mux *httprouter.Router) {
+	mux.Handler(http.MethodGet, groupDetailPath+groupAuditLogPath, ac.authorize(http.HandlerFunc(ac.list)))
+}
+
+// list lists administrative actions performed in a specified group
+//
+//	@Summary		List administrative actions performed in a specified group
+//	@Description	Only admins and the owner of the group can view the audit log.
+//	@Tags			group-audit-log
+//	@Accept			json
+//	@Produce		json
+//	@Param			group_id		path		int		true	"Group identity"
+//	@Param			action			query		string	false	"Action to filter by"
+//	@Param			actor_id		query		int		false	"User who performed the action"
+//	@Param			target_user_id	query		int		false	"User on whom the action was performed"
+//	@Param			id_after		query		int		false	"Entry id that excludes already-retrieved entries"
+//	@Param			limit			query		int		false	"Number of items to list per page (default: 20, max: 100)"
+//	@Param			sort			query		string	false	"Sort order (asc or desc, default: desc)"
+//	@Success		200				{object}	GroupAuditLog
+//	@Failure		400				{object}	httputil.Error
+//	@Failure		403				{object}	httputil.Error
+//	@Failure		404				{object}	httputil.Error
+//	@Failure		500				{object}	httputil.Error
+//	@Security		JWTAuth
+//	@Router			/groups/{group_id}/audit-log  [get]
+func (ac *GroupAuditLogController) list(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var (
+		groupID      int
+		action       string
+		actorID      int
+		targetUserID int
+		idAfter      int
+		limit        int
+		sort         string
+	)
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.MergeResults(
+		dec.Path(groupIDParam, &groupID, nil),
+		dec.Query(auditActionParam, &action, ""),
+		dec.Query(auditActorIDParam, &actorID, 0),
+		dec.Query(auditTargetUserIDParam, &targetUserID, 0),
+		dec.Query(idAfterParam, &idAfter, 0),
+		dec.Query(limitParam, &limit, defaultLimit),
+		dec.Query(sortParam, &sort, string(dto.DescSort)),
+	); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	if err := validator.MergeResults(
+		ac.validator.Var(sort, sortParam, "oneof=asc desc"),
+		ac.validator.Var(limit, limitParam, "gt=0,max=100"),
+	); err != nil {
+		ve := validator.Error{}
+		if errors.As(err, &ve) {
+			httputil.RespondError(ctx, w, httputil.ErrValidationFailed.WithData(ve.Fields).Wrap(err))
+			return
+		}
+
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	obj := dto.GroupAuditLogList{
+		GroupID:      groupID,
+		Action:       entity.GroupAuditAction(action),
+		ActorID:      actorID,
+		TargetUserID: targetUserID,
+		IDAfter:      idAfter,
+		Limit:        limit,
+		Sort:         dto.Sort(sort),
+	}
+
+	entries, err := ac.service.List(ctx, obj)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrGroupNotFound):
+			httputil.RespondError(ctx, w, errGroupNotFound.Wrap(err))
+		case errors.Is(err, entity.ErrForbiddenPerformAction):
+			httputil.RespondError(ctx, w, httputil.ErrForbiddenPerformAction.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusOK, NewGroupAuditLog(entries))
+}
@@ -0,0 +1,124 @@
+package v1
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Chatyx/backend/internal/dto"
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/validator"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGroupAuditLogController_list(t *testing.T) {
+	targetUserID := 2
+
+	testCases := []struct {
+		name                 string
+		query                string
+		mockBehavior         func(s *MockGroupAuditLogService)
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Successful",
+			mockBehavior: func(s *MockGroupAuditLogService) {
+				s.On("List", mock.Anything, dto.GroupAuditLogList{
+					GroupID: 1,
+					Limit:   defaultLimit,
+					Sort:    dto.DescSort,
+				}).Return([]entity.GroupAuditEntry{
+					{
+						ID:           1,
+						GroupID:      1,
+						ActorID:      1,
+						TargetUserID: &targetUserID,
+						Action:       entity.ParticipantRoleChangedAuditAction,
+						Before:       map[string]any{"role": "member"},
+						After:        map[string]any{"role": "admin"},
+						CreatedAt:    defaultCreatedAt,
+					},
+				}, nil)
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"total":1,"data":[{"id":1,"actor_id":1,"target_user_id":2,"action":"participant_role_changed","before":{"role":"member"},"after":{"role":"admin"},"created_at":"2024-01-23T00:00:00Z"}]}`,
+		},
+		{
+			name:  "Successful with filters",
+			query: "?action=group_updated&actor_id=3&target_user_id=4&id_after=10&limit=5&sort=asc",
+			mockBehavior: func(s *MockGroupAuditLogService) {
+				s.On("List", mock.Anything, dto.GroupAuditLogList{
+					GroupID:      1,
+					Action:       entity.GroupUpdatedAuditAction,
+					ActorID:      3,
+					TargetUserID: 4,
+					IDAfter:      10,
+					Limit:        5,
+					Sort:         dto.AscSort,
+				}).Return(nil, nil)
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"total":0,"data":[]}`,
+		},
+		{
+			name:                 "Validation error",
+			query:                "?limit=500",
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"CM0006","message":"validation error","data":{"limit":"failed on the 'max' tag"}}`,
+		},
+		{
+			name: "Current user isn't an admin",
+			mockBehavior: func(s *MockGroupAuditLogService) {
+				s.On("List", mock.Anything, mock.Anything).Return(nil, entity.ErrForbiddenPerformAction)
+			},
+			expectedStatusCode:   http.StatusForbidden,
+			expectedResponseBody: `{"code":"CM0008","message":"it's forbidden to perform this action"}`,
+		},
+		{
+			name: "Group is not found",
+			mockBehavior: func(s *MockGroupAuditLogService) {
+				s.On("List", mock.Anything, mock.Anything).Return(nil, entity.ErrGroupNotFound)
+			},
+			expectedStatusCode:   http.StatusNotFound,
+			expectedResponseBody: `{"code":"CH0001","message":"group is not found"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := NewMockGroupAuditLogService(t)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(service)
+			}
+
+			cnt := NewGroupAuditLogController(GroupAuditLogControllerConfig{
+				Service:   service,
+				Validator: validator.NewValidator(),
+			})
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, groupDetailPath+groupAuditLogPath+testCase.query, nil)
+			ctx := context.WithValue(
+				req.Context(),
+				httprouter.ParamsKey,
+				httprouter.Params{{Key: "group_id", Value: "1"}},
+			)
+			req = req.WithContext(ctx)
+
+			cnt.list(rec, req)
+			resp := rec.Result()
+
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedResponseBody, string(respBody))
+		})
+	}
+}
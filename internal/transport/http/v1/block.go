@@ -0,0 +1,184 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/httputil"
+	"github.com/Chatyx/backend/pkg/httputil/middleware"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	// The list isn't under userMePath since it would conflict with userDetailPath.
+	userBlockListPath   = "/api/v1/blocks"
+	userBlockDetailPath = "/api/v1/users/me/blocks/:user_id"
+)
+
+type UserBlock struct {
+	UserID    int       `json:"user_id"`
+	BlockedAt time.Time `json:"blocked_at"`
+}
+
+func NewUserBlock(block entity.UserBlock) UserBlock {
+	return UserBlock{
+		UserID:    block.BlockedUserID,
+		BlockedAt: block.CreatedAt,
+	}
+}
+
+type UserBlockList struct {
+	Total int         `json:"total"`
+	Data  []UserBlock `json:"data"`
+}
+
+func NewUserBlockList(blocks []entity.UserBlock) UserBlockList {
+	data := make([]UserBlock, len(blocks))
+	for i, block := range blocks {
+		data[i] = NewUserBlock(block)
+	}
+
+	return UserBlockList{
+		Total: len(blocks),
+		Data:  data,
+	}
+}
+
+//go:generate mockery --inpackage --testonly --case underscore --name UserBlockService
+type UserBlockService interface {
+	List(ctx context.Context) ([]entity.UserBlock, error)
+	Block(ctx context.Context, userID int) (entity.UserBlock, error)
+	Unblock(ctx context.Context, userID int) error
+}
+
+type UserBlockControllerConfig struct {
+	Service   UserBlockService
+	Authorize middleware.Middleware
+}
+
+type UserBlockController struct {
+	service   UserBlockService
+	authorize middleware.Middleware
+}
+
+func NewUserBlockController(conf UserBlockControllerConfig) *UserBlockController {
+	return &UserBlockController{
+		service:   conf.Service,
+		authorize: conf.Authorize,
+	}
+}
+
+func (bc *UserBlockController) Register(mux *httprouter.Router) {
+	mux.Handler(http.MethodGet, userBlockListPath, bc.authorize(http.HandlerFunc(bc.list)))
+	mux.Handler(http.MethodPost, userBlockDetailPath, bc.authorize(http.HandlerFunc(bc.block)))
+	mux.Handler(http.MethodDelete, userBlockDetailPath, bc.authorize(http.HandlerFunc(bc.unblock)))
+}
+
+// list lists users blocked by the current user
+//
+//	@Summary	List users blocked by the current user
+//	@Tags		user-blocks
+//	@Accept		json
+//	@Produce	json
+//	@Success	200	{object}	UserBlockList
+//	@Failure	500	{object}	httputil.Error
+//	@Security	JWTAuth
+//	@Router		/blocks  [get]
+func (bc *UserBlockController) list(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	blocks, err := bc.service.List(ctx)
+	if err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusOK, NewUserBlockList(blocks))
+}
+
+// block blocks a specified user for the current user
+//
+//	@Summary		Block a specified user for the current user
+//	@Description	The blocked user can't write to the current user, invite them to groups
+//	@Description	or create a dialog with them. The existing dialog is blocked as well.
+//	@Tags			user-blocks
+//	@Accept			json
+//	@Produce		json
+//	@Param			user_id	path		int	true	"User identity"
+//	@Success		201		{object}	UserBlock
+//	@Failure		400		{object}	httputil.Error
+//	@Failure		500		{object}	httputil.Error
+//	@Security		JWTAuth
+//	@Router			/users/me/blocks/{user_id}  [post]
+func (bc *UserBlockController) block(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var userID int
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.Path(userIDParam, &userID, nil); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	block, err := bc.service.Block(ctx, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrBlockYourself):
+			httputil.RespondError(ctx, w, errBlockYourself.Wrap(err))
+		case errors.Is(err, entity.ErrBlockNonExistentUser):
+			httputil.RespondError(ctx, w, errBlockNonExistentUser.Wrap(err))
+		case errors.Is(err, entity.ErrSuchUserBlockAlreadyExists):
+			httputil.RespondError(ctx, w, errSuchUserBlockAlreadyExists.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusCreated, NewUserBlock(block))
+}
+
+// unblock unblocks a specified user for the current user
+//
+//	@Summary		Unblock a specified user for the current user
+//	@Description	The existing dialog with the user is unblocked as well.
+//	@Tags			user-blocks
+//	@Accept			json
+//	@Produce		json
+//	@Param			user_id	path	int	true	"User identity"
+//	@Success		204		"No Content"
+//	@Failure		400		{object}	httputil.Error
+//	@Failure		404		{object}	httputil.Error
+//	@Failure		500		{object}	httputil.Error
+//	@Security		JWTAuth
+//	@Router			/users/me/blocks/{user_id}  [delete]
+func (bc *UserBlockController) unblock(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var userID int
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.Path(userIDParam, &userID, nil); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	if err := bc.service.Unblock(ctx, userID); err != nil {
+		switch {
+		case errors.Is(err, entity.ErrUserBlockNotFound):
+			httputil.RespondError(ctx, w, errUserBlockNotFound.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusNoContent, nil)
+}
@@ -0,0 +1,161 @@
+package v1
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Chatyx/backend/internal/entity"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUserBlockController_list(t *testing.T) {
+	service := NewMockUserBlockService(t)
+	service.On("List", mock.Anything).Return([]entity.UserBlock{
+		{UserID: 1, BlockedUserID: 2, CreatedAt: defaultCreatedAt},
+	}, nil)
+
+	cnt := NewUserBlockController(UserBlockControllerConfig{Service: service})
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, userBlockListPath, nil)
+
+	cnt.list(rec, req)
+	resp := rec.Result()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	respBody, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"total":1,"data":[{"user_id":2,"blocked_at":"2024-01-23T00:00:00Z"}]}`, string(respBody))
+}
+
+func TestUserBlockController_block(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		mockBehavior         func(s *MockUserBlockService)
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Successful",
+			mockBehavior: func(s *MockUserBlockService) {
+				s.On("Block", mock.Anything, 2).Return(entity.UserBlock{
+					UserID:        1,
+					BlockedUserID: 2,
+					CreatedAt:     defaultCreatedAt,
+				}, nil)
+			},
+			expectedStatusCode:   http.StatusCreated,
+			expectedResponseBody: `{"user_id":2,"blocked_at":"2024-01-23T00:00:00Z"}`,
+		},
+		{
+			name: "Blocking yourself",
+			mockBehavior: func(s *MockUserBlockService) {
+				s.On("Block", mock.Anything, 2).Return(entity.UserBlock{}, entity.ErrBlockYourself)
+			},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"US0006","message":"blocking yourself"}`,
+		},
+		{
+			name: "Blocking a non-existent user",
+			mockBehavior: func(s *MockUserBlockService) {
+				s.On("Block", mock.Anything, 2).Return(entity.UserBlock{}, entity.ErrBlockNonExistentUser)
+			},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"US0007","message":"blocking a non-existent user"}`,
+		},
+		{
+			name: "User is already blocked",
+			mockBehavior: func(s *MockUserBlockService) {
+				s.On("Block", mock.Anything, 2).Return(entity.UserBlock{}, entity.ErrSuchUserBlockAlreadyExists)
+			},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"US0005","message":"such a user block already exists"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := NewMockUserBlockService(t)
+			testCase.mockBehavior(service)
+
+			cnt := NewUserBlockController(UserBlockControllerConfig{Service: service})
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/users/me/blocks/2", nil)
+			ctx := context.WithValue(
+				req.Context(),
+				httprouter.ParamsKey,
+				httprouter.Params{{Key: "user_id", Value: "2"}},
+			)
+			req = req.WithContext(ctx)
+
+			cnt.block(rec, req)
+			resp := rec.Result()
+
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedResponseBody, string(respBody))
+		})
+	}
+}
+
+func TestUserBlockController_unblock(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		mockBehavior         func(s *MockUserBlockService)
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Successful",
+			mockBehavior: func(s *MockUserBlockService) {
+				s.On("Unblock", mock.Anything, 2).Return(nil)
+			},
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name: "User isn't blocked",
+			mockBehavior: func(s *MockUserBlockService) {
+				s.On("Unblock", mock.Anything, 2).Return(entity.ErrUserBlockNotFound)
+			},
+			expectedStatusCode:   http.StatusNotFound,
+			expectedResponseBody: `{"code":"US0004","message":"user block is not found"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := NewMockUserBlockService(t)
+			testCase.mockBehavior(service)
+
+			cnt := NewUserBlockController(UserBlockControllerConfig{Service: service})
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodDelete, "/api/v1/users/me/blocks/2", nil)
+			ctx := context.WithValue(
+				req.Context(),
+				httprouter.ParamsKey,
+				httprouter.Params{{Key: "user_id", Value: "2"}},
+			)
+			req = req.WithContext(ctx)
+
+			cnt.unblock(rec, req)
+			resp := rec.Result()
+
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedResponseBody, string(respBody))
+		})
+	}
+}
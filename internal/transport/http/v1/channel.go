@@ -0,0 +1,336 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/Chatyx/backend/internal/dto"
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/httputil"
+	"github.com/Chatyx/backend/pkg/httputil/middleware"
+	"github.com/Chatyx/backend/pkg/validator"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	channelListPath   = "/api/v1/channels"
+	channelDetailPath = "/api/v1/channels/:channel_id"
+)
+
+const (
+	channelIDParam = "channel_id"
+)
+
+type Channel struct {
+	ID          int       `json:"id"`
+	Uname       string    `json:"uname,omitempty"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func NewChannel(channel entity.Channel) Channel {
+	return Channel{
+		ID:          channel.ID,
+		Uname:       channel.Uname,
+		Name:        channel.Name,
+		Description: channel.Description,
+		CreatedAt:   channel.CreatedAt,
+	}
+}
+
+type ChannelList struct {
+	Total int       `json:"total"`
+	Data  []Channel `json:"data"`
+}
+
+func NewChannelList(channels []entity.Channel) ChannelList {
+	data := make([]Channel, len(channels))
+	for i, channel := range channels {
+		data[i] = NewChannel(channel)
+	}
+
+	return ChannelList{
+		Total: len(channels),
+		Data:  data,
+	}
+}
+
+type ChannelCreate struct {
+	Uname       string `json:"uname"       validate:"omitempty,min=5,max=32,alphanum"`
+	Name        string `json:"name"        validate:"required,max=255"`
+	Description string `json:"description" validate:"max=10000"`
+}
+
+func (g ChannelCreate) DTO() dto.ChannelCreate {
+	return dto.ChannelCreate{
+		Uname:       g.Uname,
+		Name:        g.Name,
+		Description: g.Description,
+	}
+}
+
+type ChannelUpdate struct {
+	Uname       string `json:"uname"       validate:"omitempty,min=5,max=32,alphanum"`
+	Name        string `json:"name"        validate:"required,max=255"`
+	Description string `json:"description" validate:"max=10000"`
+}
+
+func (g ChannelUpdate) DTO() dto.ChannelUpdate {
+	return dto.ChannelUpdate{
+		Uname:       g.Uname,
+		Name:        g.Name,
+		Description: g.Description,
+	}
+}
+
+//go:generate mockery --inpackage --testonly --case underscore --name ChannelService
+type ChannelService interface {
+	List(ctx context.Context) ([]entity.Channel, error)
+	Create(ctx context.Context, obj dto.ChannelCreate) (entity.Channel, error)
+	GetByID(ctx context.Context, id int) (entity.Channel, error)
+	Update(ctx context.Context, obj dto.ChannelUpdate) (entity.Channel, error)
+	Delete(ctx context.Context, id int) error
+}
+
+type ChannelControllerConfig struct {
+	Service   ChannelService
+	Authorize middleware.Middleware
+	Validator validator.Validator
+}
+
+type ChannelController struct {
+	service   ChannelService
+	authorize middleware.Middleware
+	validator validator.Validator
+}
+
+func NewChannelController(conf ChannelControllerConfig) *ChannelController {
+	return &ChannelController{
+		service:   conf.Service,
+		authorize: conf.Authorize,
+		validator: conf.Validator,
+	}
+}
+
+func (cc *ChannelController) Register(mux *httprouter.Router) {
+	mux.Handler(http.MethodGet, channelListPath, cc.authorize(http.HandlerFunc(cc.list)))
+	mux.Handler(http.MethodPost, channelListPath, cc.authorize(http.HandlerFunc(cc.create)))
+	mux.Handler(http.MethodGet, channelDetailPath, cc.authorize(http.HandlerFunc(cc.detail)))
+	mux.Handler(http.MethodPut, channelDetailPath, cc.authorize(http.HandlerFunc(cc.update)))
+	mux.Handler(http.MethodDelete, channelDetailPath, cc.authorize(http.HandlerFunc(cc.delete)))
+}
+
+// list lists channels the current user is subscribed to
+//
+//	@Summary	List channels the current user is subscribed to
+//	@Tags		channels
+//	@Accept		json
+//	@Produce	json
+//	@Success	200	{object}	ChannelList
+//	@Failure	500	{object}	httputil.Error
+//	@Security	JWTAuth
+//	@Router		/channels  [get]
+func (cc *ChannelController) list(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	channels, err := cc.service.List(ctx)
+	if err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusOK, NewChannelList(channels))
+}
+
+// create creates a channel
+//
+//	@Summary		Create a channel
+//	@Description	The current user is subscribed to the created channel as an admin.
+//	@Tags			channels
+//	@Accept			json
+//	@Produce		json
+//	@Param			input	body		ChannelCreate	true	"Body to create"
+//	@Success		201		{object}	Channel
+//	@Failure		400		{object}	httputil.Error
+//	@Failure		500		{object}	httputil.Error
+//	@Security		JWTAuth
+//	@Router			/channels  [post]
+func (cc *ChannelController) create(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var bodyObj ChannelCreate
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.Body(&bodyObj); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	if err := cc.validator.Struct(bodyObj); err != nil {
+		ve := validator.Error{}
+		if errors.As(err, &ve) {
+			httputil.RespondError(ctx, w, httputil.ErrValidationFailed.WithData(ve.Fields).Wrap(err))
+			return
+		}
+
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	channel, err := cc.service.Create(ctx, bodyObj.DTO())
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrSuchChannelUnameAlreadyExists):
+			httputil.RespondError(ctx, w, errSuchChannelUnameAlreadyExists.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusCreated, NewChannel(channel))
+}
+
+// detail gets a specified channel
+//
+//	@Summary	Get a specified channel
+//	@Tags		channels
+//	@Accept		json
+//	@Produce	json
+//	@Param		channel_id	path		int	true	"Channel identity"
+//	@Success	200			{object}	Channel
+//	@Failure	400			{object}	httputil.Error
+//	@Failure	404			{object}	httputil.Error
+//	@Failure	500			{object}	httputil.Error
+//	@Security	JWTAuth
+//	@Router		/channels/{channel_id}  [get]
+func (cc *ChannelController) detail(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var channelID int
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.Path(channelIDParam, &channelID, nil); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	channel, err := cc.service.GetByID(ctx, channelID)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrChannelNotFound):
+			httputil.RespondError(ctx, w, errChannelNotFound.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusOK, NewChannel(channel))
+}
+
+// update updates a specified channel
+//
+//	@Summary	Update a specified channel
+//	@Tags		channels
+//	@Accept		json
+//	@Produce	json
+//	@Param		channel_id	path		int				true	"Channel identity"
+//	@Param		input		body		ChannelUpdate	true	"Body to update"
+//	@Success	200			{object}	Channel
+//	@Failure	400			{object}	httputil.Error
+//	@Failure	404			{object}	httputil.Error
+//	@Failure	500			{object}	httputil.Error
+//	@Security	JWTAuth
+//	@Router		/channels/{channel_id}  [put]
+func (cc *ChannelController) update(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var (
+		channelID int
+		bodyObj   ChannelUpdate
+	)
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.MergeResults(
+		dec.Path(channelIDParam, &channelID, nil),
+		dec.Body(&bodyObj),
+	); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	if err := cc.validator.Struct(bodyObj); err != nil {
+		ve := validator.Error{}
+		if errors.As(err, &ve) {
+			httputil.RespondError(ctx, w, httputil.ErrValidationFailed.WithData(ve.Fields).Wrap(err))
+			return
+		}
+
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	obj := bodyObj.DTO()
+	obj.ID = channelID
+
+	channel, err := cc.service.Update(ctx, obj)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrChannelNotFound):
+			httputil.RespondError(ctx, w, errChannelNotFound.Wrap(err))
+		case errors.Is(err, entity.ErrSuchChannelUnameAlreadyExists):
+			httputil.RespondError(ctx, w, errSuchChannelUnameAlreadyExists.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusOK, NewChannel(channel))
+}
+
+// delete deletes a specified channel
+//
+//	@Summary	Delete a specified channel
+//	@Tags		channels
+//	@Accept		json
+//	@Produce	json
+//	@Param		channel_id	path	int	true	"Channel identity"
+//	@Success	204			"No Content"
+//	@Failure	400			{object}	httputil.Error
+//	@Failure	404			{object}	httputil.Error
+//	@Failure	500			{object}	httputil.Error
+//	@Security	JWTAuth
+//	@Router		/channels/{channel_id}  [delete]
+func (cc *ChannelController) delete(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var channelID int
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.Path(channelIDParam, &channelID, nil); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	if err := cc.service.Delete(ctx, channelID); err != nil {
+		switch {
+		case errors.Is(err, entity.ErrChannelNotFound):
+			httputil.RespondError(ctx, w, errChannelNotFound.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusNoContent, nil)
+}
@@ -0,0 +1,283 @@
+package v1
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Chatyx/backend/internal/dto"
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/validator"
+
+	"github.com/google/uuid"
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestChannelController_list(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		mockBehavior         func(s *MockChannelService)
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Successful",
+			mockBehavior: func(s *MockChannelService) {
+				s.On("List", mock.Anything).Return([]entity.Channel{
+					{
+						ID:        1,
+						Uname:     "news",
+						Name:      "News",
+						CreatedAt: defaultCreatedAt,
+					},
+				}, nil)
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"total":1,"data":[{"id":1,"uname":"news","name":"News","created_at":"2024-01-23T00:00:00Z"}]}`,
+		},
+		{
+			name: "Internal server error",
+			mockBehavior: func(s *MockChannelService) {
+				s.On("List", mock.Anything).Return(nil, errUnexpected)
+			},
+			expectedStatusCode:   http.StatusInternalServerError,
+			expectedResponseBody: `{"code":"CM0001","message":"internal server error"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := NewMockChannelService(t)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(service)
+			}
+
+			cnt := NewChannelController(ChannelControllerConfig{
+				Service:   service,
+				Validator: validator.NewValidator(),
+			})
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, channelListPath, nil)
+
+			cnt.list(rec, req)
+			resp := rec.Result()
+
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedResponseBody, string(respBody))
+		})
+	}
+}
+
+func TestChannelController_create(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		requestBody          string
+		mockBehavior         func(s *MockChannelService)
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:        "Successful",
+			requestBody: `{"uname":"news1","name":"News","description":"Latest news"}`,
+			mockBehavior: func(s *MockChannelService) {
+				s.On("Create", mock.Anything, dto.ChannelCreate{
+					Uname:       "news1",
+					Name:        "News",
+					Description: "Latest news",
+				}).Return(entity.Channel{
+					ID:          1,
+					Uname:       "news1",
+					Name:        "News",
+					Description: "Latest news",
+					CreatedAt:   defaultCreatedAt,
+				}, nil)
+			},
+			expectedStatusCode:   http.StatusCreated,
+			expectedResponseBody: `{"id":1,"uname":"news1","name":"News","description":"Latest news","created_at":"2024-01-23T00:00:00Z"}`,
+		},
+		{
+			name:        "Channel with such uname already exists",
+			requestBody: `{"uname":"news1","name":"News"}`,
+			mockBehavior: func(s *MockChannelService) {
+				s.On("Create", mock.Anything, dto.ChannelCreate{
+					Uname: "news1",
+					Name:  "News",
+				}).Return(entity.Channel{}, entity.ErrSuchChannelUnameAlreadyExists)
+			},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"CH0020","message":"channel with such uname already exists"}`,
+		},
+		{
+			name:                 "Validation error",
+			requestBody:          `{"uname":"news1"}`,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"CM0006","message":"validation error","data":{"name":"failed on the 'required' tag"}}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := NewMockChannelService(t)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(service)
+			}
+
+			cnt := NewChannelController(ChannelControllerConfig{
+				Service:   service,
+				Validator: validator.NewValidator(),
+			})
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, channelListPath, strings.NewReader(testCase.requestBody))
+
+			cnt.create(rec, req)
+			resp := rec.Result()
+
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedResponseBody, string(respBody))
+		})
+	}
+}
+
+func TestChannelController_detail(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		channelIDPathParam   string
+		mockBehavior         func(s *MockChannelService)
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:               "Successful",
+			channelIDPathParam: "1",
+			mockBehavior: func(s *MockChannelService) {
+				s.On("GetByID", mock.Anything, 1).Return(entity.Channel{
+					ID:        1,
+					Name:      "News",
+					CreatedAt: defaultCreatedAt,
+				}, nil)
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"id":1,"name":"News","created_at":"2024-01-23T00:00:00Z"}`,
+		},
+		{
+			name:                 "Decode path param error",
+			channelIDPathParam:   uuid.New().String(),
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"CM0003","message":"decode path params error","data":{"channel_id":"failed to parse int"}}`,
+		},
+		{
+			name:               "Channel is not found",
+			channelIDPathParam: "1",
+			mockBehavior: func(s *MockChannelService) {
+				s.On("GetByID", mock.Anything, 1).Return(entity.Channel{}, entity.ErrChannelNotFound)
+			},
+			expectedStatusCode:   http.StatusNotFound,
+			expectedResponseBody: `{"code":"CH0017","message":"channel is not found"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := NewMockChannelService(t)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(service)
+			}
+
+			cnt := NewChannelController(ChannelControllerConfig{
+				Service:   service,
+				Validator: validator.NewValidator(),
+			})
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, channelDetailPath, nil)
+			ctx := context.WithValue(
+				req.Context(),
+				httprouter.ParamsKey,
+				httprouter.Params{{Key: "channel_id", Value: testCase.channelIDPathParam}},
+			)
+			req = req.WithContext(ctx)
+
+			cnt.detail(rec, req)
+			resp := rec.Result()
+
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedResponseBody, string(respBody))
+		})
+	}
+}
+
+func TestChannelController_delete(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		channelIDPathParam   string
+		mockBehavior         func(s *MockChannelService)
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:               "Successful",
+			channelIDPathParam: "1",
+			mockBehavior: func(s *MockChannelService) {
+				s.On("Delete", mock.Anything, 1).Return(nil)
+			},
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name:               "Channel is not found",
+			channelIDPathParam: "1",
+			mockBehavior: func(s *MockChannelService) {
+				s.On("Delete", mock.Anything, 1).Return(entity.ErrChannelNotFound)
+			},
+			expectedStatusCode:   http.StatusNotFound,
+			expectedResponseBody: `{"code":"CH0017","message":"channel is not found"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := NewMockChannelService(t)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(service)
+			}
+
+			cnt := NewChannelController(ChannelControllerConfig{
+				Service:   service,
+				Validator: validator.NewValidator(),
+			})
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodDelete, channelDetailPath, nil)
+			ctx := context.WithValue(
+				req.Context(),
+				httprouter.ParamsKey,
+				httprouter.Params{{Key: "channel_id", Value: testCase.channelIDPathParam}},
+			)
+			req = req.WithContext(ctx)
+
+			cnt.delete(rec, req)
+			resp := rec.Result()
+
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedResponseBody, string(respBody))
+		})
+	}
+}
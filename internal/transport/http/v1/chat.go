@@ -0,0 +1,254 @@
+package v1
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Chatyx/backend/internal/dto"
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/httputil"
+	"github.com/Chatyx/backend/pkg/httputil/middleware"
+	"github.com/Chatyx/backend/pkg/validator"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	chatListPath = "/api/v1/chats"
+)
+
+const (
+	cursorParam   = "cursor"
+	archivedParam = "archived"
+	folderIDParam = "folder_id"
+)
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+type ChatListItem struct {
+	ID             int             `json:"id"`
+	Type           entity.ChatType `json:"type"`
+	Name           string          `json:"name,omitempty"`
+	PartnerUserID  int             `json:"partner_user_id,omitempty"`
+	LastMessage    *Message        `json:"last_message,omitempty"`
+	UnreadCount    int             `json:"unread_count"`
+	IsMuted        bool            `json:"is_muted"`
+	IsPinned       bool            `json:"is_pinned"`
+	IsArchived     bool            `json:"is_archived"`
+	CreatedAt      time.Time       `json:"created_at"`
+	LastActivityAt time.Time       `json:"last_activity_at"`
+}
+
+func NewChatListItem(item entity.ChatListItem) ChatListItem {
+	var lastMessage *Message
+	if item.LastMessage != nil {
+		message := NewMessage(*item.LastMessage)
+		lastMessage = &message
+	}
+
+	return ChatListItem{
+		ID:             item.ChatID.ID,
+		Type:           item.ChatID.Type,
+		Name:           item.Name,
+		PartnerUserID:  item.PartnerUserID,
+		LastMessage:    lastMessage,
+		UnreadCount:    item.UnreadCount,
+		IsMuted:        item.IsMuted,
+		IsPinned:       item.PinnedOrder != 0,
+		IsArchived:     item.IsArchived,
+		CreatedAt:      item.CreatedAt,
+		LastActivityAt: item.LastActivityAt,
+	}
+}
+
+type ChatList struct {
+	Total int            `json:"total"`
+	Data  []ChatListItem `json:"data"`
+	// NextCursor is set if there may be more chats after the listed ones.
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+func NewChatList(items []entity.ChatListItem, limit int) ChatList {
+	data := make([]ChatListItem, len(items))
+	for i, item := range items {
+		data[i] = NewChatListItem(item)
+	}
+
+	list := ChatList{
+		Total: len(items),
+		Data:  data,
+	}
+	if len(items) != 0 && len(items) == limit {
+		last := items[len(items)-1]
+		list.NextCursor = encodeChatListCursor(dto.ChatListCursor{
+			PinnedOrder:    last.PinnedOrder,
+			LastActivityAt: last.LastActivityAt,
+			ChatID:         last.ChatID.ID,
+		})
+	}
+
+	return list
+}
+
+// encodeChatListCursor encodes the cursor into an opaque string,
+// so clients don't depend on what the chat list is sorted by.
+func encodeChatListCursor(cursor dto.ChatListCursor) string {
+	raw := strconv.Itoa(cursor.PinnedOrder) + ":" +
+		strconv.FormatInt(cursor.LastActivityAt.UnixNano(), 10) + ":" +
+		strconv.Itoa(cursor.ChatID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeChatListCursor(s string) (dto.ChatListCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return dto.ChatListCursor{}, fmt.Errorf("%w: %v", errInvalidCursor, err)
+	}
+
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 3 {
+		return dto.ChatListCursor{}, errInvalidCursor
+	}
+
+	pinnedOrder, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return dto.ChatListCursor{}, fmt.Errorf("%w: %v", errInvalidCursor, err)
+	}
+
+	unixNano, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return dto.ChatListCursor{}, fmt.Errorf("%w: %v", errInvalidCursor, err)
+	}
+
+	id, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return dto.ChatListCursor{}, fmt.Errorf("%w: %v", errInvalidCursor, err)
+	}
+
+	return dto.ChatListCursor{
+		PinnedOrder:    pinnedOrder,
+		LastActivityAt: time.Unix(0, unixNano).UTC(),
+		ChatID:         id,
+	}, nil
+}
+
+//go:generate mockery --inpackage --testonly --case underscore --name ChatService
+type ChatService interface {
+	List(ctx context.Context, obj dto.ChatList) ([]entity.ChatListItem, error)
+}
+
+type ChatControllerConfig struct {
+	Service   ChatService
+	Authorize middleware.Middleware
+	Validator validator.Validator
+}
+
+type ChatController struct {
+	service   ChatService
+	authorize middleware.Middleware
+	validator validator.Validator
+}
+
+func NewChatController(conf ChatControllerConfig) *ChatController {
+	return &ChatController{
+		service:   conf.Service,
+		authorize: conf.Authorize,
+		validator: conf.Validator,
+	}
+}
+
+func (cc *ChatController) Register(mux *httprouter.Router) {
+	mux.Handler(http.MethodGet, chatListPath, cc.authorize(http.HandlerFunc(cc.list)))
+}
+
+// list lists dialogs and groups sorted by last activity
+//
+//	@Summary		List dialogs and groups sorted by last activity
+//	@Description	Pinned chats go first, the others are sorted by the time of their last message.
+//	@Description	The next page is requested by the cursor of the previous one.
+//	@Description	Archived chats are listed only on request, chats of a folder are listed regardless of the archive.
+//	@Tags			chats
+//	@Accept			json
+//	@Produce		json
+//	@Param			cursor		query		string	false	"Cursor of the next page returned with the previous one"
+//	@Param			archived	query		bool	false	"List archived chats instead of the others"
+//	@Param			folder_id	query		int		false	"List chats of the folder"
+//	@Param			limit		query		int		false	"Number of items to list per page (default: 20, max: 100)"
+//	@Success		200			{object}	ChatList
+//	@Failure		400			{object}	httputil.Error
+//	@Failure		404			{object}	httputil.Error
+//	@Failure		500			{object}	httputil.Error
+//	@Security		JWTAuth
+//	@Router			/chats  [get]
+func (cc *ChatController) list(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var (
+		cursor   string
+		archived bool
+		folderID int
+		limit    int
+	)
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.MergeResults(
+		dec.Query(cursorParam, &cursor, ""),
+		dec.Query(archivedParam, &archived, false),
+		dec.Query(folderIDParam, &folderID, 0),
+		dec.Query(limitParam, &limit, defaultLimit),
+	); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	if err := validator.MergeResults(
+		cc.validator.Var(folderID, folderIDParam, "min=0"),
+		cc.validator.Var(limit, limitParam, "gt=0,max=100"),
+	); err != nil {
+		ve := validator.Error{}
+		if errors.As(err, &ve) {
+			httputil.RespondError(ctx, w, httputil.ErrValidationFailed.WithData(ve.Fields).Wrap(err))
+			return
+		}
+
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	obj := dto.ChatList{
+		Archived: archived,
+		Limit:    limit,
+	}
+	if folderID != 0 {
+		obj.FolderID = &folderID
+	}
+	if cursor != "" {
+		chatCursor, err := decodeChatListCursor(cursor)
+		if err != nil {
+			httputil.RespondError(ctx, w, httputil.ErrValidationFailed.
+				WithData(map[string]string{cursorParam: errInvalidCursor.Error()}).Wrap(err))
+			return
+		}
+		obj.Cursor = &chatCursor
+	}
+
+	items, err := cc.service.List(ctx, obj)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrChatFolderNotFound):
+			httputil.RespondError(ctx, w, errChatFolderNotFound.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusOK, NewChatList(items, limit))
+}
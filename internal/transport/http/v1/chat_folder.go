@@ -0,0 +1,375 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/Chatyx/backend/internal/dto"
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/httputil"
+	"github.com/Chatyx/backend/pkg/httputil/middleware"
+	"github.com/Chatyx/backend/pkg/validator"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	chatFolderListPath   = "/api/v1/chat-folders"
+	chatFolderDetailPath = "/api/v1/chat-folders/:folder_id"
+)
+
+type FolderChat struct {
+	ID   int             `json:"id"   validate:"required,min=1"`
+	Type entity.ChatType `json:"type" validate:"required,oneof=dialog group"`
+}
+
+func newFolderChats(chatIDs []entity.ChatID) []FolderChat {
+	chats := make([]FolderChat, len(chatIDs))
+	for i, chatID := range chatIDs {
+		chats[i] = FolderChat{ID: chatID.ID, Type: chatID.Type}
+	}
+	return chats
+}
+
+func folderChatIDs(chats []FolderChat) []entity.ChatID {
+	chatIDs := make([]entity.ChatID, len(chats))
+	for i, chat := range chats {
+		chatIDs[i] = entity.ChatID{ID: chat.ID, Type: chat.Type}
+	}
+	return chatIDs
+}
+
+type ChatFolder struct {
+	ID              int          `json:"id"`
+	Name            string       `json:"name"`
+	IncludeDialogs  bool         `json:"include_dialogs"`
+	IncludeGroups   bool         `json:"include_groups"`
+	ExcludeMuted    bool         `json:"exclude_muted"`
+	ExcludeArchived bool         `json:"exclude_archived"`
+	IncludedChats   []FolderChat `json:"included_chats"`
+	ExcludedChats   []FolderChat `json:"excluded_chats"`
+	CreatedAt       time.Time    `json:"created_at"`
+}
+
+func NewChatFolder(folder entity.ChatFolder) ChatFolder {
+	return ChatFolder{
+		ID:              folder.ID,
+		Name:            folder.Name,
+		IncludeDialogs:  folder.IncludeDialogs,
+		IncludeGroups:   folder.IncludeGroups,
+		ExcludeMuted:    folder.ExcludeMuted,
+		ExcludeArchived: folder.ExcludeArchived,
+		IncludedChats:   newFolderChats(folder.IncludedChats),
+		ExcludedChats:   newFolderChats(folder.ExcludedChats),
+		CreatedAt:       folder.CreatedAt,
+	}
+}
+
+type ChatFolderList struct {
+	Total int          `json:"total"`
+	Data  []ChatFolder `json:"data"`
+}
+
+func NewChatFolderList(folders []entity.ChatFolder) ChatFolderList {
+	data := make([]ChatFolder, len(folders))
+	for i, folder := range folders {
+		data[i] = NewChatFolder(folder)
+	}
+
+	return ChatFolderList{
+		Total: len(folders),
+		Data:  data,
+	}
+}
+
+type ChatFolderCreate struct {
+	Name            string       `json:"name"             validate:"required,max=64"`
+	IncludeDialogs  bool         `json:"include_dialogs"`
+	IncludeGroups   bool         `json:"include_groups"`
+	ExcludeMuted    bool         `json:"exclude_muted"`
+	ExcludeArchived bool         `json:"exclude_archived"`
+	IncludedChats   []FolderChat `json:"included_chats"   validate:"max=100,dive"`
+	ExcludedChats   []FolderChat `json:"excluded_chats"   validate:"max=100,dive"`
+}
+
+func (fc ChatFolderCreate) DTO() dto.ChatFolderCreate {
+	return dto.ChatFolderCreate{
+		Name:            fc.Name,
+		IncludeDialogs:  fc.IncludeDialogs,
+		IncludeGroups:   fc.IncludeGroups,
+		ExcludeMuted:    fc.ExcludeMuted,
+		ExcludeArchived: fc.ExcludeArchived,
+		IncludedChats:   folderChatIDs(fc.IncludedChats),
+		ExcludedChats:   folderChatIDs(fc.ExcludedChats),
+	}
+}
+
+type ChatFolderUpdate struct {
+	Name            string       `json:"name"             validate:"required,max=64"`
+	IncludeDialogs  bool         `json:"include_dialogs"`
+	IncludeGroups   bool         `json:"include_groups"`
+	ExcludeMuted    bool         `json:"exclude_muted"`
+	ExcludeArchived bool         `json:"exclude_archived"`
+	IncludedChats   []FolderChat `json:"included_chats"   validate:"max=100,dive"`
+	ExcludedChats   []FolderChat `json:"excluded_chats"   validate:"max=100,dive"`
+}
+
+func (fu ChatFolderUpdate) DTO(folderID int) dto.ChatFolderUpdate {
+	return dto.ChatFolderUpdate{
+		ID:              folderID,
+		Name:            fu.Name,
+		IncludeDialogs:  fu.IncludeDialogs,
+		IncludeGroups:   fu.IncludeGroups,
+		ExcludeMuted:    fu.ExcludeMuted,
+		ExcludeArchived: fu.ExcludeArchived,
+		IncludedChats:   folderChatIDs(fu.IncludedChats),
+		ExcludedChats:   folderChatIDs(fu.ExcludedChats),
+	}
+}
+
+//go:generate mockery --inpackage --testonly --case underscore --name ChatFolderService
+type ChatFolderService interface {
+	List(ctx context.Context) ([]entity.ChatFolder, error)
+	GetByID(ctx context.Context, id int) (entity.ChatFolder, error)
+	Create(ctx context.Context, obj dto.ChatFolderCreate) (entity.ChatFolder, error)
+	Update(ctx context.Context, obj dto.ChatFolderUpdate) (entity.ChatFolder, error)
+	Delete(ctx context.Context, id int) error
+}
+
+type ChatFolderControllerConfig struct {
+	Service   ChatFolderService
+	Authorize middleware.Middleware
+	Validator validator.Validator
+}
+
+type ChatFolderController struct {
+	service   ChatFolderService
+	authorize middleware.Middleware
+	validator validator.Validator
+}
+
+func NewChatFolderController(conf ChatFolderControllerConfig) *ChatFolderController {
+	return &ChatFolderController{
+		service:   conf.Service,
+		authorize: conf.Authorize,
+		validator: conf.Validator,
+	}
+}
+
+func (fc *ChatFolderController) Register(mux *httprouter.Router) {
+	mux.Handler(http.MethodGet, chatFolderListPath, fc.authorize(http.HandlerFunc(fc.list)))
+	mux.Handler(http.MethodPost, chatFolderListPath, fc.authorize(http.HandlerFunc(fc.create)))
+	mux.Handler(http.MethodGet, chatFolderDetailPath, fc.authorize(http.HandlerFunc(fc.detail)))
+	mux.Handler(http.MethodPut, chatFolderDetailPath, fc.authorize(http.HandlerFunc(fc.update)))
+	mux.Handler(http.MethodDelete, chatFolderDetailPath, fc.authorize(http.HandlerFunc(fc.delete)))
+}
+
+// list lists chat folders of the current user
+//
+//	@Summary	List chat folders of the current user
+//	@Tags		chat-folders
+//	@Accept		json
+//	@Produce	json
+//	@Success	200	{object}	ChatFolderList
+//	@Failure	500	{object}	httputil.Error
+//	@Security	JWTAuth
+//	@Router		/chat-folders  [get]
+func (fc *ChatFolderController) list(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	folders, err := fc.service.List(ctx)
+	if err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusOK, NewChatFolderList(folders))
+}
+
+// create creates a chat folder
+//
+//	@Summary		Create a chat folder
+//	@Description	A chat is in the folder if it's included explicitly or it matches the rules of the folder
+//	@Description	and it isn't excluded explicitly. Chats which the user isn't in are skipped.
+//	@Tags			chat-folders
+//	@Accept			json
+//	@Produce		json
+//	@Param			input	body		ChatFolderCreate	true	"Body to create"
+//	@Success		201		{object}	ChatFolder
+//	@Failure		400		{object}	httputil.Error
+//	@Failure		500		{object}	httputil.Error
+//	@Security		JWTAuth
+//	@Router			/chat-folders  [post]
+func (fc *ChatFolderController) create(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var bodyObj ChatFolderCreate
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.Body(&bodyObj); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	if err := fc.validator.Struct(bodyObj); err != nil {
+		ve := validator.Error{}
+		if errors.As(err, &ve) {
+			httputil.RespondError(ctx, w, httputil.ErrValidationFailed.WithData(ve.Fields).Wrap(err))
+			return
+		}
+
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	folder, err := fc.service.Create(ctx, bodyObj.DTO())
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrChatFolderLimitReached):
+			httputil.RespondError(ctx, w, errChatFolderLimitReached.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusCreated, NewChatFolder(folder))
+}
+
+// detail gets a specified chat folder
+//
+//	@Summary	Get a specified chat folder
+//	@Tags		chat-folders
+//	@Accept		json
+//	@Produce	json
+//	@Param		folder_id	path		int	true	"Chat folder identity"
+//	@Success	200			{object}	ChatFolder
+//	@Failure	400			{object}	httputil.Error
+//	@Failure	404			{object}	httputil.Error
+//	@Failure	500			{object}	httputil.Error
+//	@Security	JWTAuth
+//	@Router		/chat-folders/{folder_id}  [get]
+func (fc *ChatFolderController) detail(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var folderID int
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.Path(folderIDParam, &folderID, nil); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	folder, err := fc.service.GetByID(ctx, folderID)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrChatFolderNotFound):
+			httputil.RespondError(ctx, w, errChatFolderNotFound.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusOK, NewChatFolder(folder))
+}
+
+// update updates a specified chat folder
+//
+//	@Summary		Update a specified chat folder
+//	@Description	Explicitly included and excluded chats of the folder are replaced with the given ones.
+//	@Tags			chat-folders
+//	@Accept			json
+//	@Produce		json
+//	@Param			folder_id	path		int					true	"Chat folder identity"
+//	@Param			input		body		ChatFolderUpdate	true	"Body to update"
+//	@Success		200			{object}	ChatFolder
+//	@Failure		400			{object}	httputil.Error
+//	@Failure		404			{object}	httputil.Error
+//	@Failure		500			{object}	httputil.Error
+//	@Security		JWTAuth
+//	@Router			/chat-folders/{folder_id}  [put]
+func (fc *ChatFolderController) update(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var (
+		folderID int
+		bodyObj  ChatFolderUpdate
+	)
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.MergeResults(
+		dec.Path(folderIDParam, &folderID, nil),
+		dec.Body(&bodyObj),
+	); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	if err := fc.validator.Struct(bodyObj); err != nil {
+		ve := validator.Error{}
+		if errors.As(err, &ve) {
+			httputil.RespondError(ctx, w, httputil.ErrValidationFailed.WithData(ve.Fields).Wrap(err))
+			return
+		}
+
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	folder, err := fc.service.Update(ctx, bodyObj.DTO(folderID))
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrChatFolderNotFound):
+			httputil.RespondError(ctx, w, errChatFolderNotFound.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusOK, NewChatFolder(folder))
+}
+
+// delete deletes a specified chat folder
+//
+//	@Summary	Delete a specified chat folder, chats of the folder remain
+//	@Tags		chat-folders
+//	@Accept		json
+//	@Produce	json
+//	@Param		folder_id	path	int	true	"Chat folder identity"
+//	@Success	204			"No Content"
+//	@Failure	400			{object}	httputil.Error
+//	@Failure	404			{object}	httputil.Error
+//	@Failure	500			{object}	httputil.Error
+//	@Security	JWTAuth
+//	@Router		/chat-folders/{folder_id}  [delete]
+func (fc *ChatFolderController) delete(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var folderID int
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.Path(folderIDParam, &folderID, nil); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	if err := fc.service.Delete(ctx, folderID); err != nil {
+		switch {
+		case errors.Is(err, entity.ErrChatFolderNotFound):
+			httputil.RespondError(ctx, w, errChatFolderNotFound.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusNoContent, nil)
+}
@@ -0,0 +1,91 @@
+package v1
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Chatyx/backend/internal/dto"
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/validator"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestChatFolderController_create(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		requestBody          string
+		mockBehavior         func(s *MockChatFolderService)
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:        "Successful",
+			requestBody: `{"name":"Work","include_groups":true,"excluded_chats":[{"id":3,"type":"group"}]}`,
+			mockBehavior: func(s *MockChatFolderService) {
+				s.On("Create", mock.Anything, dto.ChatFolderCreate{
+					Name:          "Work",
+					IncludeGroups: true,
+					IncludedChats: []entity.ChatID{},
+					ExcludedChats: []entity.ChatID{{ID: 3, Type: entity.GroupChatType}},
+				}).Return(entity.ChatFolder{
+					ID:            1,
+					UserID:        1,
+					Name:          "Work",
+					IncludeGroups: true,
+					ExcludedChats: []entity.ChatID{{ID: 3, Type: entity.GroupChatType}},
+					CreatedAt:     defaultCreatedAt,
+				}, nil)
+			},
+			expectedStatusCode: http.StatusCreated,
+			expectedResponseBody: `{"id":1,"name":"Work","include_dialogs":false,"include_groups":true,` +
+				`"exclude_muted":false,"exclude_archived":false,"included_chats":[],` +
+				`"excluded_chats":[{"id":3,"type":"group"}],"created_at":"2024-01-23T00:00:00Z"}`,
+		},
+		{
+			name:                 "Validation error",
+			requestBody:          `{"name":"Work","included_chats":[{"id":3,"type":"channel"}]}`,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"CM0006","message":"validation error","data":{"included_chats[0].type":"failed on the 'oneof' tag"}}`,
+		},
+		{
+			name:        "Limit is reached",
+			requestBody: `{"name":"Work","include_dialogs":true}`,
+			mockBehavior: func(s *MockChatFolderService) {
+				s.On("Create", mock.Anything, mock.Anything).Return(entity.ChatFolder{}, entity.ErrChatFolderLimitReached)
+			},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"CH0026","message":"chat folder limit is reached"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := NewMockChatFolderService(t)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(service)
+			}
+
+			cnt := NewChatFolderController(ChatFolderControllerConfig{
+				Service:   service,
+				Validator: validator.NewValidator(),
+			})
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, chatFolderListPath, strings.NewReader(testCase.requestBody))
+
+			cnt.create(rec, req)
+			resp := rec.Result()
+
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedResponseBody, string(respBody))
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/Chatyx/backend/internal/dto"
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/httputil"
+	"github.com/Chatyx/backend/pkg/httputil/middleware"
+	"github.com/Chatyx/backend/pkg/validator"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	chatSettingsPath = "/api/v1/chats/:chat_type/:chat_id/settings"
+)
+
+type ChatSettings struct {
+	MutedUntil *time.Time `json:"muted_until,omitempty"`
+	IsMuted    bool       `json:"is_muted"`
+	IsPinned   bool       `json:"is_pinned"`
+	IsArchived bool       `json:"is_archived"`
+}
+
+func NewChatSettings(settings entity.ChatSettings) ChatSettings {
+	return ChatSettings{
+		MutedUntil: settings.MutedUntil,
+		IsMuted:    settings.IsMuted(time.Now()),
+		IsPinned:   settings.PinnedOrder != nil,
+		IsArchived: settings.IsArchived,
+	}
+}
+
+type ChatSettingsUpdate struct {
+	// Notifications of the chat are muted until the time, null unmutes them
+	MutedUntil *time.Time `json:"muted_until"`
+	IsPinned   bool       `json:"is_pinned"`
+	IsArchived bool       `json:"is_archived"`
+}
+
+func (s ChatSettingsUpdate) DTO(chatID entity.ChatID) dto.ChatSettingsUpdate {
+	return dto.ChatSettingsUpdate{
+		ChatID:     chatID,
+		MutedUntil: s.MutedUntil,
+		IsPinned:   s.IsPinned,
+		IsArchived: s.IsArchived,
+	}
+}
+
+//go:generate mockery --inpackage --testonly --case underscore --name ChatSettingsService
+type ChatSettingsService interface {
+	Get(ctx context.Context, chatID entity.ChatID) (entity.ChatSettings, error)
+	Update(ctx context.Context, obj dto.ChatSettingsUpdate) (entity.ChatSettings, error)
+}
+
+type ChatSettingsControllerConfig struct {
+	Service   ChatSettingsService
+	Authorize middleware.Middleware
+	Validator validator.Validator
+}
+
+type ChatSettingsController struct {
+	service   ChatSettingsService
+	authorize middleware.Middleware
+	validator validator.Validator
+}
+
+func NewChatSettingsController(conf ChatSettingsControllerConfig) *ChatSettingsController {
+	return &ChatSettingsController{
+		service:   conf.Service,
+		authorize: conf.Authorize,
+		validator: conf.Validator,
+	}
+}
+
+func (sc *ChatSettingsController) Register(mux *httprouter.Router) {
+	mux.Handler(http.MethodGet, chatSettingsPath, sc.authorize(http.HandlerFunc(sc.detail)))
+	mux.Handler(http.MethodPut, chatSettingsPath, sc.authorize(http.HandlerFunc(sc.update)))
+}
+
+// detail gets personal settings of a specified chat
+//
+//	@Summary	Get personal settings of a specified dialog or group
+//	@Tags		chats
+//	@Accept		json
+//	@Produce	json
+//	@Param		chat_type	path		string	true	"Chat type (dialog or group)"
+//	@Param		chat_id		path		int		true	"Chat identity"
+//	@Success	200			{object}	ChatSettings
+//	@Failure	400			{object}	httputil.Error
+//	@Failure	404			{object}	httputil.Error
+//	@Failure	500			{object}	httputil.Error
+//	@Security	JWTAuth
+//	@Router		/chats/{chat_type}/{chat_id}/settings  [get]
+func (sc *ChatSettingsController) detail(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	chatID, err := sc.decodeChatID(req)
+	if err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	settings, err := sc.service.Get(ctx, chatID)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrChatNotFound):
+			httputil.RespondError(ctx, w, errChatNotFound.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusOK, NewChatSettings(settings))
+}
+
+// update updates personal settings of a specified chat
+//
+//	@Summary		Update personal settings of a specified dialog or group
+//	@Description	The chat pinned later is listed before the chats pinned earlier.
+//	@Description	Other devices of the user are notified about the change.
+//	@Tags			chats
+//	@Accept			json
+//	@Produce		json
+//	@Param			chat_type	path		string				true	"Chat type (dialog or group)"
+//	@Param			chat_id		path		int					true	"Chat identity"
+//	@Param			input		body		ChatSettingsUpdate	true	"Body to update"
+//	@Success		200			{object}	ChatSettings
+//	@Failure		400			{object}	httputil.Error
+//	@Failure		404			{object}	httputil.Error
+//	@Failure		500			{object}	httputil.Error
+//	@Security		JWTAuth
+//	@Router			/chats/{chat_type}/{chat_id}/settings  [put]
+func (sc *ChatSettingsController) update(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	chatID, err := sc.decodeChatID(req)
+	if err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	var bodyObj ChatSettingsUpdate
+
+	dec := httputil.NewRequestDecoder(req)
+	if err = dec.Body(&bodyObj); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	settings, err := sc.service.Update(ctx, bodyObj.DTO(chatID))
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrChatNotFound):
+			httputil.RespondError(ctx, w, errChatNotFound.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusOK, NewChatSettings(settings))
+}
+
+func (sc *ChatSettingsController) decodeChatID(req *http.Request) (entity.ChatID, error) {
+	var (
+		chatType string
+		chatID   int
+	)
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.MergeResults(
+		dec.Path(chatTypeParam, &chatType, nil),
+		dec.Path(chatIDParam, &chatID, nil),
+	); err != nil {
+		return entity.ChatID{}, err
+	}
+
+	if err := sc.validator.Var(chatType, chatTypeParam, "oneof=dialog group"); err != nil {
+		ve := validator.Error{}
+		if errors.As(err, &ve) {
+			return entity.ChatID{}, httputil.ErrValidationFailed.WithData(ve.Fields).Wrap(err)
+		}
+
+		return entity.ChatID{}, err
+	}
+
+	return entity.ChatID{ID: chatID, Type: entity.ChatType(chatType)}, nil
+}
@@ -0,0 +1,103 @@
+package v1
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Chatyx/backend/internal/dto"
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/validator"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestChatSettingsController_update(t *testing.T) {
+	chatID := entity.ChatID{ID: 1, Type: entity.GroupChatType}
+	pinnedOrder := 1
+
+	testCases := []struct {
+		name                 string
+		chatTypePathParam    string
+		requestBody          string
+		mockBehavior         func(s *MockChatSettingsService)
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:              "Successful",
+			chatTypePathParam: "group",
+			requestBody:       `{"muted_until":"2024-01-23T00:00:00Z","is_pinned":true}`,
+			mockBehavior: func(s *MockChatSettingsService) {
+				s.On("Update", mock.Anything, dto.ChatSettingsUpdate{
+					ChatID:     chatID,
+					MutedUntil: &defaultCreatedAt,
+					IsPinned:   true,
+				}).Return(entity.ChatSettings{
+					ChatID:      chatID,
+					MutedUntil:  &defaultCreatedAt,
+					PinnedOrder: &pinnedOrder,
+				}, nil)
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"muted_until":"2024-01-23T00:00:00Z","is_muted":false,"is_pinned":true,"is_archived":false}`,
+		},
+		{
+			name:                 "Validation error",
+			chatTypePathParam:    "channel",
+			requestBody:          `{}`,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"CM0006","message":"validation error","data":{"chat_type":"failed on the 'oneof' tag"}}`,
+		},
+		{
+			name:              "Chat is not found",
+			chatTypePathParam: "group",
+			requestBody:       `{"is_archived":true}`,
+			mockBehavior: func(s *MockChatSettingsService) {
+				s.On("Update", mock.Anything, mock.Anything).Return(entity.ChatSettings{}, entity.ErrChatNotFound)
+			},
+			expectedStatusCode:   http.StatusNotFound,
+			expectedResponseBody: `{"code":"CH0024","message":"chat is not found"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := NewMockChatSettingsService(t)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(service)
+			}
+
+			cnt := NewChatSettingsController(ChatSettingsControllerConfig{
+				Service:   service,
+				Validator: validator.NewValidator(),
+			})
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPut, chatSettingsPath, strings.NewReader(testCase.requestBody))
+			ctx := context.WithValue(
+				req.Context(),
+				httprouter.ParamsKey,
+				httprouter.Params{
+					{Key: "chat_type", Value: testCase.chatTypePathParam},
+					{Key: "chat_id", Value: "1"},
+				},
+			)
+			req = req.WithContext(ctx)
+
+			cnt.update(rec, req)
+			resp := rec.Result()
+
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedResponseBody, string(respBody))
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package v1
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Chatyx/backend/internal/dto"
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/validator"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChatListCursor(t *testing.T) {
+	cursor := dto.ChatListCursor{
+		PinnedOrder:    2,
+		LastActivityAt: defaultCreatedAt,
+		ChatID:         5,
+	}
+
+	got, err := decodeChatListCursor(encodeChatListCursor(cursor))
+	require.NoError(t, err)
+	assert.Equal(t, cursor.PinnedOrder, got.PinnedOrder)
+	assert.True(t, cursor.LastActivityAt.Equal(got.LastActivityAt))
+	assert.Equal(t, cursor.ChatID, got.ChatID)
+
+	for _, s := range []string{"!!!", "MTIz", "YWJjOjE"} {
+		_, err = decodeChatListCursor(s)
+		assert.ErrorIs(t, err, errInvalidCursor, s)
+	}
+}
+
+func TestChatController_list(t *testing.T) {
+	items := []entity.ChatListItem{
+		{
+			ChatID:        entity.ChatID{ID: 2, Type: entity.DialogChatType},
+			PartnerUserID: 3,
+			LastMessage: &entity.Message{
+				ID:          10,
+				ChatID:      entity.ChatID{ID: 2, Type: entity.DialogChatType},
+				SenderID:    3,
+				Content:     "Hello",
+				ContentType: entity.TextContentType,
+				SentAt:      defaultCreatedAt,
+			},
+			UnreadCount:    1,
+			CreatedAt:      defaultCreatedAt,
+			LastActivityAt: defaultCreatedAt,
+		},
+		{
+			ChatID:         entity.ChatID{ID: 1, Type: entity.GroupChatType},
+			Name:           "Test group",
+			IsMuted:        true,
+			CreatedAt:      defaultCreatedAt,
+			LastActivityAt: defaultCreatedAt,
+		},
+	}
+	nextCursor := encodeChatListCursor(dto.ChatListCursor{LastActivityAt: defaultCreatedAt, ChatID: 1})
+
+	testCases := []struct {
+		name                 string
+		query                string
+		mockBehavior         func(s *MockChatService)
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:  "Successful",
+			query: "?limit=2",
+			mockBehavior: func(s *MockChatService) {
+				s.On("List", mock.Anything, dto.ChatList{Limit: 2}).Return(items, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponseBody: `{"total":2,"data":[` +
+				`{"id":2,"type":"dialog","partner_user_id":3,"last_message":{"id":10,"sender_id":3,"content":"Hello","content_type":"text","is_service":false,"sent_at":"2024-01-23T00:00:00Z"},"unread_count":1,"is_muted":false,"is_pinned":false,"is_archived":false,"created_at":"2024-01-23T00:00:00Z","last_activity_at":"2024-01-23T00:00:00Z"},` +
+				`{"id":1,"type":"group","name":"Test group","unread_count":0,"is_muted":true,"is_pinned":false,"is_archived":false,"created_at":"2024-01-23T00:00:00Z","last_activity_at":"2024-01-23T00:00:00Z"}` +
+				`],"next_cursor":"` + nextCursor + `"}`,
+		},
+		{
+			name:  "Successful with cursor",
+			query: "?cursor=" + nextCursor,
+			mockBehavior: func(s *MockChatService) {
+				s.On("List", mock.Anything, mock.MatchedBy(func(obj dto.ChatList) bool {
+					return obj.Limit == defaultLimit && obj.Cursor != nil &&
+						obj.Cursor.ChatID == 1 && obj.Cursor.LastActivityAt.Equal(defaultCreatedAt)
+				})).Return(nil, nil)
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"total":0,"data":[]}`,
+		},
+		{
+			name:  "Successful with folder",
+			query: "?folder_id=3&archived=true",
+			mockBehavior: func(s *MockChatService) {
+				folderID := 3
+				s.On("List", mock.Anything, dto.ChatList{Archived: true, FolderID: &folderID, Limit: defaultLimit}).Return(nil, nil)
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"total":0,"data":[]}`,
+		},
+		{
+			name:  "Chat folder is not found",
+			query: "?folder_id=3",
+			mockBehavior: func(s *MockChatService) {
+				s.On("List", mock.Anything, mock.Anything).Return(nil, entity.ErrChatFolderNotFound)
+			},
+			expectedStatusCode:   http.StatusNotFound,
+			expectedResponseBody: `{"code":"CH0025","message":"chat folder is not found"}`,
+		},
+		{
+			name:                 "Invalid cursor",
+			query:                "?cursor=abc",
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"CM0006","message":"validation error","data":{"cursor":"invalid cursor"}}`,
+		},
+		{
+			name:                 "Validation error",
+			query:                "?limit=500",
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"CM0006","message":"validation error","data":{"limit":"failed on the 'max' tag"}}`,
+		},
+		{
+			name: "Internal server error",
+			mockBehavior: func(s *MockChatService) {
+				s.On("List", mock.Anything, mock.Anything).Return(nil, errUnexpected)
+			},
+			expectedStatusCode:   http.StatusInternalServerError,
+			expectedResponseBody: `{"code":"CM0001","message":"internal server error"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := NewMockChatService(t)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(service)
+			}
+
+			cnt := NewChatController(ChatControllerConfig{
+				Service:   service,
+				Validator: validator.NewValidator(),
+			})
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, chatListPath+testCase.query, nil)
+
+			cnt.list(rec, req)
+			resp := rec.Result()
+
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedResponseBody, string(respBody))
+		})
+	}
+}
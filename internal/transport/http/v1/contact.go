@@ -0,0 +1,368 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/Chatyx/backend/internal/dto"
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/httputil"
+	"github.com/Chatyx/backend/pkg/httputil/middleware"
+	"github.com/Chatyx/backend/pkg/validator"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	contactListPath   = "/api/v1/contacts"
+	contactImportPath = "/api/v1/contacts/import"
+	contactDetailPath = "/api/v1/contacts/:user_id"
+)
+
+type Contact struct {
+	UserID      int       `json:"user_id"`
+	DisplayName string    `json:"display_name,omitempty"`
+	IsMutual    bool      `json:"is_mutual"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func NewContact(contact entity.Contact) Contact {
+	return Contact{
+		UserID:      contact.ContactUserID,
+		DisplayName: contact.DisplayName,
+		IsMutual:    contact.IsMutual,
+		CreatedAt:   contact.CreatedAt,
+	}
+}
+
+type ContactList struct {
+	Total int       `json:"total"`
+	Data  []Contact `json:"data"`
+}
+
+func NewContactList(contacts []entity.Contact) ContactList {
+	data := make([]Contact, len(contacts))
+	for i, contact := range contacts {
+		data[i] = NewContact(contact)
+	}
+
+	return ContactList{
+		Total: len(contacts),
+		Data:  data,
+	}
+}
+
+type ContactCreate struct {
+	UserID      int    `json:"user_id"      validate:"required,min=1"`
+	DisplayName string `json:"display_name" validate:"max=100"`
+}
+
+func (cc ContactCreate) DTO() dto.ContactCreate {
+	return dto.ContactCreate{
+		ContactUserID: cc.UserID,
+		DisplayName:   cc.DisplayName,
+	}
+}
+
+type ContactUpdate struct {
+	DisplayName string `json:"display_name" validate:"max=100"`
+}
+
+func (cu ContactUpdate) DTO(contactUserID int) dto.ContactUpdate {
+	return dto.ContactUpdate{
+		ContactUserID: contactUserID,
+		DisplayName:   cu.DisplayName,
+	}
+}
+
+type ContactImport struct {
+	Usernames []string `json:"usernames" validate:"max=100,dive,required,max=50"`
+	Emails    []string `json:"emails"    validate:"max=100,dive,required,email,max=255"`
+}
+
+func (ci ContactImport) DTO() dto.ContactImport {
+	return dto.ContactImport{
+		Usernames: ci.Usernames,
+		Emails:    ci.Emails,
+	}
+}
+
+type ContactImportResult struct {
+	Query  string                     `json:"query"`
+	UserID int                        `json:"user_id,omitempty"`
+	Status entity.ContactImportStatus `json:"status"`
+}
+
+type ContactImportResultList struct {
+	Total int                   `json:"total"`
+	Data  []ContactImportResult `json:"data"`
+}
+
+func NewContactImportResultList(results []entity.ContactImportResult) ContactImportResultList {
+	data := make([]ContactImportResult, len(results))
+	for i, result := range results {
+		data[i] = ContactImportResult{
+			Query:  result.Query,
+			UserID: result.UserID,
+			Status: result.Status,
+		}
+	}
+
+	return ContactImportResultList{
+		Total: len(results),
+		Data:  data,
+	}
+}
+
+//go:generate mockery --inpackage --testonly --case underscore --name ContactService
+type ContactService interface {
+	List(ctx context.Context) ([]entity.Contact, error)
+	Create(ctx context.Context, obj dto.ContactCreate) (entity.Contact, error)
+	Update(ctx context.Context, obj dto.ContactUpdate) (entity.Contact, error)
+	Delete(ctx context.Context, contactUserID int) error
+	Import(ctx context.Context, obj dto.ContactImport) ([]entity.ContactImportResult, error)
+}
+
+type ContactControllerConfig struct {
+	Service   ContactService
+	Authorize middleware.Middleware
+	Validator validator.Validator
+}
+
+type ContactController struct {
+	service   ContactService
+	authorize middleware.Middleware
+	validator validator.Validator
+}
+
+func NewContactController(conf ContactControllerConfig) *ContactController {
+	return &ContactController{
+		service:   conf.Service,
+		authorize: conf.Authorize,
+		validator: conf.Validator,
+	}
+}
+
+func (cc *ContactController) Register(mux *httprouter.Router) {
+	mux.Handler(http.MethodGet, contactListPath, cc.authorize(http.HandlerFunc(cc.list)))
+	mux.Handler(http.MethodPost, contactListPath, cc.authorize(http.HandlerFunc(cc.create)))
+	mux.Handler(http.MethodPost, contactImportPath, cc.authorize(http.HandlerFunc(cc.importContacts)))
+	mux.Handler(http.MethodPut, contactDetailPath, cc.authorize(http.HandlerFunc(cc.update)))
+	mux.Handler(http.MethodDelete, contactDetailPath, cc.authorize(http.HandlerFunc(cc.delete)))
+}
+
+// list lists contacts of the current user
+//
+//	@Summary	List contacts of the current user
+//	@Tags		contacts
+//	@Accept		json
+//	@Produce	json
+//	@Success	200	{object}	ContactList
+//	@Failure	500	{object}	httputil.Error
+//	@Security	JWTAuth
+//	@Router		/contacts  [get]
+func (cc *ContactController) list(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	contacts, err := cc.service.List(ctx)
+	if err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusOK, NewContactList(contacts))
+}
+
+// create adds a specified user to contacts of the current user
+//
+//	@Summary	Add a specified user to contacts of the current user
+//	@Tags		contacts
+//	@Accept		json
+//	@Produce	json
+//	@Param		input	body		ContactCreate	true	"Body to create"
+//	@Success	201		{object}	Contact
+//	@Failure	400		{object}	httputil.Error
+//	@Failure	500		{object}	httputil.Error
+//	@Security	JWTAuth
+//	@Router		/contacts  [post]
+func (cc *ContactController) create(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var bodyObj ContactCreate
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.Body(&bodyObj); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	if err := cc.validator.Struct(bodyObj); err != nil {
+		ve := validator.Error{}
+		if errors.As(err, &ve) {
+			httputil.RespondError(ctx, w, httputil.ErrValidationFailed.WithData(ve.Fields).Wrap(err))
+			return
+		}
+
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	contact, err := cc.service.Create(ctx, bodyObj.DTO())
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrSuchContactAlreadyExists):
+			httputil.RespondError(ctx, w, errSuchContactAlreadyExists.Wrap(err))
+		case errors.Is(err, entity.ErrAddYourselfToContacts):
+			httputil.RespondError(ctx, w, errAddYourselfToContacts.Wrap(err))
+		case errors.Is(err, entity.ErrAddNonExistentUserToContacts):
+			httputil.RespondError(ctx, w, errAddNonExistentUserToContacts.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusCreated, NewContact(contact))
+}
+
+// importContacts adds users found by usernames and emails to contacts of the current user
+//
+//	@Summary		Add users found by usernames and emails to contacts of the current user
+//	@Description	Users who can't be added don't fail the request, the reason is returned for every username and email.
+//	@Tags			contacts
+//	@Accept			json
+//	@Produce		json
+//	@Param			input	body		ContactImport	true	"Usernames and emails to import"
+//	@Success		200		{object}	ContactImportResultList
+//	@Failure		400		{object}	httputil.Error
+//	@Failure		500		{object}	httputil.Error
+//	@Security		JWTAuth
+//	@Router			/contacts/import  [post]
+func (cc *ContactController) importContacts(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var bodyObj ContactImport
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.Body(&bodyObj); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	if err := cc.validator.Struct(bodyObj); err != nil {
+		ve := validator.Error{}
+		if errors.As(err, &ve) {
+			httputil.RespondError(ctx, w, httputil.ErrValidationFailed.WithData(ve.Fields).Wrap(err))
+			return
+		}
+
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	results, err := cc.service.Import(ctx, bodyObj.DTO())
+	if err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusOK, NewContactImportResultList(results))
+}
+
+// update updates a display name of a specified contact
+//
+//	@Summary	Update a display name of a specified contact
+//	@Tags		contacts
+//	@Accept		json
+//	@Produce	json
+//	@Param		user_id	path		int				true	"Contact user identity"
+//	@Param		input	body		ContactUpdate	true	"Body to update"
+//	@Success	200		{object}	Contact
+//	@Failure	400		{object}	httputil.Error
+//	@Failure	404		{object}	httputil.Error
+//	@Failure	500		{object}	httputil.Error
+//	@Security	JWTAuth
+//	@Router		/contacts/{user_id}  [put]
+func (cc *ContactController) update(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var (
+		userID  int
+		bodyObj ContactUpdate
+	)
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.MergeResults(
+		dec.Path(userIDParam, &userID, nil),
+		dec.Body(&bodyObj),
+	); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	if err := cc.validator.Struct(bodyObj); err != nil {
+		ve := validator.Error{}
+		if errors.As(err, &ve) {
+			httputil.RespondError(ctx, w, httputil.ErrValidationFailed.WithData(ve.Fields).Wrap(err))
+			return
+		}
+
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	contact, err := cc.service.Update(ctx, bodyObj.DTO(userID))
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrContactNotFound):
+			httputil.RespondError(ctx, w, errContactNotFound.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusOK, NewContact(contact))
+}
+
+// delete removes a specified user from contacts of the current user
+//
+//	@Summary	Remove a specified user from contacts of the current user
+//	@Tags		contacts
+//	@Accept		json
+//	@Produce	json
+//	@Param		user_id	path	int	true	"Contact user identity"
+//	@Success	204		"No Content"
+//	@Failure	400		{object}	httputil.Error
+//	@Failure	404		{object}	httputil.Error
+//	@Failure	500		{object}	httputil.Error
+//	@Security	JWTAuth
+//	@Router		/contacts/{user_id}  [delete]
+func (cc *ContactController) delete(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var userID int
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.Path(userIDParam, &userID, nil); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	if err := cc.service.Delete(ctx, userID); err != nil {
+		switch {
+		case errors.Is(err, entity.ErrContactNotFound):
+			httputil.RespondError(ctx, w, errContactNotFound.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusNoContent, nil)
+}
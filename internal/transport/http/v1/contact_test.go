@@ -0,0 +1,124 @@
+package v1
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Chatyx/backend/internal/dto"
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/validator"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestContactController_create(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		requestBody          string
+		mockBehavior         func(s *MockContactService)
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:        "Successful",
+			requestBody: `{"user_id":2,"display_name":"Mick"}`,
+			mockBehavior: func(s *MockContactService) {
+				s.On("Create", mock.Anything, dto.ContactCreate{
+					ContactUserID: 2,
+					DisplayName:   "Mick",
+				}).Return(entity.Contact{
+					UserID:        1,
+					ContactUserID: 2,
+					DisplayName:   "Mick",
+					IsMutual:      true,
+					CreatedAt:     defaultCreatedAt,
+				}, nil)
+			},
+			expectedStatusCode:   http.StatusCreated,
+			expectedResponseBody: `{"user_id":2,"display_name":"Mick","is_mutual":true,"created_at":"2024-01-23T00:00:00Z"}`,
+		},
+		{
+			name:                 "Validation error",
+			requestBody:          `{"display_name":"Mick"}`,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"CM0006","message":"validation error","data":{"user_id":"failed on the 'required' tag"}}`,
+		},
+		{
+			name:        "Contact already exists",
+			requestBody: `{"user_id":2}`,
+			mockBehavior: func(s *MockContactService) {
+				s.On("Create", mock.Anything, mock.Anything).Return(entity.Contact{}, entity.ErrSuchContactAlreadyExists)
+			},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"US0010","message":"such a contact already exists"}`,
+		},
+		{
+			name:        "Adding yourself",
+			requestBody: `{"user_id":1}`,
+			mockBehavior: func(s *MockContactService) {
+				s.On("Create", mock.Anything, mock.Anything).Return(entity.Contact{}, entity.ErrAddYourselfToContacts)
+			},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"US0011","message":"adding yourself to contacts"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := NewMockContactService(t)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(service)
+			}
+
+			cnt := NewContactController(ContactControllerConfig{
+				Service:   service,
+				Validator: validator.NewValidator(),
+			})
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, contactListPath, strings.NewReader(testCase.requestBody))
+
+			cnt.create(rec, req)
+			resp := rec.Result()
+
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedResponseBody, string(respBody))
+		})
+	}
+}
+
+func TestContactController_importContacts(t *testing.T) {
+	service := NewMockContactService(t)
+	service.On("Import", mock.Anything, dto.ContactImport{
+		Usernames: []string{"mick49"},
+		Emails:    []string{"nobody@gmail.com"},
+	}).Return([]entity.ContactImportResult{
+		{Query: "mick49", UserID: 2, Status: entity.AddedContactImportStatus},
+		{Query: "nobody@gmail.com", Status: entity.NotFoundContactImportStatus},
+	}, nil)
+
+	cnt := NewContactController(ContactControllerConfig{
+		Service:   service,
+		Validator: validator.NewValidator(),
+	})
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, contactImportPath,
+		strings.NewReader(`{"usernames":["mick49"],"emails":["nobody@gmail.com"]}`))
+
+	cnt.importContacts(rec, req)
+	resp := rec.Result()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	respBody, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"total":2,"data":[{"query":"mick49","user_id":2,"status":"added"},{"query":"nobody@gmail.com","status":"not_found"}]}`, string(respBody))
+}
@@ -16,12 +16,19 @@ import (
 )
 
 const (
-	dialogListPath   = "/api/v1/dialogs"
-	dialogDetailPath = "/api/v1/dialogs/:dialog_id"
+	dialogListPath        = "/api/v1/dialogs"
+	dialogDetailPath      = "/api/v1/dialogs/:dialog_id"
+	dialogRequestListPath = "/api/v1/dialog-requests"
+	dialogAcceptPath      = "/api/v1/dialogs/:dialog_id/accept"
+	dialogDeclinePath     = "/api/v1/dialogs/:dialog_id/decline"
+	dialogReportPath      = "/api/v1/dialogs/:dialog_id/report"
+	dialogClearPath       = "/api/v1/dialogs/:dialog_id/clear"
+	savedMessagesPath     = "/api/v1/saved-messages"
 )
 
 const (
-	dialogIDParam = "dialog_id"
+	dialogIDParam      = "dialog_id"
+	dialogForBothParam = "for_both"
 )
 
 type DialogPartner struct {
@@ -32,6 +39,7 @@ type DialogPartner struct {
 type Dialog struct {
 	ID        int           `json:"id"`
 	IsBlocked bool          `json:"is_blocked"`
+	IsRequest bool          `json:"is_request,omitempty"`
 	Partner   DialogPartner `json:"partner"`
 	CreatedAt time.Time     `json:"created_at"`
 }
@@ -40,6 +48,7 @@ func NewDialog(dialog entity.Dialog) Dialog {
 	return Dialog{
 		ID:        dialog.ID,
 		IsBlocked: dialog.IsBlocked,
+		IsRequest: dialog.IsRequest,
 		Partner: DialogPartner{
 			UserID:    dialog.Partner.UserID,
 			IsBlocked: dialog.Partner.IsBlocked,
@@ -90,9 +99,16 @@ func (d DialogUpdate) DTO() dto.DialogUpdate {
 //go:generate mockery --inpackage --testonly --case underscore --name DialogService
 type DialogService interface {
 	List(ctx context.Context) ([]entity.Dialog, error)
+	ListRequests(ctx context.Context) ([]entity.Dialog, error)
 	Create(ctx context.Context, obj dto.DialogCreate) (entity.Dialog, error)
 	GetByID(ctx context.Context, id int) (entity.Dialog, error)
+	GetSaved(ctx context.Context) (entity.Dialog, error)
 	Update(ctx context.Context, obj dto.DialogUpdate) error
+	ClearHistory(ctx context.Context, dialogID int) error
+	Delete(ctx context.Context, obj dto.DialogDelete) error
+	AcceptRequest(ctx context.Context, dialogID int) error
+	DeclineRequest(ctx context.Context, dialogID int) error
+	ReportRequest(ctx context.Context, dialogID int) error
 }
 
 type DialogControllerConfig struct {
@@ -120,18 +136,26 @@ func (dc *DialogController) Register(mux *httprouter.Router) {
 	mux.Handler(http.MethodPost, dialogListPath, dc.authorize(http.HandlerFunc(dc.create)))
 	mux.Handler(http.MethodGet, dialogDetailPath, dc.authorize(http.HandlerFunc(dc.detail)))
 	mux.Handler(http.MethodPatch, dialogDetailPath, dc.authorize(http.HandlerFunc(dc.update)))
+	mux.Handler(http.MethodDelete, dialogDetailPath, dc.authorize(http.HandlerFunc(dc.delete)))
+	mux.Handler(http.MethodPost, dialogClearPath, dc.authorize(http.HandlerFunc(dc.clearHistory)))
+	mux.Handler(http.MethodGet, dialogRequestListPath, dc.authorize(http.HandlerFunc(dc.listRequests)))
+	mux.Handler(http.MethodPost, dialogAcceptPath, dc.authorize(http.HandlerFunc(dc.accept)))
+	mux.Handler(http.MethodPost, dialogDeclinePath, dc.authorize(http.HandlerFunc(dc.decline)))
+	mux.Handler(http.MethodPost, dialogReportPath, dc.authorize(http.HandlerFunc(dc.report)))
+	mux.Handler(http.MethodGet, savedMessagesPath, dc.authorize(http.HandlerFunc(dc.saved)))
 }
 
 // list lists all dialogs
 //
-//	@Summary	List all dialogs
-//	@Tags		dialogs
-//	@Accept		json
-//	@Produce	json
-//	@Success	200	{object}	DialogList
-//	@Failure	500	{object}	httputil.Error
-//	@Security	JWTAuth
-//	@Router		/dialogs  [get]
+//	@Summary		List all dialogs
+//	@Description	Message requests which the current user hasn't accepted aren't listed.
+//	@Tags			dialogs
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	DialogList
+//	@Failure		500	{object}	httputil.Error
+//	@Security		JWTAuth
+//	@Router			/dialogs  [get]
 func (dc *DialogController) list(w http.ResponseWriter, req *http.Request) {
 	ctx := req.Context()
 
@@ -144,18 +168,43 @@ func (dc *DialogController) list(w http.ResponseWriter, req *http.Request) {
 	httputil.RespondSuccess(ctx, w, http.StatusOK, NewDialogList(dialogs))
 }
 
+// listRequests lists message requests sent to the current user
+//
+//	@Summary		List message requests sent to the current user
+//	@Description	Dialogs started by users who aren't in the contacts of the current user.
+//	@Tags			dialogs
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	DialogList
+//	@Failure		500	{object}	httputil.Error
+//	@Security		JWTAuth
+//	@Router			/dialog-requests  [get]
+func (dc *DialogController) listRequests(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	dialogs, err := dc.service.ListRequests(ctx)
+	if err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusOK, NewDialogList(dialogs))
+}
+
 // create creates a dialog with a specified partner
 //
-//	@Summary	Create a dialog with a specified partner
-//	@Tags		dialogs
-//	@Accept		json
-//	@Produce	json
-//	@Param		input	body		DialogCreate	true	"Body to create"
-//	@Success	201		{object}	Dialog
-//	@Failure	400		{object}	httputil.Error
-//	@Failure	500		{object}	httputil.Error
-//	@Security	JWTAuth
-//	@Router		/dialogs  [post]
+//	@Summary		Create a dialog with a specified partner
+//	@Description	If the current user isn't in the contacts of the partner, the dialog becomes a message request.
+//	@Tags			dialogs
+//	@Accept			json
+//	@Produce		json
+//	@Param			input	body		DialogCreate	true	"Body to create"
+//	@Success		201		{object}	Dialog
+//	@Failure		400		{object}	httputil.Error
+//	@Failure		403		{object}	httputil.Error
+//	@Failure		500		{object}	httputil.Error
+//	@Security		JWTAuth
+//	@Router			/dialogs  [post]
 func (dc *DialogController) create(w http.ResponseWriter, req *http.Request) {
 	ctx := req.Context()
 
@@ -187,6 +236,8 @@ func (dc *DialogController) create(w http.ResponseWriter, req *http.Request) {
 			httputil.RespondError(ctx, w, errCreateDialogWithYourself.Wrap(err))
 		case errors.Is(err, entity.ErrCreateDialogWithNonExistentUser):
 			httputil.RespondError(ctx, w, errCreateDialogWithNonExistenceUser.Wrap(err))
+		case errors.Is(err, entity.ErrUserBlocked):
+			httputil.RespondError(ctx, w, errUserBlocked.Wrap(err))
 		default:
 			httputil.RespondError(ctx, w, err)
 		}
@@ -236,6 +287,29 @@ func (dc *DialogController) detail(w http.ResponseWriter, req *http.Request) {
 	httputil.RespondSuccess(ctx, w, http.StatusOK, NewDialog(dialog))
 }
 
+// saved gets the saved messages dialog of the current user
+//
+//	@Summary		Get the saved messages dialog of the current user
+//	@Description	The dialog of the current user with themselves is created on the first request.
+//	@Tags			dialogs
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	Dialog
+//	@Failure		500	{object}	httputil.Error
+//	@Security		JWTAuth
+//	@Router			/saved-messages  [get]
+func (dc *DialogController) saved(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	dialog, err := dc.service.GetSaved(ctx)
+	if err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusOK, NewDialog(dialog))
+}
+
 // update updates a specified dialog
 //
 //	@Summary	Update a specified dialog
@@ -246,6 +320,7 @@ func (dc *DialogController) detail(w http.ResponseWriter, req *http.Request) {
 //	@Param		input		body	DialogUpdate	true	"Body to update"
 //	@Success	204			"No Content"
 //	@Failure	400			{object}	httputil.Error
+//	@Failure	403			{object}	httputil.Error
 //	@Failure	404			{object}	httputil.Error
 //	@Failure	500			{object}	httputil.Error
 //	@Security	JWTAuth
@@ -275,6 +350,171 @@ func (dc *DialogController) update(w http.ResponseWriter, req *http.Request) {
 		switch {
 		case errors.Is(err, entity.ErrDialogNotFound):
 			httputil.RespondError(ctx, w, errDialogNotFound.Wrap(err))
+		case errors.Is(err, entity.ErrForbiddenPerformAction):
+			httputil.RespondError(ctx, w, httputil.ErrForbiddenPerformAction.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusNoContent, nil)
+}
+
+// delete deletes a specified dialog
+//
+//	@Summary		Delete a specified dialog
+//	@Description	By default the dialog is deleted on the side of the current user only and is shown again once a new message arrives.
+//	@Tags			dialogs
+//	@Accept			json
+//	@Produce		json
+//	@Param			dialog_id	path	int		true	"Dialog identity"
+//	@Param			for_both	query	bool	false	"Delete the dialog with its messages for both sides"
+//	@Success		204			"No Content"
+//	@Failure		400			{object}	httputil.Error
+//	@Failure		404			{object}	httputil.Error
+//	@Failure		500			{object}	httputil.Error
+//	@Security		JWTAuth
+//	@Router			/dialogs/{dialog_id}  [delete]
+func (dc *DialogController) delete(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var obj dto.DialogDelete
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.MergeResults(
+		dec.Path(dialogIDParam, &obj.ID, nil),
+		dec.Query(dialogForBothParam, &obj.ForBoth, false),
+	); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	if err := dc.service.Delete(ctx, obj); err != nil {
+		switch {
+		case errors.Is(err, entity.ErrDialogNotFound):
+			httputil.RespondError(ctx, w, errDialogNotFound.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusNoContent, nil)
+}
+
+// clearHistory clears the history of a specified dialog
+//
+//	@Summary		Clear the history of a specified dialog
+//	@Description	Messages are cleared on the side of the current user only.
+//	@Tags			dialogs
+//	@Accept			json
+//	@Produce		json
+//	@Param			dialog_id	path	int	true	"Dialog identity"
+//	@Success		204			"No Content"
+//	@Failure		400			{object}	httputil.Error
+//	@Failure		404			{object}	httputil.Error
+//	@Failure		500			{object}	httputil.Error
+//	@Security		JWTAuth
+//	@Router			/dialogs/{dialog_id}/clear  [post]
+func (dc *DialogController) clearHistory(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var dialogID int
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.Path(dialogIDParam, &dialogID, nil); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	if err := dc.service.ClearHistory(ctx, dialogID); err != nil {
+		switch {
+		case errors.Is(err, entity.ErrDialogNotFound):
+			httputil.RespondError(ctx, w, errDialogNotFound.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusNoContent, nil)
+}
+
+// accept accepts a message request in a specified dialog
+//
+//	@Summary	Accept a message request in a specified dialog
+//	@Tags		dialogs
+//	@Accept		json
+//	@Produce	json
+//	@Param		dialog_id	path	int	true	"Dialog identity"
+//	@Success	204			"No Content"
+//	@Failure	400			{object}	httputil.Error
+//	@Failure	404			{object}	httputil.Error
+//	@Failure	500			{object}	httputil.Error
+//	@Security	JWTAuth
+//	@Router		/dialogs/{dialog_id}/accept  [post]
+func (dc *DialogController) accept(w http.ResponseWriter, req *http.Request) {
+	dc.resolveRequest(w, req, dc.service.AcceptRequest)
+}
+
+// decline declines a message request in a specified dialog
+//
+//	@Summary	Decline a message request in a specified dialog
+//	@Tags		dialogs
+//	@Accept		json
+//	@Produce	json
+//	@Param		dialog_id	path	int	true	"Dialog identity"
+//	@Success	204			"No Content"
+//	@Failure	400			{object}	httputil.Error
+//	@Failure	404			{object}	httputil.Error
+//	@Failure	500			{object}	httputil.Error
+//	@Security	JWTAuth
+//	@Router		/dialogs/{dialog_id}/decline  [post]
+func (dc *DialogController) decline(w http.ResponseWriter, req *http.Request) {
+	dc.resolveRequest(w, req, dc.service.DeclineRequest)
+}
+
+// report reports a message request in a specified dialog as spam
+//
+//	@Summary		Report a message request in a specified dialog as spam
+//	@Description	The request is declined and its sender is blocked.
+//	@Tags			dialogs
+//	@Accept			json
+//	@Produce		json
+//	@Param			dialog_id	path	int	true	"Dialog identity"
+//	@Success		204			"No Content"
+//	@Failure		400			{object}	httputil.Error
+//	@Failure		404			{object}	httputil.Error
+//	@Failure		500			{object}	httputil.Error
+//	@Security		JWTAuth
+//	@Router			/dialogs/{dialog_id}/report  [post]
+func (dc *DialogController) report(w http.ResponseWriter, req *http.Request) {
+	dc.resolveRequest(w, req, dc.service.ReportRequest)
+}
+
+type resolveDialogRequestFunc func(ctx context.Context, dialogID int) error
+
+func (dc *DialogController) resolveRequest(w http.ResponseWriter, req *http.Request, resolve resolveDialogRequestFunc) {
+	ctx := req.Context()
+
+	var dialogID int
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.Path(dialogIDParam, &dialogID, nil); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	if err := resolve(ctx, dialogID); err != nil {
+		switch {
+		case errors.Is(err, entity.ErrDialogNotFound):
+			httputil.RespondError(ctx, w, errDialogNotFound.Wrap(err))
+		case errors.Is(err, entity.ErrDialogRequestNotFound):
+			httputil.RespondError(ctx, w, errDialogRequestNotFound.Wrap(err))
 		default:
 			httputil.RespondError(ctx, w, err)
 		}
@@ -340,6 +340,19 @@ func TestDialogController_update(t *testing.T) {
 			expectedStatusCode:   http.StatusNotFound,
 			expectedResponseBody: `{"code":"CH0002","message":"dialog is not found"}`,
 		},
+		{
+			name:              "Partner is in the blocklist",
+			dialogIDPathParam: "1",
+			requestBody:       `{"partner":{"is_blocked":false}}`,
+			mockBehavior: func(s *MockDialogService) {
+				s.On("Update", mock.Anything, dto.DialogUpdate{
+					ID:               1,
+					PartnerIsBlocked: boolPtr(false),
+				}).Return(entity.ErrForbiddenPerformAction)
+			},
+			expectedStatusCode:   http.StatusForbidden,
+			expectedResponseBody: `{"code":"CM0008","message":"it's forbidden to perform this action"}`,
+		},
 		{
 			name:              "Internal server error",
 			dialogIDPathParam: "1",
@@ -391,3 +404,208 @@ func TestDialogController_update(t *testing.T) {
 func boolPtr(b bool) *bool {
 	return &b
 }
+
+func TestDialogController_accept(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		dialogIDPathParam    string
+		mockBehavior         func(s *MockDialogService)
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:              "Successful",
+			dialogIDPathParam: "1",
+			mockBehavior: func(s *MockDialogService) {
+				s.On("AcceptRequest", mock.Anything, 1).Return(nil)
+			},
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name:                 "Decode path param error",
+			dialogIDPathParam:    uuid.New().String(),
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"CM0003","message":"decode path params error","data":{"dialog_id":"failed to parse int"}}`,
+		},
+		{
+			name:              "Dialog request is not found",
+			dialogIDPathParam: "1",
+			mockBehavior: func(s *MockDialogService) {
+				s.On("AcceptRequest", mock.Anything, 1).Return(entity.ErrDialogRequestNotFound)
+			},
+			expectedStatusCode:   http.StatusNotFound,
+			expectedResponseBody: `{"code":"CH0023","message":"dialog request is not found"}`,
+		},
+		{
+			name:              "Internal server error",
+			dialogIDPathParam: "1",
+			mockBehavior: func(s *MockDialogService) {
+				s.On("AcceptRequest", mock.Anything, 1).Return(errUnexpected)
+			},
+			expectedStatusCode:   http.StatusInternalServerError,
+			expectedResponseBody: `{"code":"CM0001","message":"internal server error"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := NewMockDialogService(t)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(service)
+			}
+
+			cnt := NewDialogController(DialogControllerConfig{
+				Service:   service,
+				Validator: validator.NewValidator(),
+			})
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, dialogAcceptPath, nil)
+			ctx := context.WithValue(
+				req.Context(),
+				httprouter.ParamsKey,
+				httprouter.Params{{Key: "dialog_id", Value: testCase.dialogIDPathParam}},
+			)
+			req = req.WithContext(ctx)
+
+			cnt.accept(rec, req)
+			resp := rec.Result()
+
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedResponseBody, string(respBody))
+		})
+	}
+}
+
+func TestDialogController_saved(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		mockBehavior         func(s *MockDialogService)
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Successful",
+			mockBehavior: func(s *MockDialogService) {
+				s.On("GetSaved", mock.Anything).Return(entity.Dialog{
+					ID:        1,
+					Partner:   entity.DialogPartner{UserID: 1},
+					CreatedAt: defaultCreatedAt,
+				}, nil)
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"id":1,"is_blocked":false,"partner":{"user_id":1,"is_blocked":false},"created_at":"2024-01-23T00:00:00Z"}`,
+		},
+		{
+			name: "Internal server error",
+			mockBehavior: func(s *MockDialogService) {
+				s.On("GetSaved", mock.Anything).Return(entity.Dialog{}, errUnexpected)
+			},
+			expectedStatusCode:   http.StatusInternalServerError,
+			expectedResponseBody: `{"code":"CM0001","message":"internal server error"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := NewMockDialogService(t)
+			testCase.mockBehavior(service)
+
+			cnt := NewDialogController(DialogControllerConfig{
+				Service:   service,
+				Validator: validator.NewValidator(),
+			})
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, savedMessagesPath, nil)
+
+			cnt.saved(rec, req)
+			resp := rec.Result()
+
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedResponseBody, string(respBody))
+		})
+	}
+}
+
+func TestDialogController_delete(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		dialogIDPathParam    string
+		query                string
+		mockBehavior         func(s *MockDialogService)
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:              "Successful",
+			dialogIDPathParam: "1",
+			mockBehavior: func(s *MockDialogService) {
+				s.On("Delete", mock.Anything, dto.DialogDelete{ID: 1}).Return(nil)
+			},
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name:              "Successful for both sides",
+			dialogIDPathParam: "1",
+			query:             "?for_both=true",
+			mockBehavior: func(s *MockDialogService) {
+				s.On("Delete", mock.Anything, dto.DialogDelete{ID: 1, ForBoth: true}).Return(nil)
+			},
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name:                 "Decode path param error",
+			dialogIDPathParam:    uuid.New().String(),
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"CM0003","message":"decode path params error","data":{"dialog_id":"failed to parse int"}}`,
+		},
+		{
+			name:              "Dialog is not found",
+			dialogIDPathParam: "1",
+			mockBehavior: func(s *MockDialogService) {
+				s.On("Delete", mock.Anything, dto.DialogDelete{ID: 1}).Return(entity.ErrDialogNotFound)
+			},
+			expectedStatusCode:   http.StatusNotFound,
+			expectedResponseBody: `{"code":"CH0002","message":"dialog is not found"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := NewMockDialogService(t)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(service)
+			}
+
+			cnt := NewDialogController(DialogControllerConfig{
+				Service:   service,
+				Validator: validator.NewValidator(),
+			})
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodDelete, "/api/v1/dialogs/1"+testCase.query, nil)
+			ctx := context.WithValue(
+				req.Context(),
+				httprouter.ParamsKey,
+				httprouter.Params{{Key: "dialog_id", Value: testCase.dialogIDPathParam}},
+			)
+			req = req.WithContext(ctx)
+
+			cnt.delete(rec, req)
+			resp := rec.Result()
+
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedResponseBody, string(respBody))
+		})
+	}
+}
@@ -23,9 +23,64 @@ var (
 		Message:    "wrong current password",
 		StatusCode: http.StatusBadRequest,
 	}
+	errUserBlockNotFound = httputil.Error{
+		Code:       "US0004",
+		Message:    "user block is not found",
+		StatusCode: http.StatusNotFound,
+	}
+	errSuchUserBlockAlreadyExists = httputil.Error{
+		Code:       "US0005",
+		Message:    "such a user block already exists",
+		StatusCode: http.StatusBadRequest,
+	}
+	errBlockYourself = httputil.Error{
+		Code:       "US0006",
+		Message:    "blocking yourself",
+		StatusCode: http.StatusBadRequest,
+	}
+	errBlockNonExistentUser = httputil.Error{
+		Code:       "US0007",
+		Message:    "blocking a non-existent user",
+		StatusCode: http.StatusBadRequest,
+	}
+	errUserBlocked = httputil.Error{
+		Code:       "US0008",
+		Message:    "interaction with the user is blocked",
+		StatusCode: http.StatusForbidden,
+	}
+	errContactNotFound = httputil.Error{
+		Code:       "US0009",
+		Message:    "contact is not found",
+		StatusCode: http.StatusNotFound,
+	}
+	errSuchContactAlreadyExists = httputil.Error{
+		Code:       "US0010",
+		Message:    "such a contact already exists",
+		StatusCode: http.StatusBadRequest,
+	}
+	errAddYourselfToContacts = httputil.Error{
+		Code:       "US0011",
+		Message:    "adding yourself to contacts",
+		StatusCode: http.StatusBadRequest,
+	}
+	errAddNonExistentUserToContacts = httputil.Error{
+		Code:       "US0012",
+		Message:    "adding a non-existent user to contacts",
+		StatusCode: http.StatusBadRequest,
+	}
+	errEmailNotVerified = httputil.Error{
+		Code:       "US0013",
+		Message:    "email of the user isn't verified",
+		StatusCode: http.StatusForbidden,
+	}
+	errInvalidVerificationToken = httputil.Error{
+		Code:       "US0014",
+		Message:    "email verification token is invalid or expired",
+		StatusCode: http.StatusBadRequest,
+	}
 )
 
-// chat (groups/dialogs) and participant errors.
+// chat (groups/dialogs/channels) and participant errors.
 var (
 	errGroupNotFound = httputil.Error{
 		Code:       "CH0001",
@@ -72,4 +127,94 @@ var (
 		Message:    "incorrect group participant status transit",
 		StatusCode: http.StatusBadRequest,
 	}
+	errIncorrectGroupParticipantRoleChange = httputil.Error{
+		Code:       "CH0010",
+		Message:    "incorrect group participant role change",
+		StatusCode: http.StatusBadRequest,
+	}
+	errLastGroupOwnerLeaving = httputil.Error{
+		Code:       "CH0011",
+		Message:    "the last group owner can't leave without transferring ownership",
+		StatusCode: http.StatusBadRequest,
+	}
+	errSuchGroupUnameAlreadyExists = httputil.Error{
+		Code:       "CH0012",
+		Message:    "group with such uname already exists",
+		StatusCode: http.StatusBadRequest,
+	}
+	errGroupInviteLinkNotFound = httputil.Error{
+		Code:       "CH0013",
+		Message:    "group invite link is not found",
+		StatusCode: http.StatusNotFound,
+	}
+	errInactiveGroupInviteLink = httputil.Error{
+		Code:       "CH0014",
+		Message:    "group invite link is revoked, expired or exhausted",
+		StatusCode: http.StatusBadRequest,
+	}
+	errRestrictedGroupParticipant = httputil.Error{
+		Code:       "CH0015",
+		Message:    "group participant is restricted to send messages",
+		StatusCode: http.StatusForbidden,
+	}
+	errGroupParticipantLimitReached = httputil.Error{
+		Code:       "CH0016",
+		Message:    "group participant limit is reached",
+		StatusCode: http.StatusBadRequest,
+	}
+	errChannelNotFound = httputil.Error{
+		Code:       "CH0017",
+		Message:    "channel is not found",
+		StatusCode: http.StatusNotFound,
+	}
+	errChannelSubscriberNotFound = httputil.Error{
+		Code:       "CH0018",
+		Message:    "channel subscriber is not found",
+		StatusCode: http.StatusNotFound,
+	}
+	errSuchChannelSubscriberAlreadyExists = httputil.Error{
+		Code:       "CH0019",
+		Message:    "such a channel subscriber already exists",
+		StatusCode: http.StatusBadRequest,
+	}
+	errSuchChannelUnameAlreadyExists = httputil.Error{
+		Code:       "CH0020",
+		Message:    "channel with such uname already exists",
+		StatusCode: http.StatusBadRequest,
+	}
+	errLastChannelAdminLeaving = httputil.Error{
+		Code:       "CH0021",
+		Message:    "the last channel admin can't unsubscribe or be revoked",
+		StatusCode: http.StatusBadRequest,
+	}
+	errGroupSlowModeActive = httputil.Error{
+		Code:       "CH0022",
+		Message:    "slow mode is enabled in the group, wait before posting",
+		StatusCode: http.StatusTooManyRequests,
+	}
+	errDialogRequestNotFound = httputil.Error{
+		Code:       "CH0023",
+		Message:    "dialog request is not found",
+		StatusCode: http.StatusNotFound,
+	}
+	errChatNotFound = httputil.Error{
+		Code:       "CH0024",
+		Message:    "chat is not found",
+		StatusCode: http.StatusNotFound,
+	}
+	errChatFolderNotFound = httputil.Error{
+		Code:       "CH0025",
+		Message:    "chat folder is not found",
+		StatusCode: http.StatusNotFound,
+	}
+	errChatFolderLimitReached = httputil.Error{
+		Code:       "CH0026",
+		Message:    "chat folder limit is reached",
+		StatusCode: http.StatusBadRequest,
+	}
+	errMessageNotFound = httputil.Error{
+		Code:       "CH0027",
+		Message:    "message is not found",
+		StatusCode: http.StatusNotFound,
+	}
 )
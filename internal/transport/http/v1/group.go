@@ -16,8 +16,10 @@ import (
 )
 
 const (
-	groupListPath   = "/api/v1/groups"
-	groupDetailPath = "/api/v1/groups/:group_id"
+	groupListPath     = "/api/v1/groups"
+	groupDetailPath   = "/api/v1/groups/:group_id"
+	groupSettingsPath = "/api/v1/groups/:group_id/settings"
+	groupRestorePath  = "/api/v1/groups/:group_id/restore"
 )
 
 const (
@@ -26,6 +28,7 @@ const (
 
 type Group struct {
 	ID          int       `json:"id"`
+	Uname       string    `json:"uname,omitempty"`
 	Name        string    `json:"name"`
 	Description string    `json:"description,omitempty"`
 	CreatedAt   time.Time `json:"created_at"`
@@ -34,6 +37,7 @@ type Group struct {
 func NewGroup(group entity.Group) Group {
 	return Group{
 		ID:          group.ID,
+		Uname:       group.Uname,
 		Name:        group.Name,
 		Description: group.Description,
 		CreatedAt:   group.CreatedAt,
@@ -58,29 +62,63 @@ func NewGroupList(groups []entity.Group) GroupList {
 }
 
 type GroupCreate struct {
+	Uname       string `json:"uname"       validate:"omitempty,min=5,max=32,alphanum"`
 	Name        string `json:"name"        validate:"required,max=255"`
 	Description string `json:"description" validate:"max=10000"`
 }
 
 func (g GroupCreate) DTO() dto.GroupCreate {
 	return dto.GroupCreate{
+		Uname:       g.Uname,
 		Name:        g.Name,
 		Description: g.Description,
 	}
 }
 
 type GroupUpdate struct {
+	Uname       string `json:"uname"       validate:"omitempty,min=5,max=32,alphanum"`
 	Name        string `json:"name"        validate:"required,max=255"`
 	Description string `json:"description" validate:"max=10000"`
 }
 
 func (g GroupUpdate) DTO() dto.GroupUpdate {
 	return dto.GroupUpdate{
+		Uname:       g.Uname,
 		Name:        g.Name,
 		Description: g.Description,
 	}
 }
 
+type GroupSettings struct {
+	OnlyAdminsCanPost bool `json:"only_admins_can_post"`
+	// Minimal interval in seconds between messages of a participant, 0 disables slow mode
+	SlowModeInterval int  `json:"slow_mode_interval"`
+	MembersCanInvite bool `json:"members_can_invite"`
+}
+
+func NewGroupSettings(settings entity.GroupSettings) GroupSettings {
+	return GroupSettings{
+		OnlyAdminsCanPost: settings.OnlyAdminsCanPost,
+		SlowModeInterval:  int(settings.SlowModeInterval / time.Second),
+		MembersCanInvite:  settings.MembersCanInvite,
+	}
+}
+
+type GroupSettingsUpdate struct {
+	OnlyAdminsCanPost bool `json:"only_admins_can_post"`
+	// Minimal interval in seconds between messages of a participant, 0 disables slow mode
+	SlowModeInterval int  `json:"slow_mode_interval" validate:"min=0,max=86400"`
+	MembersCanInvite bool `json:"members_can_invite"`
+}
+
+func (s GroupSettingsUpdate) DTO() dto.GroupSettingsUpdate {
+	return dto.GroupSettingsUpdate{
+		OnlyAdminsCanPost: s.OnlyAdminsCanPost,
+		SlowModeInterval:  time.Duration(s.SlowModeInterval) * time.Second,
+		MembersCanInvite:  s.MembersCanInvite,
+	}
+}
+
 //go:generate mockery --inpackage --testonly --case underscore --name GroupService
 type GroupService interface {
 	List(ctx context.Context) ([]entity.Group, error)
@@ -88,6 +126,9 @@ type GroupService interface {
 	GetByID(ctx context.Context, id int) (entity.Group, error)
 	Update(ctx context.Context, obj dto.GroupUpdate) (entity.Group, error)
 	Delete(ctx context.Context, id int) error
+	Restore(ctx context.Context, id int) (entity.Group, error)
+	GetSettings(ctx context.Context, groupID int) (entity.GroupSettings, error)
+	UpdateSettings(ctx context.Context, obj dto.GroupSettingsUpdate) (entity.GroupSettings, error)
 }
 
 type GroupControllerConfig struct {
@@ -116,6 +157,9 @@ func (gc *GroupController) Register(mux *httprouter.Router) {
 	mux.Handler(http.MethodGet, groupDetailPath, gc.authorize(http.HandlerFunc(gc.detail)))
 	mux.Handler(http.MethodPut, groupDetailPath, gc.authorize(http.HandlerFunc(gc.update)))
 	mux.Handler(http.MethodDelete, groupDetailPath, gc.authorize(http.HandlerFunc(gc.delete)))
+	mux.Handler(http.MethodPost, groupRestorePath, gc.authorize(http.HandlerFunc(gc.restore)))
+	mux.Handler(http.MethodGet, groupSettingsPath, gc.authorize(http.HandlerFunc(gc.settings)))
+	mux.Handler(http.MethodPut, groupSettingsPath, gc.authorize(http.HandlerFunc(gc.updateSettings)))
 }
 
 // list lists all groups
@@ -176,7 +220,13 @@ func (gc *GroupController) create(w http.ResponseWriter, req *http.Request) {
 
 	group, err := gc.service.Create(ctx, bodyObj.DTO())
 	if err != nil {
-		httputil.RespondError(ctx, w, err)
+		switch {
+		case errors.Is(err, entity.ErrSuchGroupUnameAlreadyExists):
+			httputil.RespondError(ctx, w, errSuchGroupUnameAlreadyExists.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
 		return
 	}
 
@@ -272,6 +322,8 @@ func (gc *GroupController) update(w http.ResponseWriter, req *http.Request) {
 		switch {
 		case errors.Is(err, entity.ErrGroupNotFound):
 			httputil.RespondError(ctx, w, errGroupNotFound.Wrap(err))
+		case errors.Is(err, entity.ErrSuchGroupUnameAlreadyExists):
+			httputil.RespondError(ctx, w, errSuchGroupUnameAlreadyExists.Wrap(err))
 		default:
 			httputil.RespondError(ctx, w, err)
 		}
@@ -284,18 +336,97 @@ func (gc *GroupController) update(w http.ResponseWriter, req *http.Request) {
 
 // delete deletes a specified group
 //
-//	@Summary	Delete a specified group
+//	@Summary		Delete a specified group
+//	@Description	The owner can restore the deleted group until the retention period is over.
+//	@Tags			groups
+//	@Accept			json
+//	@Produce		json
+//	@Param			group_id	path	int	true	"Group identity"
+//	@Success		204			"No Content"
+//	@Failure		400			{object}	httputil.Error
+//	@Failure		404			{object}	httputil.Error
+//	@Failure		500			{object}	httputil.Error
+//	@Security		JWTAuth
+//	@Router			/groups/{group_id}  [delete]
+func (gc *GroupController) delete(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var groupID int
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.Path(groupIDParam, &groupID, nil); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	if err := gc.service.Delete(ctx, groupID); err != nil {
+		switch {
+		case errors.Is(err, entity.ErrGroupNotFound):
+			httputil.RespondError(ctx, w, errGroupNotFound.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusNoContent, nil)
+}
+
+// restore restores a specified deleted group
+//
+//	@Summary		Restore a specified deleted group
+//	@Description	Only the owner can restore the group until the retention period is over.
+//	@Tags			groups
+//	@Accept			json
+//	@Produce		json
+//	@Param			group_id	path		int	true	"Group identity"
+//	@Success		200			{object}	Group
+//	@Failure		400			{object}	httputil.Error
+//	@Failure		404			{object}	httputil.Error
+//	@Failure		500			{object}	httputil.Error
+//	@Security		JWTAuth
+//	@Router			/groups/{group_id}/restore  [post]
+func (gc *GroupController) restore(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var groupID int
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.Path(groupIDParam, &groupID, nil); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	group, err := gc.service.Restore(ctx, groupID)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrGroupNotFound):
+			httputil.RespondError(ctx, w, errGroupNotFound.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusOK, NewGroup(group))
+}
+
+// settings gets settings of a specified group
+//
+//	@Summary	Get settings of a specified group
 //	@Tags		groups
 //	@Accept		json
 //	@Produce	json
-//	@Param		group_id	path	int	true	"Group identity"
-//	@Success	204			"No Content"
+//	@Param		group_id	path		int	true	"Group identity"
+//	@Success	200			{object}	GroupSettings
 //	@Failure	400			{object}	httputil.Error
 //	@Failure	404			{object}	httputil.Error
 //	@Failure	500			{object}	httputil.Error
 //	@Security	JWTAuth
-//	@Router		/groups/{group_id}  [delete]
-func (gc *GroupController) delete(w http.ResponseWriter, req *http.Request) {
+//	@Router		/groups/{group_id}/settings  [get]
+func (gc *GroupController) settings(w http.ResponseWriter, req *http.Request) {
 	ctx := req.Context()
 
 	var groupID int
@@ -306,7 +437,8 @@ func (gc *GroupController) delete(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	if err := gc.service.Delete(ctx, groupID); err != nil {
+	settings, err := gc.service.GetSettings(ctx, groupID)
+	if err != nil {
 		switch {
 		case errors.Is(err, entity.ErrGroupNotFound):
 			httputil.RespondError(ctx, w, errGroupNotFound.Wrap(err))
@@ -317,5 +449,67 @@ func (gc *GroupController) delete(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	httputil.RespondSuccess(ctx, w, http.StatusNoContent, nil)
+	httputil.RespondSuccess(ctx, w, http.StatusOK, NewGroupSettings(settings))
+}
+
+// updateSettings updates settings of a specified group
+//
+//	@Summary		Update settings of a specified group
+//	@Description	Only participants who can edit the group info can update its settings.
+//	@Description	Admins aren't restricted by the settings.
+//	@Tags			groups
+//	@Accept			json
+//	@Produce		json
+//	@Param			group_id	path		int					true	"Group identity"
+//	@Param			input		body		GroupSettingsUpdate	true	"Body to update"
+//	@Success		200			{object}	GroupSettings
+//	@Failure		400			{object}	httputil.Error
+//	@Failure		404			{object}	httputil.Error
+//	@Failure		500			{object}	httputil.Error
+//	@Security		JWTAuth
+//	@Router			/groups/{group_id}/settings  [put]
+func (gc *GroupController) updateSettings(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var (
+		groupID int
+		bodyObj GroupSettingsUpdate
+	)
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.MergeResults(
+		dec.Path(groupIDParam, &groupID, nil),
+		dec.Body(&bodyObj),
+	); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	if err := gc.validator.Struct(bodyObj); err != nil {
+		ve := validator.Error{}
+		if errors.As(err, &ve) {
+			httputil.RespondError(ctx, w, httputil.ErrValidationFailed.WithData(ve.Fields).Wrap(err))
+			return
+		}
+
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	obj := bodyObj.DTO()
+	obj.GroupID = groupID
+
+	settings, err := gc.service.UpdateSettings(ctx, obj)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrGroupNotFound):
+			httputil.RespondError(ctx, w, errGroupNotFound.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusOK, NewGroupSettings(settings))
 }
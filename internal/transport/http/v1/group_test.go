@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/Chatyx/backend/internal/dto"
 	"github.com/Chatyx/backend/internal/entity"
@@ -116,20 +117,40 @@ func TestGroupController_create(t *testing.T) {
 		},
 		{
 			name:        "Successful with all fields",
-			requestBody: `{"name":"Test1","description":"Test1 group description"}`,
+			requestBody: `{"uname":"test1group","name":"Test1","description":"Test1 group description"}`,
 			mockBehavior: func(s *MockGroupService) {
 				s.On("Create", mock.Anything, dto.GroupCreate{
+					Uname:       "test1group",
 					Name:        "Test1",
 					Description: "Test1 group description",
 				}).Return(entity.Group{
 					ID:          1,
+					Uname:       "test1group",
 					Name:        "Test1",
 					Description: "Test1 group description",
 					CreatedAt:   defaultCreatedAt,
 				}, nil)
 			},
 			expectedStatusCode:   http.StatusCreated,
-			expectedResponseBody: `{"id":1,"name":"Test1","description":"Test1 group description","created_at":"2024-01-23T00:00:00Z"}`,
+			expectedResponseBody: `{"id":1,"uname":"test1group","name":"Test1","description":"Test1 group description","created_at":"2024-01-23T00:00:00Z"}`,
+		},
+		{
+			name:        "Group with such uname already exists",
+			requestBody: `{"uname":"test1group","name":"Test1"}`,
+			mockBehavior: func(s *MockGroupService) {
+				s.On("Create", mock.Anything, dto.GroupCreate{
+					Uname: "test1group",
+					Name:  "Test1",
+				}).Return(entity.Group{}, entity.ErrSuchGroupUnameAlreadyExists)
+			},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"CH0012","message":"group with such uname already exists"}`,
+		},
+		{
+			name:                 "Validation error: uname isn't alphanumeric",
+			requestBody:          `{"uname":"test/group","name":"Test1"}`,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"CM0006","message":"validation error","data":{"uname":"failed on the 'alphanum' tag"}}`,
 		},
 		{
 			name:                 "Decode body error",
@@ -480,3 +501,141 @@ func TestGroupController_delete(t *testing.T) {
 		})
 	}
 }
+
+func TestGroupController_restore(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		mockBehavior         func(s *MockGroupService)
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Successful",
+			mockBehavior: func(s *MockGroupService) {
+				s.On("Restore", mock.Anything, 1).Return(entity.Group{
+					ID:        1,
+					Name:      "Group",
+					CreatedAt: defaultCreatedAt,
+				}, nil)
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"id":1,"name":"Group","created_at":"2024-01-23T00:00:00Z"}`,
+		},
+		{
+			name: "Group is not found",
+			mockBehavior: func(s *MockGroupService) {
+				s.On("Restore", mock.Anything, 1).Return(entity.Group{}, entity.ErrGroupNotFound)
+			},
+			expectedStatusCode:   http.StatusNotFound,
+			expectedResponseBody: `{"code":"CH0001","message":"group is not found"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := NewMockGroupService(t)
+			testCase.mockBehavior(service)
+
+			cnt := NewGroupController(GroupControllerConfig{
+				Service:   service,
+				Validator: validator.NewValidator(),
+			})
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, groupRestorePath, nil)
+			ctx := context.WithValue(
+				req.Context(),
+				httprouter.ParamsKey,
+				httprouter.Params{{Key: "group_id", Value: "1"}},
+			)
+			req = req.WithContext(ctx)
+
+			cnt.restore(rec, req)
+			resp := rec.Result()
+
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedResponseBody, string(respBody))
+		})
+	}
+}
+func TestGroupController_updateSettings(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		requestBody          string
+		mockBehavior         func(s *MockGroupService)
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:        "Successful",
+			requestBody: `{"only_admins_can_post":true,"slow_mode_interval":30,"members_can_invite":true}`,
+			mockBehavior: func(s *MockGroupService) {
+				s.On("UpdateSettings", mock.Anything, dto.GroupSettingsUpdate{
+					GroupID:           1,
+					OnlyAdminsCanPost: true,
+					SlowModeInterval:  30 * time.Second,
+					MembersCanInvite:  true,
+				}).Return(entity.GroupSettings{
+					OnlyAdminsCanPost: true,
+					SlowModeInterval:  30 * time.Second,
+					MembersCanInvite:  true,
+				}, nil)
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"only_admins_can_post":true,"slow_mode_interval":30,"members_can_invite":true}`,
+		},
+		{
+			name:                 "Validation error",
+			requestBody:          `{"slow_mode_interval":-1}`,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"CM0006","message":"validation error","data":{"slow_mode_interval":"failed on the 'min' tag"}}`,
+		},
+		{
+			name:        "Group is not found",
+			requestBody: `{"only_admins_can_post":true}`,
+			mockBehavior: func(s *MockGroupService) {
+				s.On("UpdateSettings", mock.Anything, dto.GroupSettingsUpdate{
+					GroupID:           1,
+					OnlyAdminsCanPost: true,
+				}).Return(entity.GroupSettings{}, entity.ErrGroupNotFound)
+			},
+			expectedStatusCode:   http.StatusNotFound,
+			expectedResponseBody: `{"code":"CH0001","message":"group is not found"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := NewMockGroupService(t)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(service)
+			}
+
+			cnt := NewGroupController(GroupControllerConfig{
+				Service:   service,
+				Validator: validator.NewValidator(),
+			})
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPut, groupSettingsPath, strings.NewReader(testCase.requestBody))
+			ctx := context.WithValue(
+				req.Context(),
+				httprouter.ParamsKey,
+				httprouter.Params{{Key: "group_id", Value: "1"}},
+			)
+			req = req.WithContext(ctx)
+
+			cnt.updateSettings(rec, req)
+			resp := rec.Result()
+
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedResponseBody, string(respBody))
+		})
+	}
+}
@@ -0,0 +1,270 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/Chatyx/backend/internal/dto"
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/httputil"
+	"github.com/Chatyx/backend/pkg/httputil/middleware"
+	"github.com/Chatyx/backend/pkg/validator"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	groupInviteLinkListPath   = "/invite-links"
+	groupInviteLinkDetailPath = "/invite-links/:token"
+)
+
+const (
+	inviteLinkTokenParam = "token"
+)
+
+type GroupInviteLink struct {
+	Token            string     `json:"token"`
+	CreatorID        int        `json:"creator_id"`
+	MaxUses          *int       `json:"max_uses,omitempty"`
+	Uses             int        `json:"uses"`
+	RequiresApproval bool       `json:"requires_approval"`
+	ExpiresAt        *time.Time `json:"expires_at,omitempty"`
+	IsRevoked        bool       `json:"is_revoked"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
+
+func NewGroupInviteLink(link entity.GroupInviteLink) GroupInviteLink {
+	return GroupInviteLink{
+		Token:            link.Token,
+		CreatorID:        link.CreatorID,
+		MaxUses:          link.MaxUses,
+		Uses:             link.Uses,
+		RequiresApproval: link.RequiresApproval,
+		ExpiresAt:        link.ExpiresAt,
+		IsRevoked:        link.RevokedAt != nil,
+		CreatedAt:        link.CreatedAt,
+	}
+}
+
+type GroupInviteLinkList struct {
+	Total int               `json:"total"`
+	Data  []GroupInviteLink `json:"data"`
+}
+
+func NewGroupInviteLinkList(links []entity.GroupInviteLink) GroupInviteLinkList {
+	data := make([]GroupInviteLink, len(links))
+	for i, link := range links {
+		data[i] = NewGroupInviteLink(link)
+	}
+
+	return GroupInviteLinkList{
+		Total: len(links),
+		Data:  data,
+	}
+}
+
+type GroupInviteLinkCreate struct {
+	MaxUses          *int       `json:"max_uses"          validate:"omitempty,min=1"`
+	RequiresApproval bool       `json:"requires_approval"`
+	ExpiresAt        *time.Time `json:"expires_at"        validate:"omitempty,gt"`
+}
+
+func (l GroupInviteLinkCreate) DTO() dto.GroupInviteLinkCreate {
+	return dto.GroupInviteLinkCreate{
+		MaxUses:          l.MaxUses,
+		RequiresApproval: l.RequiresApproval,
+		ExpiresAt:        l.ExpiresAt,
+	}
+}
+
+//go:generate mockery --inpackage --testonly --case underscore --name GroupInviteLinkService
+type GroupInviteLinkService interface {
+	List(ctx context.Context, groupID int) ([]entity.GroupInviteLink, error)
+	Create(ctx context.Context, obj dto.GroupInviteLinkCreate) (entity.GroupInviteLink, error)
+	Revoke(ctx context.Context, groupID int, token string) error
+}
+
+type GroupInviteLinkControllerConfig struct {
+	Service   GroupInviteLinkService
+	Authorize middleware.Middleware
+	Validator validator.Validator
+}
+
+type GroupInviteLinkController struct {
+	service   GroupInviteLinkService
+	authorize middleware.Middleware
+	validator validator.Validator
+}
+
+func NewGroupInviteLinkController(conf GroupInviteLinkControllerConfig) *GroupInviteLinkController {
+	return &GroupInviteLinkController{
+		service:   conf.Service,
+		authorize: conf.Authorize,
+		validator: conf.Validator,
+	}
+}
+
+func (lc *GroupInviteLinkController) Register(mux *httprouter.Router) {
+	mux.Handler(http.MethodGet, groupDetailPath+groupInviteLinkListPath, lc.authorize(http.HandlerFunc(lc.list)))
+	mux.Handler(http.MethodPost, groupDetailPath+groupInviteLinkListPath, lc.authorize(http.HandlerFunc(lc.create)))
+	mux.Handler(http.MethodDelete, groupDetailPath+groupInviteLinkDetailPath, lc.authorize(http.HandlerFunc(lc.revoke)))
+}
+
+// list lists all invite links of a specified group
+//
+//	@Summary	List all invite links of a specified group
+//	@Tags		group-invite-links
+//	@Accept		json
+//	@Produce	json
+//	@Param		group_id	path		int	true	"Group identity"
+//	@Success	200			{object}	GroupInviteLinkList
+//	@Failure	400			{object}	httputil.Error
+//	@Failure	403			{object}	httputil.Error
+//	@Failure	404			{object}	httputil.Error
+//	@Failure	500			{object}	httputil.Error
+//	@Security	JWTAuth
+//	@Router		/groups/{group_id}/invite-links  [get]
+func (lc *GroupInviteLinkController) list(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var groupID int
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.Path(groupIDParam, &groupID, nil); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	links, err := lc.service.List(ctx, groupID)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrGroupNotFound):
+			httputil.RespondError(ctx, w, errGroupNotFound.Wrap(err))
+		case errors.Is(err, entity.ErrForbiddenPerformAction):
+			httputil.RespondError(ctx, w, httputil.ErrForbiddenPerformAction.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusOK, NewGroupInviteLinkList(links))
+}
+
+// create creates an invite link of a specified group
+//
+//	@Summary		Create an invite link of a specified group
+//	@Description	The link is unlimited unless max uses or expiration time are specified.
+//	@Description	Joining by the link requiring approval creates a pending join request.
+//	@Tags			group-invite-links
+//	@Accept			json
+//	@Produce		json
+//	@Param			group_id	path		int						true	"Group identity"
+//	@Param			input		body		GroupInviteLinkCreate	true	"Body to create"
+//	@Success		201			{object}	GroupInviteLink
+//	@Failure		400			{object}	httputil.Error
+//	@Failure		403			{object}	httputil.Error
+//	@Failure		404			{object}	httputil.Error
+//	@Failure		500			{object}	httputil.Error
+//	@Security		JWTAuth
+//	@Router			/groups/{group_id}/invite-links  [post]
+func (lc *GroupInviteLinkController) create(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var (
+		groupID int
+		bodyObj GroupInviteLinkCreate
+	)
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.MergeResults(
+		dec.Path(groupIDParam, &groupID, nil),
+		dec.Body(&bodyObj),
+	); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	if err := lc.validator.Struct(bodyObj); err != nil {
+		ve := validator.Error{}
+		if errors.As(err, &ve) {
+			httputil.RespondError(ctx, w, httputil.ErrValidationFailed.WithData(ve.Fields).Wrap(err))
+			return
+		}
+
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	obj := bodyObj.DTO()
+	obj.GroupID = groupID
+
+	link, err := lc.service.Create(ctx, obj)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrGroupNotFound):
+			httputil.RespondError(ctx, w, errGroupNotFound.Wrap(err))
+		case errors.Is(err, entity.ErrForbiddenPerformAction):
+			httputil.RespondError(ctx, w, httputil.ErrForbiddenPerformAction.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusCreated, NewGroupInviteLink(link))
+}
+
+// revoke revokes an invite link of a specified group
+//
+//	@Summary	Revoke an invite link of a specified group
+//	@Tags		group-invite-links
+//	@Accept		json
+//	@Produce	json
+//	@Param		group_id	path	int		true	"Group identity"
+//	@Param		token		path	string	true	"Invite link token"
+//	@Success	204			"No Content"
+//	@Failure	400			{object}	httputil.Error
+//	@Failure	403			{object}	httputil.Error
+//	@Failure	404			{object}	httputil.Error
+//	@Failure	500			{object}	httputil.Error
+//	@Security	JWTAuth
+//	@Router		/groups/{group_id}/invite-links/{token}  [delete]
+func (lc *GroupInviteLinkController) revoke(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var (
+		groupID int
+		token   string
+	)
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.MergeResults(
+		dec.Path(groupIDParam, &groupID, nil),
+		dec.Path(inviteLinkTokenParam, &token, nil),
+	); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	if err := lc.service.Revoke(ctx, groupID, token); err != nil {
+		switch {
+		case errors.Is(err, entity.ErrGroupNotFound):
+			httputil.RespondError(ctx, w, errGroupNotFound.Wrap(err))
+		case errors.Is(err, entity.ErrGroupInviteLinkNotFound):
+			httputil.RespondError(ctx, w, errGroupInviteLinkNotFound.Wrap(err))
+		case errors.Is(err, entity.ErrForbiddenPerformAction):
+			httputil.RespondError(ctx, w, httputil.ErrForbiddenPerformAction.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusNoContent, nil)
+}
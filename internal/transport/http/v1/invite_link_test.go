@@ -0,0 +1,161 @@
+package v1
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Chatyx/backend/internal/dto"
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/validator"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGroupInviteLinkController_create(t *testing.T) {
+	createdAt := time.Date(2024, 3, 31, 12, 0, 0, 0, time.UTC)
+	maxUses := 5
+
+	testCases := []struct {
+		name                 string
+		requestBody          string
+		mockBehavior         func(s *MockGroupInviteLinkService)
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:        "Successful",
+			requestBody: `{"max_uses":5}`,
+			mockBehavior: func(s *MockGroupInviteLinkService) {
+				s.On("Create", mock.Anything, dto.GroupInviteLinkCreate{
+					GroupID: 1,
+					MaxUses: &maxUses,
+				}).Return(entity.GroupInviteLink{
+					Token:     "4e1a1b6e0c2f4fd2b2e1c1b0a7d3e9f1",
+					GroupID:   1,
+					CreatorID: 1,
+					MaxUses:   &maxUses,
+					CreatedAt: createdAt,
+				}, nil)
+			},
+			expectedStatusCode:   http.StatusCreated,
+			expectedResponseBody: `{"token":"4e1a1b6e0c2f4fd2b2e1c1b0a7d3e9f1","creator_id":1,"max_uses":5,"uses":0,"requires_approval":false,"is_revoked":false,"created_at":"2024-03-31T12:00:00Z"}`,
+		},
+		{
+			name:                 "Validation error",
+			requestBody:          `{"max_uses":0,"expires_at":"2020-01-01T00:00:00Z"}`,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"CM0006","message":"validation error","data":{"expires_at":"failed on the 'gt' tag","max_uses":"failed on the 'min' tag"}}`,
+		},
+		{
+			name:        "Create without permission",
+			requestBody: `{}`,
+			mockBehavior: func(s *MockGroupInviteLinkService) {
+				s.On("Create", mock.Anything, dto.GroupInviteLinkCreate{GroupID: 1}).
+					Return(entity.GroupInviteLink{}, entity.ErrForbiddenPerformAction)
+			},
+			expectedStatusCode:   http.StatusForbidden,
+			expectedResponseBody: `{"code":"CM0008","message":"it's forbidden to perform this action"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := NewMockGroupInviteLinkService(t)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(service)
+			}
+
+			cnt := NewGroupInviteLinkController(GroupInviteLinkControllerConfig{
+				Service:   service,
+				Validator: validator.NewValidator(),
+			})
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, groupDetailPath+groupInviteLinkListPath, strings.NewReader(testCase.requestBody))
+			ctx := context.WithValue(
+				req.Context(),
+				httprouter.ParamsKey,
+				httprouter.Params{
+					{Key: "group_id", Value: "1"},
+				},
+			)
+			req = req.WithContext(ctx)
+
+			cnt.create(rec, req)
+			resp := rec.Result()
+
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedResponseBody, string(respBody))
+		})
+	}
+}
+
+func TestGroupInviteLinkController_revoke(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		mockBehavior         func(s *MockGroupInviteLinkService)
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Successful",
+			mockBehavior: func(s *MockGroupInviteLinkService) {
+				s.On("Revoke", mock.Anything, 1, "token").Return(nil)
+			},
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name: "Invite link is not found",
+			mockBehavior: func(s *MockGroupInviteLinkService) {
+				s.On("Revoke", mock.Anything, 1, "token").Return(entity.ErrGroupInviteLinkNotFound)
+			},
+			expectedStatusCode:   http.StatusNotFound,
+			expectedResponseBody: `{"code":"CH0013","message":"group invite link is not found"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := NewMockGroupInviteLinkService(t)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(service)
+			}
+
+			cnt := NewGroupInviteLinkController(GroupInviteLinkControllerConfig{
+				Service:   service,
+				Validator: validator.NewValidator(),
+			})
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodDelete, groupDetailPath+groupInviteLinkDetailPath, http.NoBody)
+			ctx := context.WithValue(
+				req.Context(),
+				httprouter.ParamsKey,
+				httprouter.Params{
+					{Key: "group_id", Value: "1"},
+					{Key: "token", Value: "token"},
+				},
+			)
+			req = req.WithContext(ctx)
+
+			cnt.revoke(rec, req)
+			resp := rec.Result()
+
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedResponseBody, string(respBody))
+		})
+	}
+}
@@ -16,7 +16,8 @@ import (
 )
 
 const (
-	messageListPath = "/api/v1/messages"
+	messageListPath    = "/api/v1/messages"
+	messageForwardPath = "/api/v1/messages/forward"
 )
 
 const (
@@ -46,25 +47,42 @@ func NewMessageList(messages []entity.Message) MessageList {
 	}
 }
 
+type ServiceAction struct {
+	Type   entity.ServiceActionType `json:"type"`
+	Params map[string]string        `json:"params,omitempty"`
+}
+
 type Message struct {
-	ID          int                `json:"id"`
-	SenderID    int                `json:"sender_id"`
-	Content     string             `json:"content"`
-	ContentType entity.ContentType `json:"content_type"`
-	IsService   bool               `json:"is_service"`
-	SentAt      time.Time          `json:"sent_at"`
-	DeliveredAt *time.Time         `json:"delivered_at,omitempty"`
+	ID              int                `json:"id"`
+	SenderID        int                `json:"sender_id"`
+	Content         string             `json:"content"`
+	ContentType     entity.ContentType `json:"content_type"`
+	IsService       bool               `json:"is_service"`
+	ServiceAction   *ServiceAction     `json:"service_action,omitempty"`
+	ForwardedFromID *int               `json:"forwarded_from_id,omitempty"`
+	SentAt          time.Time          `json:"sent_at"`
+	DeliveredAt     *time.Time         `json:"delivered_at,omitempty"`
 }
 
 func NewMessage(message entity.Message) Message {
+	var action *ServiceAction
+	if message.ServiceAction != nil {
+		action = &ServiceAction{
+			Type:   message.ServiceAction.Type,
+			Params: message.ServiceAction.Params,
+		}
+	}
+
 	return Message{
-		ID:          message.ID,
-		SenderID:    message.SenderID,
-		Content:     message.Content,
-		ContentType: message.ContentType,
-		IsService:   message.IsService,
-		SentAt:      message.SentAt,
-		DeliveredAt: message.DeliveredAt,
+		ID:              message.ID,
+		SenderID:        message.SenderID,
+		Content:         message.Content,
+		ContentType:     message.ContentType,
+		IsService:       message.IsService,
+		ServiceAction:   action,
+		ForwardedFromID: message.ForwardedFromID,
+		SentAt:          message.SentAt,
+		DeliveredAt:     message.DeliveredAt,
 	}
 }
 
@@ -80,10 +98,27 @@ func (mc MessageCreate) DTO() dto.MessageCreate {
 	}
 }
 
+type MessageForward struct {
+	FromChatID   int    `json:"from_chat_id"   validate:"required"`
+	FromChatType string `json:"from_chat_type" validate:"required,oneof=dialog group channel"`
+	MessageID    int    `json:"message_id"     validate:"required"`
+}
+
+func (mf MessageForward) DTO() dto.MessageForward {
+	return dto.MessageForward{
+		FromChatID: entity.ChatID{
+			ID:   mf.FromChatID,
+			Type: entity.ChatType(mf.FromChatType),
+		},
+		MessageID: mf.MessageID,
+	}
+}
+
 //go:generate mockery --inpackage --testonly --case underscore --name MessageService
 type MessageService interface {
 	List(ctx context.Context, obj dto.MessageList) ([]entity.Message, error)
 	Create(ctx context.Context, obj dto.MessageCreate) (entity.Message, error)
+	Forward(ctx context.Context, obj dto.MessageForward) (entity.Message, error)
 }
 
 type MessageControllerConfig struct {
@@ -109,6 +144,7 @@ func NewMessageController(conf MessageControllerConfig) *MessageController {
 func (mc *MessageController) Register(mux *httprouter.Router) {
 	mux.Handler(http.MethodGet, messageListPath, mc.authorize(http.HandlerFunc(mc.list)))
 	mux.Handler(http.MethodPost, messageListPath, mc.authorize(http.HandlerFunc(mc.create)))
+	mux.Handler(http.MethodPost, messageForwardPath, mc.authorize(http.HandlerFunc(mc.forward)))
 }
 
 // list lists messages for a specified chat
@@ -117,8 +153,8 @@ func (mc *MessageController) Register(mux *httprouter.Router) {
 //	@Tags		messages
 //	@Accept		json
 //	@Produce	json
-//	@Param		chat_id		query		int		true	"Chat id for dialog or group"
-//	@Param		chat_type	query		string	true	"Chat type (dialog or group)"
+//	@Param		chat_id		query		int		true	"Chat id for dialog, group or channel"
+//	@Param		chat_type	query		string	true	"Chat type (dialog, group or channel)"
 //	@Param		id_after	query		int		false	"Message id that excludes already-retrieved messages"
 //	@Param		limit		query		int		false	"Number of items to list per page (default: 20, max: 100)"
 //	@Param		sort		query		string	true	"Sort order (asc or desc)"
@@ -153,7 +189,7 @@ func (mc *MessageController) list(w http.ResponseWriter, req *http.Request) {
 
 	if err := validator.MergeResults(
 		mc.validator.Var(chatID, chatIDParam, "required"),
-		mc.validator.Var(chatType, chatTypeParam, "required,oneof=dialog group"),
+		mc.validator.Var(chatType, chatTypeParam, "required,oneof=dialog group channel"),
 		mc.validator.Var(sort, sortParam, "required,oneof=asc desc"),
 		mc.validator.Var(limit, limitParam, "gt=0,max=100"),
 	); err != nil {
@@ -184,6 +220,8 @@ func (mc *MessageController) list(w http.ResponseWriter, req *http.Request) {
 			httputil.RespondError(ctx, w, errGroupNotFound.Wrap(err))
 		case errors.Is(err, entity.ErrDialogNotFound):
 			httputil.RespondError(ctx, w, errDialogNotFound.Wrap(err))
+		case errors.Is(err, entity.ErrChannelNotFound):
+			httputil.RespondError(ctx, w, errChannelNotFound.Wrap(err))
 		default:
 			httputil.RespondError(ctx, w, err)
 		}
@@ -200,12 +238,14 @@ func (mc *MessageController) list(w http.ResponseWriter, req *http.Request) {
 //	@Tags		messages
 //	@Accept		json
 //	@Produce	json
-//	@Param		chat_id		query		int				true	"Chat id for dialog or group"
-//	@Param		chat_type	query		string			true	"Chat type (dialog or group)"
+//	@Param		chat_id		query		int				true	"Chat id for dialog, group or channel"
+//	@Param		chat_type	query		string			true	"Chat type (dialog, group or channel)"
 //	@Param		input		body		MessageCreate	true	"Body to create"
 //	@Success	201			{object}	Message
 //	@Failure	400			{object}	httputil.Error
+//	@Failure	403			{object}	httputil.Error
 //	@Failure	404			{object}	httputil.Error
+//	@Failure	429			{object}	httputil.Error
 //	@Failure	500			{object}	httputil.Error
 //	@Security	JWTAuth
 //	@Router		/messages  [post]
@@ -230,7 +270,7 @@ func (mc *MessageController) create(w http.ResponseWriter, req *http.Request) {
 
 	if err := validator.MergeResults(
 		mc.validator.Var(chatID, chatIDParam, "required"),
-		mc.validator.Var(chatType, chatTypeParam, "required,oneof=dialog group"),
+		mc.validator.Var(chatType, chatTypeParam, "required,oneof=dialog group channel"),
 		mc.validator.Struct(bodyObj),
 	); err != nil {
 		ve := validator.Error{}
@@ -256,6 +296,106 @@ func (mc *MessageController) create(w http.ResponseWriter, req *http.Request) {
 			httputil.RespondError(ctx, w, errGroupNotFound.Wrap(err))
 		case errors.Is(err, entity.ErrDialogNotFound):
 			httputil.RespondError(ctx, w, errDialogNotFound.Wrap(err))
+		case errors.Is(err, entity.ErrChannelNotFound):
+			httputil.RespondError(ctx, w, errChannelNotFound.Wrap(err))
+		case errors.Is(err, entity.ErrRestrictedGroupParticipant):
+			httputil.RespondError(ctx, w, errRestrictedGroupParticipant.Wrap(err))
+		case errors.Is(err, entity.ErrGroupSlowModeActive):
+			httputil.RespondError(ctx, w, errGroupSlowModeActive.Wrap(err))
+		case errors.Is(err, entity.ErrUserBlocked):
+			httputil.RespondError(ctx, w, errUserBlocked.Wrap(err))
+		case errors.Is(err, entity.ErrEmailNotVerified):
+			httputil.RespondError(ctx, w, errEmailNotVerified.Wrap(err))
+		case errors.Is(err, entity.ErrForbiddenPerformAction):
+			httputil.RespondError(ctx, w, httputil.ErrForbiddenPerformAction.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusCreated, NewMessage(message))
+}
+
+// forward forwards message of another chat to the specified chat
+//
+//	@Summary	Forward message of another chat to the specified chat
+//	@Tags		messages
+//	@Accept		json
+//	@Produce	json
+//	@Param		chat_id		query		int				true	"Chat id for dialog, group or channel"
+//	@Param		chat_type	query		string			true	"Chat type (dialog, group or channel)"
+//	@Param		input		body		MessageForward	true	"Message to forward"
+//	@Success	201			{object}	Message
+//	@Failure	400			{object}	httputil.Error
+//	@Failure	403			{object}	httputil.Error
+//	@Failure	404			{object}	httputil.Error
+//	@Failure	429			{object}	httputil.Error
+//	@Failure	500			{object}	httputil.Error
+//	@Security	JWTAuth
+//	@Router		/messages/forward  [post]
+func (mc *MessageController) forward(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var (
+		chatID   int
+		chatType string
+		bodyObj  MessageForward
+	)
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.MergeResults(
+		dec.Query(chatIDParam, &chatID, nil),
+		dec.Query(chatTypeParam, &chatType, nil),
+		dec.Body(&bodyObj),
+	); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	if err := validator.MergeResults(
+		mc.validator.Var(chatID, chatIDParam, "required"),
+		mc.validator.Var(chatType, chatTypeParam, "required,oneof=dialog group channel"),
+		mc.validator.Struct(bodyObj),
+	); err != nil {
+		ve := validator.Error{}
+		if errors.As(err, &ve) {
+			httputil.RespondError(ctx, w, httputil.ErrValidationFailed.WithData(ve.Fields).Wrap(err))
+			return
+		}
+
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	obj := bodyObj.DTO()
+	obj.ChatID = entity.ChatID{
+		ID:   chatID,
+		Type: entity.ChatType(chatType),
+	}
+
+	message, err := mc.service.Forward(ctx, obj)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrMessageNotFound):
+			httputil.RespondError(ctx, w, errMessageNotFound.Wrap(err))
+		case errors.Is(err, entity.ErrGroupNotFound):
+			httputil.RespondError(ctx, w, errGroupNotFound.Wrap(err))
+		case errors.Is(err, entity.ErrDialogNotFound):
+			httputil.RespondError(ctx, w, errDialogNotFound.Wrap(err))
+		case errors.Is(err, entity.ErrChannelNotFound):
+			httputil.RespondError(ctx, w, errChannelNotFound.Wrap(err))
+		case errors.Is(err, entity.ErrRestrictedGroupParticipant):
+			httputil.RespondError(ctx, w, errRestrictedGroupParticipant.Wrap(err))
+		case errors.Is(err, entity.ErrGroupSlowModeActive):
+			httputil.RespondError(ctx, w, errGroupSlowModeActive.Wrap(err))
+		case errors.Is(err, entity.ErrUserBlocked):
+			httputil.RespondError(ctx, w, errUserBlocked.Wrap(err))
+		case errors.Is(err, entity.ErrEmailNotVerified):
+			httputil.RespondError(ctx, w, errEmailNotVerified.Wrap(err))
+		case errors.Is(err, entity.ErrForbiddenPerformAction):
+			httputil.RespondError(ctx, w, httputil.ErrForbiddenPerformAction.Wrap(err))
 		default:
 			httputil.RespondError(ctx, w, err)
 		}
@@ -54,15 +54,19 @@ func TestMessageController_list(t *testing.T) {
 						ID:          3,
 						ChatID:      dtoObj.ChatID,
 						SenderID:    1,
-						Content:     "world",
+						Content:     "User 1 blocked user 2",
 						ContentType: entity.TextContentType,
-						IsService:   false,
-						SentAt:      defaultCreatedAt,
+						IsService:   true,
+						ServiceAction: &entity.ServiceAction{
+							Type:   entity.DialogBlockedServiceAction,
+							Params: map[string]string{entity.UserIDServiceParam: "2"},
+						},
+						SentAt: defaultCreatedAt,
 					},
 				}, nil)
 			},
 			expectedStatusCode:   http.StatusOK,
-			expectedResponseBody: `{"total":2,"data":[{"id":2,"sender_id":1,"content":"hello","content_type":"text","is_service":false,"sent_at":"2024-01-23T00:00:00Z"},{"id":3,"sender_id":1,"content":"world","content_type":"text","is_service":false,"sent_at":"2024-01-23T00:00:00Z"}]}`,
+			expectedResponseBody: `{"total":2,"data":[{"id":2,"sender_id":1,"content":"hello","content_type":"text","is_service":false,"sent_at":"2024-01-23T00:00:00Z"},{"id":3,"sender_id":1,"content":"User 1 blocked user 2","content_type":"text","is_service":true,"service_action":{"type":"dialog_blocked","params":{"user_id":"2"}},"sent_at":"2024-01-23T00:00:00Z"}]}`,
 		},
 		{
 			name: "Successful with empty list",
@@ -123,10 +127,10 @@ func TestMessageController_list(t *testing.T) {
 			expectedResponseBody: `{"code":"CM0006","message":"validation error","data":{"chat_id":"failed on the 'required' tag","chat_type":"failed on the 'required' tag","sort":"failed on the 'required' tag"}}`,
 		},
 		{
-			name: "Validation error: chat_type is dialog or group",
+			name: "Validation error: chat_type is dialog, group or channel",
 			queryBehavior: func(query url.Values) {
 				query.Add(chatIDParam, "1")
-				query.Add(chatTypeParam, "channel")
+				query.Add(chatTypeParam, "secret")
 				query.Add(sortParam, "asc")
 			},
 			expectedStatusCode:   http.StatusBadRequest,
@@ -307,11 +311,11 @@ func TestMessageController_create(t *testing.T) {
 			expectedResponseBody: `{"code":"CM0006","message":"validation error","data":{"chat_id":"failed on the 'required' tag","chat_type":"failed on the 'required' tag"}}`,
 		},
 		{
-			name:        "Validation error: chat_type is dialog or group",
+			name:        "Validation error: chat_type is dialog, group or channel",
 			requestBody: `{"content":"hello","content_type":"text"}`,
 			queryBehavior: func(query url.Values) {
 				query.Add(chatIDParam, "1")
-				query.Add(chatTypeParam, "channel")
+				query.Add(chatTypeParam, "secret")
 			},
 			expectedStatusCode:   http.StatusBadRequest,
 			expectedResponseBody: `{"code":"CM0006","message":"validation error","data":{"chat_type":"failed on the 'oneof' tag"}}`,
@@ -333,6 +337,74 @@ func TestMessageController_create(t *testing.T) {
 			expectedStatusCode:   http.StatusNotFound,
 			expectedResponseBody: `{"code":"CH0001","message":"group is not found"}`,
 		},
+		{
+			name:        "Group participant is muted",
+			requestBody: `{"content":"hello","content_type":"text"}`,
+			queryBehavior: func(query url.Values) {
+				query.Add(chatIDParam, "2")
+				query.Add(chatTypeParam, "group")
+			},
+			mockBehavior: func(s *MockMessageService) {
+				s.On("Create", mock.Anything, dto.MessageCreate{
+					ChatID:      entity.ChatID{ID: 2, Type: entity.GroupChatType},
+					Content:     "hello",
+					ContentType: entity.TextContentType,
+				}).Return(entity.Message{}, entity.ErrRestrictedGroupParticipant)
+			},
+			expectedStatusCode:   http.StatusForbidden,
+			expectedResponseBody: `{"code":"CH0015","message":"group participant is restricted to send messages"}`,
+		},
+		{
+			name:        "Channel subscriber isn't an admin",
+			requestBody: `{"content":"hello","content_type":"text"}`,
+			queryBehavior: func(query url.Values) {
+				query.Add(chatIDParam, "3")
+				query.Add(chatTypeParam, "channel")
+			},
+			mockBehavior: func(s *MockMessageService) {
+				s.On("Create", mock.Anything, dto.MessageCreate{
+					ChatID:      entity.ChatID{ID: 3, Type: entity.ChannelChatType},
+					Content:     "hello",
+					ContentType: entity.TextContentType,
+				}).Return(entity.Message{}, entity.ErrForbiddenPerformAction)
+			},
+			expectedStatusCode:   http.StatusForbidden,
+			expectedResponseBody: `{"code":"CM0008","message":"it's forbidden to perform this action"}`,
+		},
+		{
+			name:        "Slow mode is enabled in the group",
+			requestBody: `{"content":"hello","content_type":"text"}`,
+			queryBehavior: func(query url.Values) {
+				query.Add(chatIDParam, "1")
+				query.Add(chatTypeParam, "group")
+			},
+			mockBehavior: func(s *MockMessageService) {
+				s.On("Create", mock.Anything, dto.MessageCreate{
+					ChatID:      entity.ChatID{ID: 1, Type: entity.GroupChatType},
+					Content:     "hello",
+					ContentType: entity.TextContentType,
+				}).Return(entity.Message{}, entity.ErrGroupSlowModeActive)
+			},
+			expectedStatusCode:   http.StatusTooManyRequests,
+			expectedResponseBody: `{"code":"CH0022","message":"slow mode is enabled in the group, wait before posting"}`,
+		},
+		{
+			name:        "Channel is not found",
+			requestBody: `{"content":"hello","content_type":"text"}`,
+			queryBehavior: func(query url.Values) {
+				query.Add(chatIDParam, "3")
+				query.Add(chatTypeParam, "channel")
+			},
+			mockBehavior: func(s *MockMessageService) {
+				s.On("Create", mock.Anything, dto.MessageCreate{
+					ChatID:      entity.ChatID{ID: 3, Type: entity.ChannelChatType},
+					Content:     "hello",
+					ContentType: entity.TextContentType,
+				}).Return(entity.Message{}, entity.ErrChannelNotFound)
+			},
+			expectedStatusCode:   http.StatusNotFound,
+			expectedResponseBody: `{"code":"CH0017","message":"channel is not found"}`,
+		},
 		{
 			name:        "Dialog is not found",
 			requestBody: `{"content":"hello","content_type":"text"}`,
@@ -401,3 +473,118 @@ func TestMessageController_create(t *testing.T) {
 		})
 	}
 }
+
+func TestMessageController_forward(t *testing.T) {
+	forwardedFromID := 2
+
+	testCases := []struct {
+		name                 string
+		requestBody          string
+		queryBehavior        func(q url.Values)
+		mockBehavior         func(s *MockMessageService)
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:        "Successful",
+			requestBody: `{"from_chat_id":2,"from_chat_type":"group","message_id":10}`,
+			queryBehavior: func(query url.Values) {
+				query.Add(chatIDParam, "1")
+				query.Add(chatTypeParam, "dialog")
+			},
+			mockBehavior: func(s *MockMessageService) {
+				s.On("Forward", mock.Anything, dto.MessageForward{
+					ChatID:     entity.ChatID{ID: 1, Type: entity.DialogChatType},
+					FromChatID: entity.ChatID{ID: 2, Type: entity.GroupChatType},
+					MessageID:  10,
+				}).Return(entity.Message{
+					ID:              11,
+					ChatID:          entity.ChatID{ID: 1, Type: entity.DialogChatType},
+					SenderID:        1,
+					Content:         "hello",
+					ContentType:     entity.TextContentType,
+					ForwardedFromID: &forwardedFromID,
+					SentAt:          defaultCreatedAt,
+				}, nil)
+			},
+			expectedStatusCode:   http.StatusCreated,
+			expectedResponseBody: `{"id":11,"sender_id":1,"content":"hello","content_type":"text","is_service":false,"forwarded_from_id":2,"sent_at":"2024-01-23T00:00:00Z"}`,
+		},
+		{
+			name:        "Validation error: from_chat_id, from_chat_type, message_id are required",
+			requestBody: `{}`,
+			queryBehavior: func(query url.Values) {
+				query.Add(chatIDParam, "1")
+				query.Add(chatTypeParam, "dialog")
+			},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"CM0006","message":"validation error","data":{"from_chat_id":"failed on the 'required' tag","from_chat_type":"failed on the 'required' tag","message_id":"failed on the 'required' tag"}}`,
+		},
+		{
+			name:        "Message is not found",
+			requestBody: `{"from_chat_id":2,"from_chat_type":"group","message_id":10}`,
+			queryBehavior: func(query url.Values) {
+				query.Add(chatIDParam, "1")
+				query.Add(chatTypeParam, "dialog")
+			},
+			mockBehavior: func(s *MockMessageService) {
+				s.On("Forward", mock.Anything, dto.MessageForward{
+					ChatID:     entity.ChatID{ID: 1, Type: entity.DialogChatType},
+					FromChatID: entity.ChatID{ID: 2, Type: entity.GroupChatType},
+					MessageID:  10,
+				}).Return(entity.Message{}, entity.ErrMessageNotFound)
+			},
+			expectedStatusCode:   http.StatusNotFound,
+			expectedResponseBody: `{"code":"CH0027","message":"message is not found"}`,
+		},
+		{
+			name:        "Service message",
+			requestBody: `{"from_chat_id":2,"from_chat_type":"group","message_id":10}`,
+			queryBehavior: func(query url.Values) {
+				query.Add(chatIDParam, "1")
+				query.Add(chatTypeParam, "dialog")
+			},
+			mockBehavior: func(s *MockMessageService) {
+				s.On("Forward", mock.Anything, dto.MessageForward{
+					ChatID:     entity.ChatID{ID: 1, Type: entity.DialogChatType},
+					FromChatID: entity.ChatID{ID: 2, Type: entity.GroupChatType},
+					MessageID:  10,
+				}).Return(entity.Message{}, entity.ErrForbiddenPerformAction)
+			},
+			expectedStatusCode:   http.StatusForbidden,
+			expectedResponseBody: `{"code":"CM0008","message":"it's forbidden to perform this action"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := NewMockMessageService(t)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(service)
+			}
+
+			cnt := NewMessageController(MessageControllerConfig{
+				Service:   service,
+				Validator: validator.NewValidator(),
+			})
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, messageForwardPath, strings.NewReader(testCase.requestBody))
+
+			query := req.URL.Query()
+			if testCase.queryBehavior != nil {
+				testCase.queryBehavior(query)
+			}
+			req.URL.RawQuery = query.Encode()
+
+			cnt.forward(rec, req)
+			resp := rec.Result()
+
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedResponseBody, string(respBody))
+		})
+	}
+}
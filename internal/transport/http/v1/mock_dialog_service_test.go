@@ -16,6 +16,42 @@ type MockDialogService struct {
 	mock.Mock
 }
 
+// AcceptRequest provides a mock function with given fields: ctx, dialogID
+func (_m *MockDialogService) AcceptRequest(ctx context.Context, dialogID int) error {
+	ret := _m.Called(ctx, dialogID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcceptRequest")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, dialogID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClearHistory provides a mock function with given fields: ctx, dialogID
+func (_m *MockDialogService) ClearHistory(ctx context.Context, dialogID int) error {
+	ret := _m.Called(ctx, dialogID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClearHistory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, dialogID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Create provides a mock function with given fields: ctx, obj
 func (_m *MockDialogService) Create(ctx context.Context, obj dto.DialogCreate) (entity.Dialog, error) {
 	ret := _m.Called(ctx, obj)
@@ -44,6 +80,42 @@ func (_m *MockDialogService) Create(ctx context.Context, obj dto.DialogCreate) (
 	return r0, r1
 }
 
+// DeclineRequest provides a mock function with given fields: ctx, dialogID
+func (_m *MockDialogService) DeclineRequest(ctx context.Context, dialogID int) error {
+	ret := _m.Called(ctx, dialogID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeclineRequest")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, dialogID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: ctx, obj
+func (_m *MockDialogService) Delete(ctx context.Context, obj dto.DialogDelete) error {
+	ret := _m.Called(ctx, obj)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.DialogDelete) error); ok {
+		r0 = rf(ctx, obj)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetByID provides a mock function with given fields: ctx, id
 func (_m *MockDialogService) GetByID(ctx context.Context, id int) (entity.Dialog, error) {
 	ret := _m.Called(ctx, id)
@@ -72,6 +144,34 @@ func (_m *MockDialogService) GetByID(ctx context.Context, id int) (entity.Dialog
 	return r0, r1
 }
 
+// GetSaved provides a mock function with given fields: ctx
+func (_m *MockDialogService) GetSaved(ctx context.Context) (entity.Dialog, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSaved")
+	}
+
+	var r0 entity.Dialog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (entity.Dialog, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) entity.Dialog); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(entity.Dialog)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // List provides a mock function with given fields: ctx
 func (_m *MockDialogService) List(ctx context.Context) ([]entity.Dialog, error) {
 	ret := _m.Called(ctx)
@@ -102,6 +202,54 @@ func (_m *MockDialogService) List(ctx context.Context) ([]entity.Dialog, error)
 	return r0, r1
 }
 
+// ListRequests provides a mock function with given fields: ctx
+func (_m *MockDialogService) ListRequests(ctx context.Context) ([]entity.Dialog, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRequests")
+	}
+
+	var r0 []entity.Dialog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]entity.Dialog, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.Dialog); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Dialog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReportRequest provides a mock function with given fields: ctx, dialogID
+func (_m *MockDialogService) ReportRequest(ctx context.Context, dialogID int) error {
+	ret := _m.Called(ctx, dialogID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReportRequest")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, dialogID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: ctx, obj
 func (_m *MockDialogService) Update(ctx context.Context, obj dto.DialogUpdate) error {
 	ret := _m.Called(ctx, obj)
@@ -5,7 +5,9 @@ package v1
 import (
 	context "context"
 
+	dto "github.com/Chatyx/backend/internal/dto"
 	entity "github.com/Chatyx/backend/internal/entity"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -14,6 +16,42 @@ type MockGroupParticipantService struct {
 	mock.Mock
 }
 
+// ApproveJoinRequest provides a mock function with given fields: ctx, groupID, userID
+func (_m *MockGroupParticipantService) ApproveJoinRequest(ctx context.Context, groupID int, userID int) error {
+	ret := _m.Called(ctx, groupID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveJoinRequest")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = rf(ctx, groupID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Demote provides a mock function with given fields: ctx, groupID, userID, role
+func (_m *MockGroupParticipantService) Demote(ctx context.Context, groupID int, userID int, role entity.GroupRole) error {
+	ret := _m.Called(ctx, groupID, userID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Demote")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, entity.GroupRole) error); ok {
+		r0 = rf(ctx, groupID, userID, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Get provides a mock function with given fields: ctx, groupID, userID
 func (_m *MockGroupParticipantService) Get(ctx context.Context, groupID int, userID int) (entity.GroupParticipant, error) {
 	ret := _m.Called(ctx, groupID, userID)
@@ -70,6 +108,64 @@ func (_m *MockGroupParticipantService) Invite(ctx context.Context, groupID int,
 	return r0, r1
 }
 
+// InviteMany provides a mock function with given fields: ctx, groupID, userIDs
+func (_m *MockGroupParticipantService) InviteMany(ctx context.Context, groupID int, userIDs []int) ([]entity.GroupInviteResult, error) {
+	ret := _m.Called(ctx, groupID, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InviteMany")
+	}
+
+	var r0 []entity.GroupInviteResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) ([]entity.GroupInviteResult, error)); ok {
+		return rf(ctx, groupID, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) []entity.GroupInviteResult); ok {
+		r0 = rf(ctx, groupID, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.GroupInviteResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, []int) error); ok {
+		r1 = rf(ctx, groupID, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Join provides a mock function with given fields: ctx, code
+func (_m *MockGroupParticipantService) Join(ctx context.Context, code string) (entity.GroupParticipant, error) {
+	ret := _m.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Join")
+	}
+
+	var r0 entity.GroupParticipant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.GroupParticipant, error)); ok {
+		return rf(ctx, code)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.GroupParticipant); ok {
+		r0 = rf(ctx, code)
+	} else {
+		r0 = ret.Get(0).(entity.GroupParticipant)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // List provides a mock function with given fields: ctx, groupID
 func (_m *MockGroupParticipantService) List(ctx context.Context, groupID int) ([]entity.GroupParticipant, error) {
 	ret := _m.Called(ctx, groupID)
@@ -100,17 +196,101 @@ func (_m *MockGroupParticipantService) List(ctx context.Context, groupID int) ([
 	return r0, r1
 }
 
-// UpdateStatus provides a mock function with given fields: ctx, groupID, userID, status
-func (_m *MockGroupParticipantService) UpdateStatus(ctx context.Context, groupID int, userID int, status entity.GroupParticipantStatus) error {
-	ret := _m.Called(ctx, groupID, userID, status)
+// ListJoinRequests provides a mock function with given fields: ctx, groupID
+func (_m *MockGroupParticipantService) ListJoinRequests(ctx context.Context, groupID int) ([]entity.GroupParticipant, error) {
+	ret := _m.Called(ctx, groupID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListJoinRequests")
+	}
+
+	var r0 []entity.GroupParticipant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]entity.GroupParticipant, error)); ok {
+		return rf(ctx, groupID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []entity.GroupParticipant); ok {
+		r0 = rf(ctx, groupID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.GroupParticipant)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, groupID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Promote provides a mock function with given fields: ctx, groupID, userID, role
+func (_m *MockGroupParticipantService) Promote(ctx context.Context, groupID int, userID int, role entity.GroupRole) error {
+	ret := _m.Called(ctx, groupID, userID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Promote")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, entity.GroupRole) error); ok {
+		r0 = rf(ctx, groupID, userID, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RejectJoinRequest provides a mock function with given fields: ctx, groupID, userID
+func (_m *MockGroupParticipantService) RejectJoinRequest(ctx context.Context, groupID int, userID int) error {
+	ret := _m.Called(ctx, groupID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectJoinRequest")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = rf(ctx, groupID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TransferOwnership provides a mock function with given fields: ctx, groupID, userID
+func (_m *MockGroupParticipantService) TransferOwnership(ctx context.Context, groupID int, userID int) error {
+	ret := _m.Called(ctx, groupID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferOwnership")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = rf(ctx, groupID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateStatus provides a mock function with given fields: ctx, obj
+func (_m *MockGroupParticipantService) UpdateStatus(ctx context.Context, obj dto.GroupParticipantStatusUpdate) error {
+	ret := _m.Called(ctx, obj)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateStatus")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int, int, entity.GroupParticipantStatus) error); ok {
-		r0 = rf(ctx, groupID, userID, status)
+	if rf, ok := ret.Get(0).(func(context.Context, dto.GroupParticipantStatusUpdate) error); ok {
+		r0 = rf(ctx, obj)
 	} else {
 		r0 = ret.Error(0)
 	}
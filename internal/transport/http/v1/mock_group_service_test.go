@@ -90,6 +90,34 @@ func (_m *MockGroupService) GetByID(ctx context.Context, id int) (entity.Group,
 	return r0, r1
 }
 
+// GetSettings provides a mock function with given fields: ctx, groupID
+func (_m *MockGroupService) GetSettings(ctx context.Context, groupID int) (entity.GroupSettings, error) {
+	ret := _m.Called(ctx, groupID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSettings")
+	}
+
+	var r0 entity.GroupSettings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (entity.GroupSettings, error)); ok {
+		return rf(ctx, groupID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) entity.GroupSettings); ok {
+		r0 = rf(ctx, groupID)
+	} else {
+		r0 = ret.Get(0).(entity.GroupSettings)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, groupID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // List provides a mock function with given fields: ctx
 func (_m *MockGroupService) List(ctx context.Context) ([]entity.Group, error) {
 	ret := _m.Called(ctx)
@@ -120,6 +148,34 @@ func (_m *MockGroupService) List(ctx context.Context) ([]entity.Group, error) {
 	return r0, r1
 }
 
+// Restore provides a mock function with given fields: ctx, id
+func (_m *MockGroupService) Restore(ctx context.Context, id int) (entity.Group, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 entity.Group
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (entity.Group, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) entity.Group); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(entity.Group)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, obj
 func (_m *MockGroupService) Update(ctx context.Context, obj dto.GroupUpdate) (entity.Group, error) {
 	ret := _m.Called(ctx, obj)
@@ -148,6 +204,34 @@ func (_m *MockGroupService) Update(ctx context.Context, obj dto.GroupUpdate) (en
 	return r0, r1
 }
 
+// UpdateSettings provides a mock function with given fields: ctx, obj
+func (_m *MockGroupService) UpdateSettings(ctx context.Context, obj dto.GroupSettingsUpdate) (entity.GroupSettings, error) {
+	ret := _m.Called(ctx, obj)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSettings")
+	}
+
+	var r0 entity.GroupSettings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.GroupSettingsUpdate) (entity.GroupSettings, error)); ok {
+		return rf(ctx, obj)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.GroupSettingsUpdate) entity.GroupSettings); ok {
+		r0 = rf(ctx, obj)
+	} else {
+		r0 = ret.Get(0).(entity.GroupSettings)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.GroupSettingsUpdate) error); ok {
+		r1 = rf(ctx, obj)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewMockGroupService creates a new instance of MockGroupService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockGroupService(t interface {
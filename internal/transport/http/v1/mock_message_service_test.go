@@ -44,6 +44,34 @@ func (_m *MockMessageService) Create(ctx context.Context, obj dto.MessageCreate)
 	return r0, r1
 }
 
+// Forward provides a mock function with given fields: ctx, obj
+func (_m *MockMessageService) Forward(ctx context.Context, obj dto.MessageForward) (entity.Message, error) {
+	ret := _m.Called(ctx, obj)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Forward")
+	}
+
+	var r0 entity.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.MessageForward) (entity.Message, error)); ok {
+		return rf(ctx, obj)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.MessageForward) entity.Message); ok {
+		r0 = rf(ctx, obj)
+	} else {
+		r0 = ret.Get(0).(entity.Message)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.MessageForward) error); ok {
+		r1 = rf(ctx, obj)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // List provides a mock function with given fields: ctx, obj
 func (_m *MockMessageService) List(ctx context.Context, obj dto.MessageList) ([]entity.Message, error) {
 	ret := _m.Called(ctx, obj)
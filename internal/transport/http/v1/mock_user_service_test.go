@@ -90,9 +90,9 @@ func (_m *MockUserService) GetByID(ctx context.Context, id int) (entity.User, er
 	return r0, r1
 }
 
-// List provides a mock function with given fields: ctx
-func (_m *MockUserService) List(ctx context.Context) ([]entity.User, error) {
-	ret := _m.Called(ctx)
+// List provides a mock function with given fields: ctx, obj
+func (_m *MockUserService) List(ctx context.Context, obj dto.UserList) ([]entity.User, error) {
+	ret := _m.Called(ctx, obj)
 
 	if len(ret) == 0 {
 		panic("no return value specified for List")
@@ -100,19 +100,19 @@ func (_m *MockUserService) List(ctx context.Context) ([]entity.User, error) {
 
 	var r0 []entity.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]entity.User, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, dto.UserList) ([]entity.User, error)); ok {
+		return rf(ctx, obj)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []entity.User); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, dto.UserList) []entity.User); ok {
+		r0 = rf(ctx, obj)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, dto.UserList) error); ok {
+		r1 = rf(ctx, obj)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -120,6 +120,24 @@ func (_m *MockUserService) List(ctx context.Context) ([]entity.User, error) {
 	return r0, r1
 }
 
+// ResendVerification provides a mock function with given fields: ctx, userID
+func (_m *MockUserService) ResendVerification(ctx context.Context, userID int) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResendVerification")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: ctx, obj
 func (_m *MockUserService) Update(ctx context.Context, obj dto.UserUpdate) (entity.User, error) {
 	ret := _m.Called(ctx, obj)
@@ -166,6 +184,24 @@ func (_m *MockUserService) UpdatePassword(ctx context.Context, obj dto.UserUpdat
 	return r0
 }
 
+// VerifyEmail provides a mock function with given fields: ctx, token
+func (_m *MockUserService) VerifyEmail(ctx context.Context, token string) error {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyEmail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewMockUserService creates a new instance of MockUserService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockUserService(t interface {
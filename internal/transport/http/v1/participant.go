@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"time"
 
+	"github.com/Chatyx/backend/internal/dto"
 	"github.com/Chatyx/backend/internal/entity"
 	"github.com/Chatyx/backend/pkg/httputil"
 	"github.com/Chatyx/backend/pkg/httputil/middleware"
@@ -14,21 +16,35 @@ import (
 )
 
 const (
-	groupParticipantListPath   = "/participants"
-	groupParticipantDetailPath = "/participants/:user_id"
+	groupParticipantListPath    = "/participants"
+	groupParticipantBulkPath    = "/bulk-invite"
+	groupParticipantDetailPath  = "/participants/:user_id"
+	groupParticipantPromotePath = "/participants/:user_id/promote"
+	groupParticipantDemotePath  = "/participants/:user_id/demote"
+	groupParticipantOwnerPath   = "/participants/:user_id/transfer-ownership"
+	groupJoinRequestListPath    = "/join-requests"
+	groupJoinRequestApprovePath = "/join-requests/:user_id/approve"
+	groupJoinRequestRejectPath  = "/join-requests/:user_id/reject"
+	groupJoinPath               = "/api/v1/groups/join/:code"
+)
+
+const (
+	joinCodeParam = "code"
 )
 
 type GroupParticipant struct {
-	UserID  int                           `json:"user_id"`
-	Status  entity.GroupParticipantStatus `json:"status"`
-	IsAdmin bool                          `json:"is_admin"`
+	UserID          int                           `json:"user_id"`
+	Status          entity.GroupParticipantStatus `json:"status"`
+	Role            entity.GroupRole              `json:"role"`
+	RestrictedUntil *time.Time                    `json:"restricted_until,omitempty"`
 }
 
 func NewGroupParticipant(participant entity.GroupParticipant) GroupParticipant {
 	return GroupParticipant{
-		UserID:  participant.UserID,
-		Status:  participant.Status,
-		IsAdmin: participant.IsAdmin,
+		UserID:          participant.UserID,
+		Status:          participant.Status,
+		Role:            participant.Role,
+		RestrictedUntil: participant.RestrictedUntil,
 	}
 }
 
@@ -49,8 +65,42 @@ func NewGroupParticipantList(participants []entity.GroupParticipant) GroupPartic
 	}
 }
 
+type GroupParticipantBulkInvite struct {
+	UserIDs []int `json:"user_ids" validate:"required,min=1,max=100,dive,min=1"`
+}
+
+type GroupInviteResult struct {
+	UserID int                      `json:"user_id"`
+	Status entity.GroupInviteStatus `json:"status"`
+}
+
+type GroupInviteResultList struct {
+	Total int                 `json:"total"`
+	Data  []GroupInviteResult `json:"data"`
+}
+
+func NewGroupInviteResultList(results []entity.GroupInviteResult) GroupInviteResultList {
+	data := make([]GroupInviteResult, len(results))
+	for i, result := range results {
+		data[i] = GroupInviteResult{
+			UserID: result.UserID,
+			Status: result.Status,
+		}
+	}
+
+	return GroupInviteResultList{
+		Total: len(results),
+		Data:  data,
+	}
+}
+
 type GroupParticipantUpdate struct {
-	Status *string `json:"status" validate:"omitempty,oneof=joined left kicked"`
+	Status          *string    `json:"status"           validate:"omitempty,oneof=joined left kicked muted banned"`
+	RestrictedUntil *time.Time `json:"restricted_until" validate:"omitempty,gt"`
+}
+
+type GroupParticipantRoleUpdate struct {
+	Role string `json:"role" validate:"required,oneof=admin moderator member"`
 }
 
 //go:generate mockery --inpackage --testonly --case underscore --name GroupParticipantService
@@ -58,7 +108,15 @@ type GroupParticipantService interface {
 	List(ctx context.Context, groupID int) ([]entity.GroupParticipant, error)
 	Get(ctx context.Context, groupID, userID int) (entity.GroupParticipant, error)
 	Invite(ctx context.Context, groupID, userID int) (entity.GroupParticipant, error)
-	UpdateStatus(ctx context.Context, groupID, userID int, status entity.GroupParticipantStatus) error
+	InviteMany(ctx context.Context, groupID int, userIDs []int) ([]entity.GroupInviteResult, error)
+	UpdateStatus(ctx context.Context, obj dto.GroupParticipantStatusUpdate) error
+	Promote(ctx context.Context, groupID, userID int, role entity.GroupRole) error
+	Demote(ctx context.Context, groupID, userID int, role entity.GroupRole) error
+	TransferOwnership(ctx context.Context, groupID, userID int) error
+	Join(ctx context.Context, code string) (entity.GroupParticipant, error)
+	ListJoinRequests(ctx context.Context, groupID int) ([]entity.GroupParticipant, error)
+	ApproveJoinRequest(ctx context.Context, groupID, userID int) error
+	RejectJoinRequest(ctx context.Context, groupID, userID int) error
 }
 
 type GroupParticipantControllerConfig struct {
@@ -84,8 +142,20 @@ func NewGroupParticipantController(conf GroupParticipantControllerConfig) *Group
 func (pc *GroupParticipantController) Register(mux *httprouter.Router) {
 	mux.Handler(http.MethodGet, groupDetailPath+groupParticipantListPath, pc.authorize(http.HandlerFunc(pc.list)))
 	mux.Handler(http.MethodPost, groupDetailPath+groupParticipantListPath, pc.authorize(http.HandlerFunc(pc.invite)))
+	mux.Handler(http.MethodPost, groupDetailPath+groupParticipantBulkPath, pc.authorize(http.HandlerFunc(pc.inviteMany)))
 	mux.Handler(http.MethodGet, groupDetailPath+groupParticipantDetailPath, pc.authorize(http.HandlerFunc(pc.detail)))
 	mux.Handler(http.MethodPatch, groupDetailPath+groupParticipantDetailPath, pc.authorize(http.HandlerFunc(pc.update)))
+	mux.Handler(http.MethodPost, groupDetailPath+groupParticipantPromotePath, pc.authorize(http.HandlerFunc(pc.promote)))
+	mux.Handler(http.MethodPost, groupDetailPath+groupParticipantDemotePath, pc.authorize(http.HandlerFunc(pc.demote)))
+	mux.Handler(http.MethodPost, groupDetailPath+groupParticipantOwnerPath, pc.authorize(http.HandlerFunc(pc.transferOwnership)))
+	mux.Handler(http.MethodGet, groupDetailPath+groupJoinRequestListPath, pc.authorize(http.HandlerFunc(pc.listJoinRequests)))
+	mux.Handler(http.MethodPost, groupDetailPath+groupJoinRequestApprovePath, pc.authorize(http.HandlerFunc(pc.approveJoinRequest)))
+	mux.Handler(http.MethodPost, groupDetailPath+groupJoinRequestRejectPath, pc.authorize(http.HandlerFunc(pc.rejectJoinRequest)))
+}
+
+// RegisterPriority registers the join route which conflicts with the group detail wildcard.
+func (pc *GroupParticipantController) RegisterPriority(mux *httprouter.Router) {
+	mux.Handler(http.MethodPost, groupJoinPath, pc.authorize(http.HandlerFunc(pc.join)))
 }
 
 // list lists all participants for a specified group
@@ -168,6 +238,10 @@ func (pc *GroupParticipantController) invite(w http.ResponseWriter, req *http.Re
 			httputil.RespondError(ctx, w, errInviteNonExistentUserToGroup.Wrap(err))
 		case errors.Is(err, entity.ErrSuchGroupParticipantAlreadyExists):
 			httputil.RespondError(ctx, w, errSuchGroupParticipantAlreadyExists.Wrap(err))
+		case errors.Is(err, entity.ErrGroupParticipantLimitReached):
+			httputil.RespondError(ctx, w, errGroupParticipantLimitReached.Wrap(err))
+		case errors.Is(err, entity.ErrUserBlocked):
+			httputil.RespondError(ctx, w, errUserBlocked.Wrap(err))
 		case errors.Is(err, entity.ErrForbiddenPerformAction):
 			httputil.RespondError(ctx, w, httputil.ErrForbiddenPerformAction.Wrap(err))
 		default:
@@ -180,6 +254,244 @@ func (pc *GroupParticipantController) invite(w http.ResponseWriter, req *http.Re
 	httputil.RespondSuccess(ctx, w, http.StatusCreated, NewGroupParticipant(participant))
 }
 
+// inviteMany invites several users in a group at once
+//
+//	@Summary		Invite several users in a group at once
+//	@Description	Users who can't be invited don't fail the request, the reason is returned for every user.
+//	@Tags			group-participants
+//	@Accept			json
+//	@Produce		json
+//	@Param			group_id	path		int							true	"Group identity"
+//	@Param			input		body		GroupParticipantBulkInvite	true	"Users to invite"
+//	@Success		200			{object}	GroupInviteResultList
+//	@Failure		400			{object}	httputil.Error
+//	@Failure		403			{object}	httputil.Error
+//	@Failure		404			{object}	httputil.Error
+//	@Failure		500			{object}	httputil.Error
+//	@Security		JWTAuth
+//	@Router			/groups/{group_id}/bulk-invite  [post]
+func (pc *GroupParticipantController) inviteMany(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var (
+		groupID int
+		bodyObj GroupParticipantBulkInvite
+	)
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.MergeResults(
+		dec.Path(groupIDParam, &groupID, nil),
+		dec.Body(&bodyObj),
+	); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	if err := pc.validator.Struct(bodyObj); err != nil {
+		ve := validator.Error{}
+		if errors.As(err, &ve) {
+			httputil.RespondError(ctx, w, httputil.ErrValidationFailed.WithData(ve.Fields).Wrap(err))
+			return
+		}
+
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	results, err := pc.service.InviteMany(ctx, groupID, bodyObj.UserIDs)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrGroupNotFound):
+			httputil.RespondError(ctx, w, errGroupNotFound.Wrap(err))
+		case errors.Is(err, entity.ErrForbiddenPerformAction):
+			httputil.RespondError(ctx, w, httputil.ErrForbiddenPerformAction.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusOK, NewGroupInviteResultList(results))
+}
+
+// join joins the current user to a group
+//
+//	@Summary		Join the current user to a group
+//	@Description	The code is either a token of the group invite link or the public group uname.
+//	@Description	If the invite link requires approval, a pending join request is created instead.
+//	@Tags			group-participants
+//	@Accept			json
+//	@Produce		json
+//	@Param			code	path		string	true	"Invite link token or group uname"
+//	@Success		201		{object}	GroupParticipant
+//	@Success		202		{object}	GroupParticipant
+//	@Failure		400		{object}	httputil.Error
+//	@Failure		403		{object}	httputil.Error
+//	@Failure		404		{object}	httputil.Error
+//	@Failure		500		{object}	httputil.Error
+//	@Security		JWTAuth
+//	@Router			/groups/join/{code}  [post]
+func (pc *GroupParticipantController) join(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var code string
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.Path(joinCodeParam, &code, nil); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	participant, err := pc.service.Join(ctx, code)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrGroupNotFound):
+			httputil.RespondError(ctx, w, errGroupNotFound.Wrap(err))
+		case errors.Is(err, entity.ErrInactiveGroupInviteLink):
+			httputil.RespondError(ctx, w, errInactiveGroupInviteLink.Wrap(err))
+		case errors.Is(err, entity.ErrSuchGroupParticipantAlreadyExists):
+			httputil.RespondError(ctx, w, errSuchGroupParticipantAlreadyExists.Wrap(err))
+		case errors.Is(err, entity.ErrGroupParticipantLimitReached):
+			httputil.RespondError(ctx, w, errGroupParticipantLimitReached.Wrap(err))
+		case errors.Is(err, entity.ErrForbiddenPerformAction):
+			httputil.RespondError(ctx, w, httputil.ErrForbiddenPerformAction.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	statusCode := http.StatusCreated
+	if participant.Status == entity.PendingStatus {
+		statusCode = http.StatusAccepted
+	}
+
+	httputil.RespondSuccess(ctx, w, statusCode, NewGroupParticipant(participant))
+}
+
+// listJoinRequests lists pending join requests of a specified group
+//
+//	@Summary	List pending join requests of a specified group
+//	@Tags		group-participants
+//	@Accept		json
+//	@Produce	json
+//	@Param		group_id	path		int	true	"Group identity"
+//	@Success	200			{object}	GroupParticipantList
+//	@Failure	400			{object}	httputil.Error
+//	@Failure	403			{object}	httputil.Error
+//	@Failure	404			{object}	httputil.Error
+//	@Failure	500			{object}	httputil.Error
+//	@Security	JWTAuth
+//	@Router		/groups/{group_id}/join-requests  [get]
+func (pc *GroupParticipantController) listJoinRequests(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var groupID int
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.Path(groupIDParam, &groupID, nil); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	participants, err := pc.service.ListJoinRequests(ctx, groupID)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrGroupNotFound):
+			httputil.RespondError(ctx, w, errGroupNotFound.Wrap(err))
+		case errors.Is(err, entity.ErrForbiddenPerformAction):
+			httputil.RespondError(ctx, w, httputil.ErrForbiddenPerformAction.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusOK, NewGroupParticipantList(participants))
+}
+
+// approveJoinRequest approves a join request of a specified user
+//
+//	@Summary	Approve a join request of a specified user
+//	@Tags		group-participants
+//	@Accept		json
+//	@Produce	json
+//	@Param		group_id	path	int	true	"Group identity"
+//	@Param		user_id		path	int	true	"User identity"
+//	@Success	204			"No Content"
+//	@Failure	400			{object}	httputil.Error
+//	@Failure	403			{object}	httputil.Error
+//	@Failure	404			{object}	httputil.Error
+//	@Failure	500			{object}	httputil.Error
+//	@Security	JWTAuth
+//	@Router		/groups/{group_id}/join-requests/{user_id}/approve  [post]
+func (pc *GroupParticipantController) approveJoinRequest(w http.ResponseWriter, req *http.Request) {
+	pc.reviewJoinRequest(w, req, pc.service.ApproveJoinRequest)
+}
+
+// rejectJoinRequest rejects a join request of a specified user
+//
+//	@Summary	Reject a join request of a specified user
+//	@Tags		group-participants
+//	@Accept		json
+//	@Produce	json
+//	@Param		group_id	path	int	true	"Group identity"
+//	@Param		user_id		path	int	true	"User identity"
+//	@Success	204			"No Content"
+//	@Failure	400			{object}	httputil.Error
+//	@Failure	403			{object}	httputil.Error
+//	@Failure	404			{object}	httputil.Error
+//	@Failure	500			{object}	httputil.Error
+//	@Security	JWTAuth
+//	@Router		/groups/{group_id}/join-requests/{user_id}/reject  [post]
+func (pc *GroupParticipantController) rejectJoinRequest(w http.ResponseWriter, req *http.Request) {
+	pc.reviewJoinRequest(w, req, pc.service.RejectJoinRequest)
+}
+
+type reviewJoinRequestFunc func(ctx context.Context, groupID, userID int) error
+
+func (pc *GroupParticipantController) reviewJoinRequest(w http.ResponseWriter, req *http.Request, fn reviewJoinRequestFunc) {
+	ctx := req.Context()
+
+	var (
+		groupID int
+		userID  int
+	)
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.MergeResults(
+		dec.Path(groupIDParam, &groupID, nil),
+		dec.Path(userIDParam, &userID, nil),
+	); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	if err := fn(ctx, groupID, userID); err != nil {
+		switch {
+		case errors.Is(err, entity.ErrGroupNotFound):
+			httputil.RespondError(ctx, w, errGroupNotFound.Wrap(err))
+		case errors.Is(err, entity.ErrGroupParticipantNotFound):
+			httputil.RespondError(ctx, w, errGroupParticipantNotFound.Wrap(err))
+		case errors.Is(err, entity.ErrIncorrectGroupParticipantStatusTransit):
+			httputil.RespondError(ctx, w, errIncorrectGroupParticipantStatusTransit.Wrap(err))
+		case errors.Is(err, entity.ErrGroupParticipantLimitReached):
+			httputil.RespondError(ctx, w, errGroupParticipantLimitReached.Wrap(err))
+		case errors.Is(err, entity.ErrForbiddenPerformAction):
+			httputil.RespondError(ctx, w, httputil.ErrForbiddenPerformAction.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusNoContent, nil)
+}
+
 // detail gets a specified participant in a group
 //
 //	@Summary	Get a specified participant in a group
@@ -232,6 +544,8 @@ func (pc *GroupParticipantController) detail(w http.ResponseWriter, req *http.Re
 //
 //	@Summary		Update a specified participant in a group
 //	@Description	It can be used to join/kick/leave participant from the group.
+//	@Description	Muted participants can read the group but can't post, banned ones can't join the group.
+//	@Description	Mute and ban are permanent unless restricted_until is specified.
 //	@Tags			group-participants
 //	@Accept			json
 //	@Produce		json
@@ -280,7 +594,12 @@ func (pc *GroupParticipantController) update(w http.ResponseWriter, req *http.Re
 		return
 	}
 
-	err := pc.service.UpdateStatus(ctx, groupID, userID, entity.GroupParticipantStatus(*bodyObj.Status))
+	err := pc.service.UpdateStatus(ctx, dto.GroupParticipantStatusUpdate{
+		GroupID:         groupID,
+		UserID:          userID,
+		Status:          entity.GroupParticipantStatus(*bodyObj.Status),
+		RestrictedUntil: bodyObj.RestrictedUntil,
+	})
 	if err != nil {
 		switch {
 		case errors.Is(err, entity.ErrGroupNotFound):
@@ -289,6 +608,157 @@ func (pc *GroupParticipantController) update(w http.ResponseWriter, req *http.Re
 			httputil.RespondError(ctx, w, errGroupParticipantNotFound.Wrap(err))
 		case errors.Is(err, entity.ErrIncorrectGroupParticipantStatusTransit):
 			httputil.RespondError(ctx, w, errIncorrectGroupParticipantStatusTransit)
+		case errors.Is(err, entity.ErrLastGroupOwnerLeaving):
+			httputil.RespondError(ctx, w, errLastGroupOwnerLeaving.Wrap(err))
+		case errors.Is(err, entity.ErrGroupParticipantLimitReached):
+			httputil.RespondError(ctx, w, errGroupParticipantLimitReached.Wrap(err))
+		case errors.Is(err, entity.ErrForbiddenPerformAction):
+			httputil.RespondError(ctx, w, httputil.ErrForbiddenPerformAction.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusNoContent, nil)
+}
+
+// transferOwnership transfers ownership of a group to a specified participant
+//
+//	@Summary		Transfer ownership of a group to a specified participant
+//	@Description	Only the owner can transfer ownership, the former owner becomes an admin.
+//	@Tags			group-participants
+//	@Accept			json
+//	@Produce		json
+//	@Param			group_id	path	int	true	"Group identity"
+//	@Param			user_id		path	int	true	"User identity of the new owner"
+//	@Success		204			"No Content"
+//	@Failure		400			{object}	httputil.Error
+//	@Failure		403			{object}	httputil.Error
+//	@Failure		404			{object}	httputil.Error
+//	@Failure		500			{object}	httputil.Error
+//	@Security		JWTAuth
+//	@Router			/groups/{group_id}/participants/{user_id}/transfer-ownership  [post]
+func (pc *GroupParticipantController) transferOwnership(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var (
+		groupID int
+		userID  int
+	)
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.MergeResults(
+		dec.Path(groupIDParam, &groupID, nil),
+		dec.Path(userIDParam, &userID, nil),
+	); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	if err := pc.service.TransferOwnership(ctx, groupID, userID); err != nil {
+		switch {
+		case errors.Is(err, entity.ErrGroupNotFound):
+			httputil.RespondError(ctx, w, errGroupNotFound.Wrap(err))
+		case errors.Is(err, entity.ErrGroupParticipantNotFound):
+			httputil.RespondError(ctx, w, errGroupParticipantNotFound.Wrap(err))
+		case errors.Is(err, entity.ErrIncorrectGroupParticipantRoleChange):
+			httputil.RespondError(ctx, w, errIncorrectGroupParticipantRoleChange.Wrap(err))
+		case errors.Is(err, entity.ErrForbiddenPerformAction):
+			httputil.RespondError(ctx, w, httputil.ErrForbiddenPerformAction.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusNoContent, nil)
+}
+
+// promote promotes a specified participant in a group
+//
+//	@Summary		Promote a specified participant in a group
+//	@Description	Participant can grant only roles lower than own one.
+//	@Tags			group-participants
+//	@Accept			json
+//	@Produce		json
+//	@Param			group_id	path	int							true	"Group identity"
+//	@Param			user_id		path	int							true	"User identity"
+//	@Param			input		body	GroupParticipantRoleUpdate	true	"Role to grant"
+//	@Success		204			"No Content"
+//	@Failure		400			{object}	httputil.Error
+//	@Failure		403			{object}	httputil.Error
+//	@Failure		404			{object}	httputil.Error
+//	@Failure		500			{object}	httputil.Error
+//	@Security		JWTAuth
+//	@Router			/groups/{group_id}/participants/{user_id}/promote  [post]
+func (pc *GroupParticipantController) promote(w http.ResponseWriter, req *http.Request) {
+	pc.changeRole(w, req, pc.service.Promote)
+}
+
+// demote demotes a specified participant in a group
+//
+//	@Summary		Demote a specified participant in a group
+//	@Description	Participant can demote only participants with roles lower than own one.
+//	@Tags			group-participants
+//	@Accept			json
+//	@Produce		json
+//	@Param			group_id	path	int							true	"Group identity"
+//	@Param			user_id		path	int							true	"User identity"
+//	@Param			input		body	GroupParticipantRoleUpdate	true	"Role to grant"
+//	@Success		204			"No Content"
+//	@Failure		400			{object}	httputil.Error
+//	@Failure		403			{object}	httputil.Error
+//	@Failure		404			{object}	httputil.Error
+//	@Failure		500			{object}	httputil.Error
+//	@Security		JWTAuth
+//	@Router			/groups/{group_id}/participants/{user_id}/demote  [post]
+func (pc *GroupParticipantController) demote(w http.ResponseWriter, req *http.Request) {
+	pc.changeRole(w, req, pc.service.Demote)
+}
+
+type changeRoleFunc func(ctx context.Context, groupID, userID int, role entity.GroupRole) error
+
+func (pc *GroupParticipantController) changeRole(w http.ResponseWriter, req *http.Request, fn changeRoleFunc) {
+	ctx := req.Context()
+
+	var (
+		groupID int
+		userID  int
+		bodyObj GroupParticipantRoleUpdate
+	)
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.MergeResults(
+		dec.Path(groupIDParam, &groupID, nil),
+		dec.Path(userIDParam, &userID, nil),
+		dec.Body(&bodyObj),
+	); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	if err := pc.validator.Struct(bodyObj); err != nil {
+		ve := validator.Error{}
+		if errors.As(err, &ve) {
+			httputil.RespondError(ctx, w, httputil.ErrValidationFailed.WithData(ve.Fields).Wrap(err))
+			return
+		}
+
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	if err := fn(ctx, groupID, userID, entity.GroupRole(bodyObj.Role)); err != nil {
+		switch {
+		case errors.Is(err, entity.ErrGroupNotFound):
+			httputil.RespondError(ctx, w, errGroupNotFound.Wrap(err))
+		case errors.Is(err, entity.ErrGroupParticipantNotFound):
+			httputil.RespondError(ctx, w, errGroupParticipantNotFound.Wrap(err))
+		case errors.Is(err, entity.ErrIncorrectGroupParticipantRoleChange):
+			httputil.RespondError(ctx, w, errIncorrectGroupParticipantRoleChange.Wrap(err))
 		case errors.Is(err, entity.ErrForbiddenPerformAction):
 			httputil.RespondError(ctx, w, httputil.ErrForbiddenPerformAction.Wrap(err))
 		default:
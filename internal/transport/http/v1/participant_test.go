@@ -10,6 +10,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/Chatyx/backend/internal/dto"
 	"github.com/Chatyx/backend/internal/entity"
 	"github.com/Chatyx/backend/pkg/validator"
 
@@ -35,25 +36,25 @@ func TestGroupParticipantController_list(t *testing.T) {
 					{
 						GroupID: 1,
 						UserID:  1,
-						IsAdmin: true,
+						Role:    entity.AdminRole,
 						Status:  entity.JoinedStatus,
 					},
 					{
 						GroupID: 1,
 						UserID:  2,
-						IsAdmin: false,
+						Role:    entity.MemberRole,
 						Status:  entity.KickedStatus,
 					},
 					{
 						GroupID: 1,
 						UserID:  3,
-						IsAdmin: false,
+						Role:    entity.MemberRole,
 						Status:  entity.LeftStatus,
 					},
 				}, nil)
 			},
 			expectedStatusCode:   http.StatusOK,
-			expectedResponseBody: `{"total":3,"data":[{"user_id":1,"status":"joined","is_admin":true},{"user_id":2,"status":"kicked","is_admin":false},{"user_id":3,"status":"left","is_admin":false}]}`,
+			expectedResponseBody: `{"total":3,"data":[{"user_id":1,"status":"joined","role":"admin"},{"user_id":2,"status":"kicked","role":"member"},{"user_id":3,"status":"left","role":"member"}]}`,
 		},
 		{
 			name:             "Successful with empty list",
@@ -140,12 +141,12 @@ func TestGroupParticipantController_invite(t *testing.T) {
 				s.On("Invite", mock.Anything, 1, 2).Return(entity.GroupParticipant{
 					GroupID: 1,
 					UserID:  2,
-					IsAdmin: false,
+					Role:    entity.MemberRole,
 					Status:  entity.JoinedStatus,
 				}, nil)
 			},
 			expectedStatusCode:   http.StatusCreated,
-			expectedResponseBody: `{"user_id":2,"status":"joined","is_admin":false}`,
+			expectedResponseBody: `{"user_id":2,"status":"joined","role":"member"}`,
 		},
 		{
 			name:                 "Decode path param error",
@@ -191,6 +192,16 @@ func TestGroupParticipantController_invite(t *testing.T) {
 			expectedStatusCode:   http.StatusBadRequest,
 			expectedResponseBody: `{"code":"CH0008","message":"such a group participant already exists"}`,
 		},
+		{
+			name:             "Invite user when participant limit is reached",
+			groupIDPathParam: "1",
+			userIDQueryParam: "2",
+			mockBehavior: func(s *MockGroupParticipantService) {
+				s.On("Invite", mock.Anything, 1, 2).Return(entity.GroupParticipant{}, entity.ErrGroupParticipantLimitReached)
+			},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"CH0016","message":"group participant limit is reached"}`,
+		},
 		{
 			name:             "Invite user without admin permission",
 			groupIDPathParam: "1",
@@ -250,6 +261,109 @@ func TestGroupParticipantController_invite(t *testing.T) {
 	}
 }
 
+func TestGroupParticipantController_inviteMany(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		groupIDPathParam     string
+		requestBody          string
+		mockBehavior         func(s *MockGroupParticipantService)
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:             "Successful",
+			groupIDPathParam: "1",
+			requestBody:      `{"user_ids":[2,3,4]}`,
+			mockBehavior: func(s *MockGroupParticipantService) {
+				s.On("InviteMany", mock.Anything, 1, []int{2, 3, 4}).Return([]entity.GroupInviteResult{
+					{UserID: 2, Status: entity.AddedInviteStatus},
+					{UserID: 3, Status: entity.AlreadyExistsInviteStatus},
+					{UserID: 4, Status: entity.LimitReachedInviteStatus},
+				}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponseBody: `{"total":3,"data":[{"user_id":2,"status":"added"},` +
+				`{"user_id":3,"status":"already_exists"},{"user_id":4,"status":"limit_reached"}]}`,
+		},
+		{
+			name:                 "Decode path param error",
+			groupIDPathParam:     uuid.New().String(),
+			requestBody:          `{"user_ids":[2]}`,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"CM0003","message":"decode path params error","data":{"group_id":"failed to parse int"}}`,
+		},
+		{
+			name:                 "Validation error",
+			groupIDPathParam:     "1",
+			requestBody:          `{"user_ids":[]}`,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"CM0006","message":"validation error","data":{"user_ids":"failed on the 'min' tag"}}`,
+		},
+		{
+			name:             "Group is not found",
+			groupIDPathParam: "1",
+			requestBody:      `{"user_ids":[2]}`,
+			mockBehavior: func(s *MockGroupParticipantService) {
+				s.On("InviteMany", mock.Anything, 1, []int{2}).Return(nil, entity.ErrGroupNotFound)
+			},
+			expectedStatusCode:   http.StatusNotFound,
+			expectedResponseBody: `{"code":"CH0001","message":"group is not found"}`,
+		},
+		{
+			name:             "Invite users without admin permission",
+			groupIDPathParam: "1",
+			requestBody:      `{"user_ids":[2]}`,
+			mockBehavior: func(s *MockGroupParticipantService) {
+				s.On("InviteMany", mock.Anything, 1, []int{2}).Return(nil, entity.ErrForbiddenPerformAction)
+			},
+			expectedStatusCode:   http.StatusForbidden,
+			expectedResponseBody: `{"code":"CM0008","message":"it's forbidden to perform this action"}`,
+		},
+		{
+			name:             "Internal server error",
+			groupIDPathParam: "1",
+			requestBody:      `{"user_ids":[2]}`,
+			mockBehavior: func(s *MockGroupParticipantService) {
+				s.On("InviteMany", mock.Anything, 1, []int{2}).Return(nil, errUnexpected)
+			},
+			expectedStatusCode:   http.StatusInternalServerError,
+			expectedResponseBody: `{"code":"CM0001","message":"internal server error"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := NewMockGroupParticipantService(t)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(service)
+			}
+
+			cnt := NewGroupParticipantController(GroupParticipantControllerConfig{
+				Service:   service,
+				Validator: validator.NewValidator(),
+			})
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, groupDetailPath+groupParticipantBulkPath, strings.NewReader(testCase.requestBody))
+			ctx := context.WithValue(
+				req.Context(),
+				httprouter.ParamsKey,
+				httprouter.Params{{Key: "group_id", Value: testCase.groupIDPathParam}},
+			)
+			req = req.WithContext(ctx)
+
+			cnt.inviteMany(rec, req)
+			resp := rec.Result()
+
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedResponseBody, string(respBody))
+		})
+	}
+}
+
 func TestGroupParticipantController_detail(t *testing.T) {
 	testCases := []struct {
 		name                 string
@@ -267,12 +381,12 @@ func TestGroupParticipantController_detail(t *testing.T) {
 				s.On("Get", mock.Anything, 1, 2).Return(entity.GroupParticipant{
 					GroupID: 1,
 					UserID:  2,
-					IsAdmin: false,
+					Role:    entity.MemberRole,
 					Status:  entity.JoinedStatus,
 				}, nil)
 			},
 			expectedStatusCode:   http.StatusOK,
-			expectedResponseBody: `{"user_id":2,"status":"joined","is_admin":false}`,
+			expectedResponseBody: `{"user_id":2,"status":"joined","role":"member"}`,
 		},
 		{
 			name:                 "Decode group_id path param error",
@@ -372,7 +486,7 @@ func TestGroupParticipantController_update(t *testing.T) {
 			userIDPathParam:  "2",
 			requestBody:      `{"status":"kicked"}`,
 			mockBehavior: func(s *MockGroupParticipantService) {
-				s.On("UpdateStatus", mock.Anything, 1, 2, entity.KickedStatus).Return(nil)
+				s.On("UpdateStatus", mock.Anything, dto.GroupParticipantStatusUpdate{GroupID: 1, UserID: 2, Status: entity.KickedStatus}).Return(nil)
 			},
 			expectedStatusCode: http.StatusNoContent,
 		},
@@ -421,7 +535,7 @@ func TestGroupParticipantController_update(t *testing.T) {
 			userIDPathParam:  "2",
 			requestBody:      `{"status":"kicked"}`,
 			mockBehavior: func(s *MockGroupParticipantService) {
-				s.On("UpdateStatus", mock.Anything, 1, 2, entity.KickedStatus).Return(entity.ErrGroupNotFound)
+				s.On("UpdateStatus", mock.Anything, dto.GroupParticipantStatusUpdate{GroupID: 1, UserID: 2, Status: entity.KickedStatus}).Return(entity.ErrGroupNotFound)
 			},
 			expectedStatusCode:   http.StatusNotFound,
 			expectedResponseBody: `{"code":"CH0001","message":"group is not found"}`,
@@ -432,7 +546,7 @@ func TestGroupParticipantController_update(t *testing.T) {
 			userIDPathParam:  "2",
 			requestBody:      `{"status":"kicked"}`,
 			mockBehavior: func(s *MockGroupParticipantService) {
-				s.On("UpdateStatus", mock.Anything, 1, 2, entity.KickedStatus).Return(entity.ErrGroupParticipantNotFound)
+				s.On("UpdateStatus", mock.Anything, dto.GroupParticipantStatusUpdate{GroupID: 1, UserID: 2, Status: entity.KickedStatus}).Return(entity.ErrGroupParticipantNotFound)
 			},
 			expectedStatusCode:   http.StatusNotFound,
 			expectedResponseBody: `{"code":"CH0006","message":"group participant is not found"}`,
@@ -443,7 +557,7 @@ func TestGroupParticipantController_update(t *testing.T) {
 			userIDPathParam:  "2",
 			requestBody:      `{"status":"kicked"}`,
 			mockBehavior: func(s *MockGroupParticipantService) {
-				s.On("UpdateStatus", mock.Anything, 1, 2, entity.KickedStatus).Return(entity.ErrForbiddenPerformAction)
+				s.On("UpdateStatus", mock.Anything, dto.GroupParticipantStatusUpdate{GroupID: 1, UserID: 2, Status: entity.KickedStatus}).Return(entity.ErrForbiddenPerformAction)
 			},
 			expectedStatusCode:   http.StatusForbidden,
 			expectedResponseBody: `{"code":"CM0008","message":"it's forbidden to perform this action"}`,
@@ -454,18 +568,29 @@ func TestGroupParticipantController_update(t *testing.T) {
 			userIDPathParam:  "2",
 			requestBody:      `{"status":"kicked"}`,
 			mockBehavior: func(s *MockGroupParticipantService) {
-				s.On("UpdateStatus", mock.Anything, 1, 2, entity.KickedStatus).Return(entity.ErrIncorrectGroupParticipantStatusTransit)
+				s.On("UpdateStatus", mock.Anything, dto.GroupParticipantStatusUpdate{GroupID: 1, UserID: 2, Status: entity.KickedStatus}).Return(entity.ErrIncorrectGroupParticipantStatusTransit)
 			},
 			expectedStatusCode:   http.StatusBadRequest,
 			expectedResponseBody: `{"code":"CH0009","message":"incorrect group participant status transit"}`,
 		},
+		{
+			name:             "The last owner leaves the group",
+			groupIDPathParam: "1",
+			userIDPathParam:  "2",
+			requestBody:      `{"status":"left"}`,
+			mockBehavior: func(s *MockGroupParticipantService) {
+				s.On("UpdateStatus", mock.Anything, dto.GroupParticipantStatusUpdate{GroupID: 1, UserID: 2, Status: entity.LeftStatus}).Return(entity.ErrLastGroupOwnerLeaving)
+			},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"CH0011","message":"the last group owner can't leave without transferring ownership"}`,
+		},
 		{
 			name:             "Internal server error",
 			groupIDPathParam: "1",
 			userIDPathParam:  "2",
 			requestBody:      `{"status":"kicked"}`,
 			mockBehavior: func(s *MockGroupParticipantService) {
-				s.On("UpdateStatus", mock.Anything, 1, 2, entity.KickedStatus).Return(errUnexpected)
+				s.On("UpdateStatus", mock.Anything, dto.GroupParticipantStatusUpdate{GroupID: 1, UserID: 2, Status: entity.KickedStatus}).Return(errUnexpected)
 			},
 			expectedStatusCode:   http.StatusInternalServerError,
 			expectedResponseBody: `{"code":"CM0001","message":"internal server error"}`,
@@ -507,3 +632,320 @@ func TestGroupParticipantController_update(t *testing.T) {
 		})
 	}
 }
+
+func TestGroupParticipantController_promote(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		requestBody          string
+		mockBehavior         func(s *MockGroupParticipantService)
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:        "Successful",
+			requestBody: `{"role":"moderator"}`,
+			mockBehavior: func(s *MockGroupParticipantService) {
+				s.On("Promote", mock.Anything, 1, 2, entity.ModeratorRole).Return(nil)
+			},
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name:                 "Validation error: owner role can't be granted",
+			requestBody:          `{"role":"owner"}`,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"CM0006","message":"validation error","data":{"role":"failed on the 'oneof' tag"}}`,
+		},
+		{
+			name:        "Incorrect role change",
+			requestBody: `{"role":"member"}`,
+			mockBehavior: func(s *MockGroupParticipantService) {
+				s.On("Promote", mock.Anything, 1, 2, entity.MemberRole).Return(entity.ErrIncorrectGroupParticipantRoleChange)
+			},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"CH0010","message":"incorrect group participant role change"}`,
+		},
+		{
+			name:        "Promote without permission",
+			requestBody: `{"role":"admin"}`,
+			mockBehavior: func(s *MockGroupParticipantService) {
+				s.On("Promote", mock.Anything, 1, 2, entity.AdminRole).Return(entity.ErrForbiddenPerformAction)
+			},
+			expectedStatusCode:   http.StatusForbidden,
+			expectedResponseBody: `{"code":"CM0008","message":"it's forbidden to perform this action"}`,
+		},
+		{
+			name:        "Group participant is not found",
+			requestBody: `{"role":"admin"}`,
+			mockBehavior: func(s *MockGroupParticipantService) {
+				s.On("Promote", mock.Anything, 1, 2, entity.AdminRole).Return(entity.ErrGroupParticipantNotFound)
+			},
+			expectedStatusCode:   http.StatusNotFound,
+			expectedResponseBody: `{"code":"CH0006","message":"group participant is not found"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := NewMockGroupParticipantService(t)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(service)
+			}
+
+			cnt := NewGroupParticipantController(GroupParticipantControllerConfig{
+				Service:   service,
+				Validator: validator.NewValidator(),
+			})
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, groupDetailPath+groupParticipantPromotePath, strings.NewReader(testCase.requestBody))
+			ctx := context.WithValue(
+				req.Context(),
+				httprouter.ParamsKey,
+				httprouter.Params{
+					{Key: "group_id", Value: "1"},
+					{Key: "user_id", Value: "2"},
+				},
+			)
+			req = req.WithContext(ctx)
+
+			cnt.promote(rec, req)
+			resp := rec.Result()
+
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedResponseBody, string(respBody))
+		})
+	}
+}
+
+func TestGroupParticipantController_transferOwnership(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		mockBehavior         func(s *MockGroupParticipantService)
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Successful",
+			mockBehavior: func(s *MockGroupParticipantService) {
+				s.On("TransferOwnership", mock.Anything, 1, 2).Return(nil)
+			},
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name: "Transfer without permission",
+			mockBehavior: func(s *MockGroupParticipantService) {
+				s.On("TransferOwnership", mock.Anything, 1, 2).Return(entity.ErrForbiddenPerformAction)
+			},
+			expectedStatusCode:   http.StatusForbidden,
+			expectedResponseBody: `{"code":"CM0008","message":"it's forbidden to perform this action"}`,
+		},
+		{
+			name: "Group participant is not found",
+			mockBehavior: func(s *MockGroupParticipantService) {
+				s.On("TransferOwnership", mock.Anything, 1, 2).Return(entity.ErrGroupParticipantNotFound)
+			},
+			expectedStatusCode:   http.StatusNotFound,
+			expectedResponseBody: `{"code":"CH0006","message":"group participant is not found"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := NewMockGroupParticipantService(t)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(service)
+			}
+
+			cnt := NewGroupParticipantController(GroupParticipantControllerConfig{
+				Service:   service,
+				Validator: validator.NewValidator(),
+			})
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, groupDetailPath+groupParticipantOwnerPath, http.NoBody)
+			ctx := context.WithValue(
+				req.Context(),
+				httprouter.ParamsKey,
+				httprouter.Params{
+					{Key: "group_id", Value: "1"},
+					{Key: "user_id", Value: "2"},
+				},
+			)
+			req = req.WithContext(ctx)
+
+			cnt.transferOwnership(rec, req)
+			resp := rec.Result()
+
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedResponseBody, string(respBody))
+		})
+	}
+}
+
+func TestGroupParticipantController_approveJoinRequest(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		mockBehavior         func(s *MockGroupParticipantService)
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Successful",
+			mockBehavior: func(s *MockGroupParticipantService) {
+				s.On("ApproveJoinRequest", mock.Anything, 1, 2).Return(nil)
+			},
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name: "Approve without permission",
+			mockBehavior: func(s *MockGroupParticipantService) {
+				s.On("ApproveJoinRequest", mock.Anything, 1, 2).Return(entity.ErrForbiddenPerformAction)
+			},
+			expectedStatusCode:   http.StatusForbidden,
+			expectedResponseBody: `{"code":"CM0008","message":"it's forbidden to perform this action"}`,
+		},
+		{
+			name: "Join request is not found",
+			mockBehavior: func(s *MockGroupParticipantService) {
+				s.On("ApproveJoinRequest", mock.Anything, 1, 2).Return(entity.ErrGroupParticipantNotFound)
+			},
+			expectedStatusCode:   http.StatusNotFound,
+			expectedResponseBody: `{"code":"CH0006","message":"group participant is not found"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := NewMockGroupParticipantService(t)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(service)
+			}
+
+			cnt := NewGroupParticipantController(GroupParticipantControllerConfig{
+				Service:   service,
+				Validator: validator.NewValidator(),
+			})
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, groupDetailPath+groupJoinRequestApprovePath, http.NoBody)
+			ctx := context.WithValue(
+				req.Context(),
+				httprouter.ParamsKey,
+				httprouter.Params{
+					{Key: "group_id", Value: "1"},
+					{Key: "user_id", Value: "2"},
+				},
+			)
+			req = req.WithContext(ctx)
+
+			cnt.approveJoinRequest(rec, req)
+			resp := rec.Result()
+
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedResponseBody, string(respBody))
+		})
+	}
+}
+
+func TestGroupParticipantController_join(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		mockBehavior         func(s *MockGroupParticipantService)
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Successful",
+			mockBehavior: func(s *MockGroupParticipantService) {
+				s.On("Join", mock.Anything, "chatyx_fans").Return(entity.GroupParticipant{
+					GroupID: 1,
+					UserID:  2,
+					Role:    entity.MemberRole,
+					Status:  entity.JoinedStatus,
+				}, nil)
+			},
+			expectedStatusCode:   http.StatusCreated,
+			expectedResponseBody: `{"user_id":2,"status":"joined","role":"member"}`,
+		},
+		{
+			name: "Successful join request",
+			mockBehavior: func(s *MockGroupParticipantService) {
+				s.On("Join", mock.Anything, "chatyx_fans").Return(entity.GroupParticipant{
+					GroupID: 1,
+					UserID:  2,
+					Role:    entity.MemberRole,
+					Status:  entity.PendingStatus,
+				}, nil)
+			},
+			expectedStatusCode:   http.StatusAccepted,
+			expectedResponseBody: `{"user_id":2,"status":"pending","role":"member"}`,
+		},
+		{
+			name: "Group is not found",
+			mockBehavior: func(s *MockGroupParticipantService) {
+				s.On("Join", mock.Anything, "chatyx_fans").Return(entity.GroupParticipant{}, entity.ErrGroupNotFound)
+			},
+			expectedStatusCode:   http.StatusNotFound,
+			expectedResponseBody: `{"code":"CH0001","message":"group is not found"}`,
+		},
+		{
+			name: "Inactive invite link",
+			mockBehavior: func(s *MockGroupParticipantService) {
+				s.On("Join", mock.Anything, "chatyx_fans").Return(entity.GroupParticipant{}, entity.ErrInactiveGroupInviteLink)
+			},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"CH0014","message":"group invite link is revoked, expired or exhausted"}`,
+		},
+		{
+			name: "Already in the group",
+			mockBehavior: func(s *MockGroupParticipantService) {
+				s.On("Join", mock.Anything, "chatyx_fans").Return(entity.GroupParticipant{}, entity.ErrSuchGroupParticipantAlreadyExists)
+			},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"CH0008","message":"such a group participant already exists"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := NewMockGroupParticipantService(t)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(service)
+			}
+
+			cnt := NewGroupParticipantController(GroupParticipantControllerConfig{
+				Service:   service,
+				Validator: validator.NewValidator(),
+			})
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, groupJoinPath, http.NoBody)
+			ctx := context.WithValue(
+				req.Context(),
+				httprouter.ParamsKey,
+				httprouter.Params{
+					{Key: "code", Value: "chatyx_fans"},
+				},
+			)
+			req = req.WithContext(ctx)
+
+			cnt.join(rec, req)
+			resp := rec.Result()
+
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedResponseBody, string(respBody))
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/httputil"
+	"github.com/Chatyx/backend/pkg/httputil/middleware"
+	"github.com/Chatyx/backend/pkg/validator"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	channelSubscriptionPath = "/subscription"
+	channelAdminPath        = "/admins/:user_id"
+)
+
+type ChannelSubscriber struct {
+	UserID       int       `json:"user_id"`
+	IsAdmin      bool      `json:"is_admin"`
+	SubscribedAt time.Time `json:"subscribed_at"`
+}
+
+func NewChannelSubscriber(subscriber entity.ChannelSubscriber) ChannelSubscriber {
+	return ChannelSubscriber{
+		UserID:       subscriber.UserID,
+		IsAdmin:      subscriber.IsAdmin,
+		SubscribedAt: subscriber.SubscribedAt,
+	}
+}
+
+//go:generate mockery --inpackage --testonly --case underscore --name ChannelSubscriberService
+type ChannelSubscriberService interface {
+	Subscribe(ctx context.Context, channelID int) (entity.ChannelSubscriber, error)
+	Unsubscribe(ctx context.Context, channelID int) error
+	SetAdmin(ctx context.Context, channelID, userID int, isAdmin bool) error
+}
+
+type ChannelSubscriberControllerConfig struct {
+	Service   ChannelSubscriberService
+	Authorize middleware.Middleware
+	Validator validator.Validator
+}
+
+type ChannelSubscriberController struct {
+	service   ChannelSubscriberService
+	authorize middleware.Middleware
+	validator validator.Validator
+}
+
+func NewChannelSubscriberController(conf ChannelSubscriberControllerConfig) *ChannelSubscriberController {
+	return &ChannelSubscriberController{
+		service:   conf.Service,
+		authorize: conf.Authorize,
+		validator: conf.Validator,
+	}
+}
+
+func (sc *ChannelSubscriberController) Register(mux *httprouter.Router) {
+	mux.Handler(http.MethodPost, channelDetailPath+channelSubscriptionPath, sc.authorize(http.HandlerFunc(sc.subscribe)))
+	mux.Handler(http.MethodDelete, channelDetailPath+channelSubscriptionPath, sc.authorize(http.HandlerFunc(sc.unsubscribe)))
+	mux.Handler(http.MethodPut, channelDetailPath+channelAdminPath, sc.authorize(http.HandlerFunc(sc.grantAdmin)))
+	mux.Handler(http.MethodDelete, channelDetailPath+channelAdminPath, sc.authorize(http.HandlerFunc(sc.revokeAdmin)))
+}
+
+// subscribe subscribes the current user to a channel
+//
+//	@Summary		Subscribe the current user to a channel
+//	@Description	Subscribers can only read the channel.
+//	@Tags			channel-subscribers
+//	@Accept			json
+//	@Produce		json
+//	@Param			channel_id	path		int	true	"Channel identity"
+//	@Success		201			{object}	ChannelSubscriber
+//	@Failure		400			{object}	httputil.Error
+//	@Failure		404			{object}	httputil.Error
+//	@Failure		500			{object}	httputil.Error
+//	@Security		JWTAuth
+//	@Router			/channels/{channel_id}/subscription  [post]
+func (sc *ChannelSubscriberController) subscribe(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var channelID int
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.Path(channelIDParam, &channelID, nil); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	subscriber, err := sc.service.Subscribe(ctx, channelID)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrChannelNotFound):
+			httputil.RespondError(ctx, w, errChannelNotFound.Wrap(err))
+		case errors.Is(err, entity.ErrSuchChannelSubscriberAlreadyExists):
+			httputil.RespondError(ctx, w, errSuchChannelSubscriberAlreadyExists.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusCreated, NewChannelSubscriber(subscriber))
+}
+
+// unsubscribe unsubscribes the current user from a channel
+//
+//	@Summary		Unsubscribe the current user from a channel
+//	@Description	The last admin can't unsubscribe from the channel.
+//	@Tags			channel-subscribers
+//	@Accept			json
+//	@Produce		json
+//	@Param			channel_id	path	int	true	"Channel identity"
+//	@Success		204			"No Content"
+//	@Failure		400			{object}	httputil.Error
+//	@Failure		404			{object}	httputil.Error
+//	@Failure		500			{object}	httputil.Error
+//	@Security		JWTAuth
+//	@Router			/channels/{channel_id}/subscription  [delete]
+func (sc *ChannelSubscriberController) unsubscribe(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var channelID int
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.Path(channelIDParam, &channelID, nil); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	if err := sc.service.Unsubscribe(ctx, channelID); err != nil {
+		switch {
+		case errors.Is(err, entity.ErrChannelNotFound):
+			httputil.RespondError(ctx, w, errChannelNotFound.Wrap(err))
+		case errors.Is(err, entity.ErrLastChannelAdminLeaving):
+			httputil.RespondError(ctx, w, errLastChannelAdminLeaving.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusNoContent, nil)
+}
+
+// grantAdmin grants a specified subscriber rights to post to a channel
+//
+//	@Summary		Grant a specified subscriber rights to post to a channel
+//	@Description	Only admins can grant admin rights.
+//	@Tags			channel-subscribers
+//	@Accept			json
+//	@Produce		json
+//	@Param			channel_id	path	int	true	"Channel identity"
+//	@Param			user_id		path	int	true	"User identity"
+//	@Success		204			"No Content"
+//	@Failure		400			{object}	httputil.Error
+//	@Failure		403			{object}	httputil.Error
+//	@Failure		404			{object}	httputil.Error
+//	@Failure		500			{object}	httputil.Error
+//	@Security		JWTAuth
+//	@Router			/channels/{channel_id}/admins/{user_id}  [put]
+func (sc *ChannelSubscriberController) grantAdmin(w http.ResponseWriter, req *http.Request) {
+	sc.setAdmin(w, req, true)
+}
+
+// revokeAdmin revokes rights of a specified subscriber to post to a channel
+//
+//	@Summary		Revoke rights of a specified subscriber to post to a channel
+//	@Description	Only admins can revoke admin rights, the last admin can't be revoked.
+//	@Tags			channel-subscribers
+//	@Accept			json
+//	@Produce		json
+//	@Param			channel_id	path	int	true	"Channel identity"
+//	@Param			user_id		path	int	true	"User identity"
+//	@Success		204			"No Content"
+//	@Failure		400			{object}	httputil.Error
+//	@Failure		403			{object}	httputil.Error
+//	@Failure		404			{object}	httputil.Error
+//	@Failure		500			{object}	httputil.Error
+//	@Security		JWTAuth
+//	@Router			/channels/{channel_id}/admins/{user_id}  [delete]
+func (sc *ChannelSubscriberController) revokeAdmin(w http.ResponseWriter, req *http.Request) {
+	sc.setAdmin(w, req, false)
+}
+
+func (sc *ChannelSubscriberController) setAdmin(w http.ResponseWriter, req *http.Request, isAdmin bool) {
+	ctx := req.Context()
+
+	var (
+		channelID int
+		userID    int
+	)
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.MergeResults(
+		dec.Path(channelIDParam, &channelID, nil),
+		dec.Path(userIDParam, &userID, nil),
+	); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	if err := sc.service.SetAdmin(ctx, channelID, userID, isAdmin); err != nil {
+		switch {
+		case errors.Is(err, entity.ErrChannelNotFound):
+			httputil.RespondError(ctx, w, errChannelNotFound.Wrap(err))
+		case errors.Is(err, entity.ErrChannelSubscriberNotFound):
+			httputil.RespondError(ctx, w, errChannelSubscriberNotFound.Wrap(err))
+		case errors.Is(err, entity.ErrLastChannelAdminLeaving):
+			httputil.RespondError(ctx, w, errLastChannelAdminLeaving.Wrap(err))
+		case errors.Is(err, entity.ErrForbiddenPerformAction):
+			httputil.RespondError(ctx, w, httputil.ErrForbiddenPerformAction.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusNoContent, nil)
+}
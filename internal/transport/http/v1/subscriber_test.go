@@ -0,0 +1,217 @@
+package v1
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Chatyx/backend/internal/entity"
+	"github.com/Chatyx/backend/pkg/validator"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestChannelSubscriberController_subscribe(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		mockBehavior         func(s *MockChannelSubscriberService)
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Successful",
+			mockBehavior: func(s *MockChannelSubscriberService) {
+				s.On("Subscribe", mock.Anything, 1).Return(entity.ChannelSubscriber{
+					ChannelID:    1,
+					UserID:       2,
+					SubscribedAt: defaultCreatedAt,
+				}, nil)
+			},
+			expectedStatusCode:   http.StatusCreated,
+			expectedResponseBody: `{"user_id":2,"is_admin":false,"subscribed_at":"2024-01-23T00:00:00Z"}`,
+		},
+		{
+			name: "Channel is not found",
+			mockBehavior: func(s *MockChannelSubscriberService) {
+				s.On("Subscribe", mock.Anything, 1).Return(entity.ChannelSubscriber{}, entity.ErrChannelNotFound)
+			},
+			expectedStatusCode:   http.StatusNotFound,
+			expectedResponseBody: `{"code":"CH0017","message":"channel is not found"}`,
+		},
+		{
+			name: "Already subscribed",
+			mockBehavior: func(s *MockChannelSubscriberService) {
+				s.On("Subscribe", mock.Anything, 1).Return(entity.ChannelSubscriber{}, entity.ErrSuchChannelSubscriberAlreadyExists)
+			},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"CH0019","message":"such a channel subscriber already exists"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := NewMockChannelSubscriberService(t)
+			testCase.mockBehavior(service)
+
+			cnt := NewChannelSubscriberController(ChannelSubscriberControllerConfig{
+				Service:   service,
+				Validator: validator.NewValidator(),
+			})
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, channelDetailPath+channelSubscriptionPath, nil)
+			ctx := context.WithValue(
+				req.Context(),
+				httprouter.ParamsKey,
+				httprouter.Params{{Key: "channel_id", Value: "1"}},
+			)
+			req = req.WithContext(ctx)
+
+			cnt.subscribe(rec, req)
+			resp := rec.Result()
+
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedResponseBody, string(respBody))
+		})
+	}
+}
+
+func TestChannelSubscriberController_unsubscribe(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		mockBehavior         func(s *MockChannelSubscriberService)
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Successful",
+			mockBehavior: func(s *MockChannelSubscriberService) {
+				s.On("Unsubscribe", mock.Anything, 1).Return(nil)
+			},
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name: "Last admin",
+			mockBehavior: func(s *MockChannelSubscriberService) {
+				s.On("Unsubscribe", mock.Anything, 1).Return(entity.ErrLastChannelAdminLeaving)
+			},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"CH0021","message":"the last channel admin can't unsubscribe or be revoked"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := NewMockChannelSubscriberService(t)
+			testCase.mockBehavior(service)
+
+			cnt := NewChannelSubscriberController(ChannelSubscriberControllerConfig{
+				Service:   service,
+				Validator: validator.NewValidator(),
+			})
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodDelete, channelDetailPath+channelSubscriptionPath, nil)
+			ctx := context.WithValue(
+				req.Context(),
+				httprouter.ParamsKey,
+				httprouter.Params{{Key: "channel_id", Value: "1"}},
+			)
+			req = req.WithContext(ctx)
+
+			cnt.unsubscribe(rec, req)
+			resp := rec.Result()
+
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedResponseBody, string(respBody))
+		})
+	}
+}
+
+func TestChannelSubscriberController_setAdmin(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		isAdmin              bool
+		mockBehavior         func(s *MockChannelSubscriberService)
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:    "Successful grant",
+			isAdmin: true,
+			mockBehavior: func(s *MockChannelSubscriberService) {
+				s.On("SetAdmin", mock.Anything, 1, 2, true).Return(nil)
+			},
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name:    "Successful revoke",
+			isAdmin: false,
+			mockBehavior: func(s *MockChannelSubscriberService) {
+				s.On("SetAdmin", mock.Anything, 1, 2, false).Return(nil)
+			},
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name:    "Grant without admin rights",
+			isAdmin: true,
+			mockBehavior: func(s *MockChannelSubscriberService) {
+				s.On("SetAdmin", mock.Anything, 1, 2, true).Return(entity.ErrForbiddenPerformAction)
+			},
+			expectedStatusCode:   http.StatusForbidden,
+			expectedResponseBody: `{"code":"CM0008","message":"it's forbidden to perform this action"}`,
+		},
+		{
+			name:    "Subscriber is not found",
+			isAdmin: true,
+			mockBehavior: func(s *MockChannelSubscriberService) {
+				s.On("SetAdmin", mock.Anything, 1, 2, true).Return(entity.ErrChannelSubscriberNotFound)
+			},
+			expectedStatusCode:   http.StatusNotFound,
+			expectedResponseBody: `{"code":"CH0018","message":"channel subscriber is not found"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := NewMockChannelSubscriberService(t)
+			testCase.mockBehavior(service)
+
+			cnt := NewChannelSubscriberController(ChannelSubscriberControllerConfig{
+				Service:   service,
+				Validator: validator.NewValidator(),
+			})
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPut, channelDetailPath+channelAdminPath, nil)
+			ctx := context.WithValue(
+				req.Context(),
+				httprouter.ParamsKey,
+				httprouter.Params{
+					{Key: "channel_id", Value: "1"},
+					{Key: "user_id", Value: "2"},
+				},
+			)
+			req = req.WithContext(ctx)
+
+			cnt.setAdmin(rec, req, testCase.isAdmin)
+			resp := rec.Result()
+
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedResponseBody, string(respBody))
+		})
+	}
+}
@@ -2,8 +2,11 @@ package v1
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/Chatyx/backend/internal/dto"
@@ -20,20 +23,23 @@ const (
 	userListPath   = "/api/v1/users"
 	userDetailPath = "/api/v1/users/:user_id"
 	userMePath     = "/api/v1/users/me"
+	userVerifyPath = "/api/v1/users/verify-email"
 )
 
 const (
 	userIDParam = "user_id"
+	searchParam = "search"
 )
 
 type User struct {
-	ID        int    `json:"id"`
-	Username  string `json:"username"`
-	Email     string `json:"email"`
-	FirstName string `json:"first_name,omitempty"`
-	LastName  string `json:"last_name,omitempty"`
-	BirthDate string `json:"birth_date,omitempty"`
-	Bio       string `json:"bio,omitempty"`
+	ID        int          `json:"id"`
+	Username  string       `json:"username"`
+	Email     string       `json:"email"`
+	FirstName string       `json:"first_name,omitempty"`
+	LastName  string       `json:"last_name,omitempty"`
+	BirthDate string       `json:"birth_date,omitempty"`
+	Bio       string       `json:"bio,omitempty"`
+	Contact   *UserContact `json:"contact,omitempty"`
 }
 
 func NewUser(user entity.User) User {
@@ -52,21 +58,59 @@ func NewUser(user entity.User) User {
 	return res
 }
 
+// UserContact is shown in the profile of the user who is in contacts of the current user.
+type UserContact struct {
+	DisplayName string `json:"display_name,omitempty"`
+	IsMutual    bool   `json:"is_mutual"`
+}
+
+func NewUserContact(contact entity.Contact) *UserContact {
+	return &UserContact{
+		DisplayName: contact.DisplayName,
+		IsMutual:    contact.IsMutual,
+	}
+}
+
 type UserList struct {
 	Total int    `json:"total"`
 	Data  []User `json:"data"`
+	// NextCursor is set if there may be more users after the listed ones.
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
-func NewUserList(users []entity.User) UserList {
+func NewUserList(users []entity.User, limit int) UserList {
 	data := make([]User, len(users))
 	for i, user := range users {
 		data[i] = NewUser(user)
 	}
 
-	return UserList{
+	list := UserList{
 		Total: len(users),
 		Data:  data,
 	}
+	if len(users) != 0 && len(users) == limit {
+		list.NextCursor = encodeUserListCursor(users[len(users)-1].Username)
+	}
+
+	return list
+}
+
+// encodeUserListCursor encodes the username of the last listed user into an opaque string,
+// so clients don't depend on what the user list is sorted by.
+func encodeUserListCursor(username string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(username))
+}
+
+func decodeUserListCursor(s string) (string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", errInvalidCursor, err)
+	}
+
+	if len(raw) == 0 {
+		return "", errInvalidCursor
+	}
+	return string(raw), nil
 }
 
 type UserUpdate struct {
@@ -116,6 +160,10 @@ func (u UserCreate) DTO() dto.UserCreate {
 	return obj
 }
 
+type UserVerifyEmail struct {
+	Token string `json:"token" validate:"required,max=64"`
+}
+
 type UserUpdatePassword struct {
 	New     string `json:"new_password"     validate:"required,min=8,max=27"`
 	Current string `json:"current_password" validate:"required,min=8,max=27"`
@@ -123,76 +171,138 @@ type UserUpdatePassword struct {
 
 //go:generate mockery --inpackage --testonly --case underscore --name UserService
 type UserService interface {
-	List(ctx context.Context) ([]entity.User, error)
+	List(ctx context.Context, obj dto.UserList) ([]entity.User, error)
 	Create(ctx context.Context, obj dto.UserCreate) (entity.User, error)
 	GetByID(ctx context.Context, id int) (entity.User, error)
 	Update(ctx context.Context, obj dto.UserUpdate) (entity.User, error)
 	UpdatePassword(ctx context.Context, obj dto.UserUpdatePassword) error
+	VerifyEmail(ctx context.Context, token string) error
+	ResendVerification(ctx context.Context, userID int) error
 	Delete(ctx context.Context, id int) error
 }
 
+//go:generate mockery --inpackage --testonly --case underscore --name UserContactService
+type UserContactService interface {
+	Get(ctx context.Context, contactUserID int) (entity.Contact, error)
+}
+
 type UserControllerConfig struct {
-	Service   UserService
-	Authorize middleware.Middleware
-	Validator validator.Validator
+	Service        UserService
+	ContactService UserContactService
+	Authorize      middleware.Middleware
+	Validator      validator.Validator
 }
 
 type UserController struct {
-	service   UserService
-	authorize middleware.Middleware
-	validator validator.Validator
+	service        UserService
+	contactService UserContactService
+	authorize      middleware.Middleware
+	validator      validator.Validator
 }
 
 func NewUserController(conf UserControllerConfig) *UserController {
 	return &UserController{
-		service:   conf.Service,
-		authorize: conf.Authorize,
-		validator: conf.Validator,
+		service:        conf.Service,
+		contactService: conf.ContactService,
+		authorize:      conf.Authorize,
+		validator:      conf.Validator,
 	}
 }
 
 func (uc *UserController) Register(mux *httprouter.Router) {
 	mux.Handler(http.MethodGet, userListPath, uc.authorize(http.HandlerFunc(uc.list)))
 	mux.HandlerFunc(http.MethodPost, userListPath, uc.create)
+	mux.HandlerFunc(http.MethodPost, userVerifyPath, uc.verifyEmail)
 	mux.Handler(http.MethodGet, userDetailPath, uc.authorize(http.HandlerFunc(uc.detail)))
 	mux.Handler(http.MethodPut, userMePath, uc.authorize(http.HandlerFunc(uc.update)))
 	mux.Handler(http.MethodPut, userMePath+"/password", uc.authorize(http.HandlerFunc(uc.updatePassword)))
+	mux.Handler(http.MethodPost, userMePath+"/verify-email/resend", uc.authorize(http.HandlerFunc(uc.resendVerification)))
 	mux.Handler(http.MethodDelete, userMePath, uc.authorize(http.HandlerFunc(uc.delete)))
 }
 
-// list lists all existing users
+// list lists existing users
 //
-//	@Summary	List all existing users
-//	@Tags		users
-//	@Accept		json
-//	@Produce	json
-//	@Success	200	{object}	UserList
-//	@Failure	500	{object}	httputil.Error
-//	@Security	JWTAuth
-//	@Router		/users  [get]
+//	@Summary		List existing users
+//	@Description	Users are sorted by their usernames, the next page is requested by the cursor of the previous one.
+//	@Tags			users
+//	@Accept			json
+//	@Produce		json
+//	@Param			search	query		string	false	"Search users by username prefix or by first and last name"
+//	@Param			cursor	query		string	false	"Cursor of the next page returned with the previous one"
+//	@Param			limit	query		int		false	"Number of items to list per page (default: 20, max: 100)"
+//	@Success		200		{object}	UserList
+//	@Failure		400		{object}	httputil.Error
+//	@Failure		500		{object}	httputil.Error
+//	@Security		JWTAuth
+//	@Router			/users  [get]
 func (uc *UserController) list(w http.ResponseWriter, req *http.Request) {
 	ctx := req.Context()
 
-	users, err := uc.service.List(ctx)
+	var (
+		search string
+		cursor string
+		limit  int
+	)
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.MergeResults(
+		dec.Query(searchParam, &search, ""),
+		dec.Query(cursorParam, &cursor, ""),
+		dec.Query(limitParam, &limit, defaultLimit),
+	); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	if err := validator.MergeResults(
+		uc.validator.Var(search, searchParam, "max=100"),
+		uc.validator.Var(limit, limitParam, "gt=0,max=100"),
+	); err != nil {
+		ve := validator.Error{}
+		if errors.As(err, &ve) {
+			httputil.RespondError(ctx, w, httputil.ErrValidationFailed.WithData(ve.Fields).Wrap(err))
+			return
+		}
+
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	obj := dto.UserList{
+		Search: strings.TrimSpace(search),
+		Limit:  limit,
+	}
+	if cursor != "" {
+		username, err := decodeUserListCursor(cursor)
+		if err != nil {
+			httputil.RespondError(ctx, w, httputil.ErrValidationFailed.
+				WithData(map[string]string{cursorParam: errInvalidCursor.Error()}).Wrap(err))
+			return
+		}
+		obj.UsernameAfter = username
+	}
+
+	users, err := uc.service.List(ctx, obj)
 	if err != nil {
 		httputil.RespondError(ctx, w, err)
 		return
 	}
 
-	httputil.RespondSuccess(ctx, w, http.StatusOK, NewUserList(users))
+	httputil.RespondSuccess(ctx, w, http.StatusOK, NewUserList(users, limit))
 }
 
 // create creates a new user
 //
-//	@Summary	Create a new user
-//	@Tags		users
-//	@Accept		json
-//	@Produce	json
-//	@Param		input	body		UserCreate	true	"Body to create"
-//	@Success	201		{object}	User
-//	@Failure	400		{object}	httputil.Error
-//	@Failure	500		{object}	httputil.Error
-//	@Router		/users [post]
+//	@Summary		Create a new user
+//	@Description	A verification token is sent to the email of the user.
+//	@Tags			users
+//	@Accept			json
+//	@Produce		json
+//	@Param			input	body		UserCreate	true	"Body to create"
+//	@Success		201		{object}	User
+//	@Failure		400		{object}	httputil.Error
+//	@Failure		500		{object}	httputil.Error
+//	@Router			/users [post]
 func (uc *UserController) create(w http.ResponseWriter, req *http.Request) {
 	ctx := req.Context()
 
@@ -230,19 +340,101 @@ func (uc *UserController) create(w http.ResponseWriter, req *http.Request) {
 	httputil.RespondSuccess(ctx, w, http.StatusCreated, NewUser(user))
 }
 
+// verifyEmail verifies the email of a user
+//
+//	@Summary		Verify the email of a user
+//	@Description	The token is sent to the email on signup and on the email change.
+//	@Tags			users
+//	@Accept			json
+//	@Produce		json
+//	@Param			input	body	UserVerifyEmail	true	"Body to verify"
+//	@Success		204		"No Content"
+//	@Failure		400		{object}	httputil.Error
+//	@Failure		500		{object}	httputil.Error
+//	@Router			/users/verify-email [post]
+func (uc *UserController) verifyEmail(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var bodyObj UserVerifyEmail
+
+	dec := httputil.NewRequestDecoder(req)
+	if err := dec.Body(&bodyObj); err != nil {
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	if err := uc.validator.Struct(bodyObj); err != nil {
+		ve := validator.Error{}
+		if errors.As(err, &ve) {
+			httputil.RespondError(ctx, w, httputil.ErrValidationFailed.WithData(ve.Fields).Wrap(err))
+			return
+		}
+
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	if err := uc.service.VerifyEmail(ctx, bodyObj.Token); err != nil {
+		switch {
+		case errors.Is(err, entity.ErrInvalidVerificationToken):
+			httputil.RespondError(ctx, w, errInvalidVerificationToken.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusNoContent, nil)
+}
+
+// resendVerification sends a new verification token to the email of the current authenticated user
+//
+//	@Summary		Send a new verification token to the email of the current authenticated user
+//	@Description	Previous tokens of the user become invalid.
+//	@Tags			users
+//	@Accept			json
+//	@Produce		json
+//	@Success		204	"No Content"
+//	@Failure		403	{object}	httputil.Error
+//	@Failure		404	{object}	httputil.Error
+//	@Failure		500	{object}	httputil.Error
+//	@Security		JWTAuth
+//	@Router			/users/me/verify-email/resend [post]
+func (uc *UserController) resendVerification(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	userID := ctxutil.UserIDFromContext(ctx).ToInt()
+
+	if err := uc.service.ResendVerification(ctx, userID); err != nil {
+		switch {
+		case errors.Is(err, entity.ErrUserNotFound):
+			httputil.RespondError(ctx, w, errUserNotFound.Wrap(err))
+		case errors.Is(err, entity.ErrForbiddenPerformAction):
+			httputil.RespondError(ctx, w, httputil.ErrForbiddenPerformAction.Wrap(err))
+		default:
+			httputil.RespondError(ctx, w, err)
+		}
+
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusNoContent, nil)
+}
+
 // detail gets a specified user
 //
-//	@Summary	Get a specified user
-//	@Tags		users
-//	@Accept		json
-//	@Produce	json
-//	@Param		user_id	path		int	true	"User identity"
-//	@Success	200		{object}	User
-//	@Failure	400		{object}	httputil.Error
-//	@Failure	404		{object}	httputil.Error
-//	@Failure	500		{object}	httputil.Error
-//	@Security	JWTAuth
-//	@Router		/users/{user_id} [get]
+//	@Summary		Get a specified user
+//	@Description	If the user is in contacts of the current user, the contact info is returned as well.
+//	@Tags			users
+//	@Accept			json
+//	@Produce		json
+//	@Param			user_id	path		int	true	"User identity"
+//	@Success		200		{object}	User
+//	@Failure		400		{object}	httputil.Error
+//	@Failure		404		{object}	httputil.Error
+//	@Failure		500		{object}	httputil.Error
+//	@Security		JWTAuth
+//	@Router			/users/{user_id} [get]
 func (uc *UserController) detail(w http.ResponseWriter, req *http.Request) {
 	ctx := req.Context()
 
@@ -266,7 +458,18 @@ func (uc *UserController) detail(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	httputil.RespondSuccess(ctx, w, http.StatusOK, NewUser(user))
+	res := NewUser(user)
+
+	contact, err := uc.contactService.Get(ctx, userID)
+	switch {
+	case err == nil:
+		res.Contact = NewUserContact(contact)
+	case !errors.Is(err, entity.ErrContactNotFound):
+		httputil.RespondError(ctx, w, err)
+		return
+	}
+
+	httputil.RespondSuccess(ctx, w, http.StatusOK, res)
 }
 
 // update updates information about the current authenticated user
@@ -33,6 +33,7 @@ var (
 func TestUserController_list(t *testing.T) {
 	testCases := []struct {
 		name                 string
+		requestQuery         string
 		mockBehavior         func(s *MockUserService)
 		expectedStatusCode   int
 		expectedResponseBody string
@@ -40,7 +41,7 @@ func TestUserController_list(t *testing.T) {
 		{
 			name: "Successful",
 			mockBehavior: func(s *MockUserService) {
-				s.On("List", mock.Anything).Return([]entity.User{
+				s.On("List", mock.Anything, dto.UserList{Limit: 20}).Return([]entity.User{
 					{
 						ID:        1,
 						Username:  "john1967",
@@ -63,17 +64,55 @@ func TestUserController_list(t *testing.T) {
 			expectedResponseBody: `{"total":2,"data":[{"id":1,"username":"john1967","email":"john1967@gmail.com","first_name":"John","last_name":"Lennon","birth_date":"1940-10-09","bio":"..."},{"id":2,"username":"mick49","email":"mick49@gmail.com"}]}`,
 		},
 		{
-			name: "Successful with empty list",
+			name:         "Successful with search and next cursor",
+			requestQuery: "?search=+jo+&limit=1",
 			mockBehavior: func(s *MockUserService) {
-				s.On("List", mock.Anything).Return(nil, nil)
+				s.On("List", mock.Anything, dto.UserList{Search: "jo", Limit: 1}).Return([]entity.User{
+					{
+						ID:        1,
+						Username:  "john1967",
+						Email:     "john1967@gmail.com",
+						CreatedAt: defaultCreatedAt,
+					},
+				}, nil)
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"total":1,"data":[{"id":1,"username":"john1967","email":"john1967@gmail.com"}],"next_cursor":"am9objE5Njc"}`,
+		},
+		{
+			name:         "Successful with cursor",
+			requestQuery: "?cursor=am9objE5Njc",
+			mockBehavior: func(s *MockUserService) {
+				s.On("List", mock.Anything, dto.UserList{UsernameAfter: "john1967", Limit: 20}).Return(nil, nil)
 			},
 			expectedStatusCode:   http.StatusOK,
 			expectedResponseBody: `{"total":0,"data":[]}`,
 		},
+		{
+			name:         "Successful with empty list",
+			requestQuery: "?search=nobody",
+			mockBehavior: func(s *MockUserService) {
+				s.On("List", mock.Anything, dto.UserList{Search: "nobody", Limit: 20}).Return(nil, nil)
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"total":0,"data":[]}`,
+		},
+		{
+			name:                 "Invalid cursor",
+			requestQuery:         "?cursor=!!!",
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"CM0006","message":"validation error","data":{"cursor":"invalid cursor"}}`,
+		},
+		{
+			name:                 "Validation error",
+			requestQuery:         "?limit=101",
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"CM0006","message":"validation error","data":{"limit":"failed on the 'max' tag"}}`,
+		},
 		{
 			name: "Internal server error",
 			mockBehavior: func(s *MockUserService) {
-				s.On("List", mock.Anything).Return(nil, errUnexpected)
+				s.On("List", mock.Anything, mock.Anything).Return(nil, errUnexpected)
 			},
 			expectedStatusCode:   http.StatusInternalServerError,
 			expectedResponseBody: `{"code":"CM0001","message":"internal server error"}`,
@@ -93,7 +132,7 @@ func TestUserController_list(t *testing.T) {
 			})
 
 			rec := httptest.NewRecorder()
-			req := httptest.NewRequest(http.MethodGet, userListPath, nil)
+			req := httptest.NewRequest(http.MethodGet, userListPath+testCase.requestQuery, nil)
 
 			cnt.list(rec, req)
 			resp := rec.Result()
@@ -248,20 +287,21 @@ func TestUserController_detail(t *testing.T) {
 	testCases := []struct {
 		name                 string
 		userIDPathParam      string
-		mockBehavior         func(s *MockUserService)
+		mockBehavior         func(s *MockUserService, cs *MockUserContactService)
 		expectedStatusCode   int
 		expectedResponseBody string
 	}{
 		{
 			name:            "Success with required fields",
 			userIDPathParam: "1",
-			mockBehavior: func(s *MockUserService) {
+			mockBehavior: func(s *MockUserService, cs *MockUserContactService) {
 				s.On("GetByID", mock.Anything, 1).Return(entity.User{
 					ID:        1,
 					Username:  "mick49",
 					Email:     "mick49@gmail.com",
 					CreatedAt: defaultCreatedAt,
 				}, nil)
+				cs.On("Get", mock.Anything, 1).Return(entity.Contact{}, entity.ErrContactNotFound)
 			},
 			expectedStatusCode:   http.StatusOK,
 			expectedResponseBody: `{"id":1,"username":"mick49","email":"mick49@gmail.com"}`,
@@ -269,7 +309,7 @@ func TestUserController_detail(t *testing.T) {
 		{
 			name:            "Success with all fields",
 			userIDPathParam: "1",
-			mockBehavior: func(s *MockUserService) {
+			mockBehavior: func(s *MockUserService, cs *MockUserContactService) {
 				s.On("GetByID", mock.Anything, 1).Return(entity.User{
 					ID:        1,
 					Username:  "john1967",
@@ -280,10 +320,32 @@ func TestUserController_detail(t *testing.T) {
 					Bio:       "...",
 					CreatedAt: defaultCreatedAt,
 				}, nil)
+				cs.On("Get", mock.Anything, 1).Return(entity.Contact{}, entity.ErrContactNotFound)
 			},
 			expectedStatusCode:   http.StatusOK,
 			expectedResponseBody: `{"id":1,"username":"john1967","email":"john1967@gmail.com","first_name":"John","last_name":"Lennon","birth_date":"1940-10-09","bio":"..."}`,
 		},
+		{
+			name:            "Success with contact info",
+			userIDPathParam: "1",
+			mockBehavior: func(s *MockUserService, cs *MockUserContactService) {
+				s.On("GetByID", mock.Anything, 1).Return(entity.User{
+					ID:        1,
+					Username:  "mick49",
+					Email:     "mick49@gmail.com",
+					CreatedAt: defaultCreatedAt,
+				}, nil)
+				cs.On("Get", mock.Anything, 1).Return(entity.Contact{
+					UserID:        2,
+					ContactUserID: 1,
+					DisplayName:   "Mick",
+					IsMutual:      true,
+					CreatedAt:     defaultCreatedAt,
+				}, nil)
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"id":1,"username":"mick49","email":"mick49@gmail.com","contact":{"display_name":"Mick","is_mutual":true}}`,
+		},
 		{
 			name:                 "Decode path param error",
 			userIDPathParam:      uuid.New().String(),
@@ -293,7 +355,7 @@ func TestUserController_detail(t *testing.T) {
 		{
 			name:            "User is not found",
 			userIDPathParam: "1",
-			mockBehavior: func(s *MockUserService) {
+			mockBehavior: func(s *MockUserService, cs *MockUserContactService) {
 				s.On("GetByID", mock.Anything, 1).Return(entity.User{}, entity.ErrUserNotFound)
 			},
 			expectedStatusCode:   http.StatusNotFound,
@@ -302,7 +364,7 @@ func TestUserController_detail(t *testing.T) {
 		{
 			name:            "Internal server error",
 			userIDPathParam: "1",
-			mockBehavior: func(s *MockUserService) {
+			mockBehavior: func(s *MockUserService, cs *MockUserContactService) {
 				s.On("GetByID", mock.Anything, 1).Return(entity.User{}, errUnexpected)
 			},
 			expectedStatusCode:   http.StatusInternalServerError,
@@ -313,13 +375,15 @@ func TestUserController_detail(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			service := NewMockUserService(t)
+			contactService := NewMockUserContactService(t)
 			if testCase.mockBehavior != nil {
-				testCase.mockBehavior(service)
+				testCase.mockBehavior(service, contactService)
 			}
 
 			cnt := NewUserController(UserControllerConfig{
-				Service:   service,
-				Validator: validator.NewValidator(),
+				Service:        service,
+				ContactService: contactService,
+				Validator:      validator.NewValidator(),
 			})
 
 			rec := httptest.NewRecorder()
@@ -651,3 +715,124 @@ func TestUserController_delete(t *testing.T) {
 		})
 	}
 }
+
+func TestUserController_verifyEmail(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		requestBody          string
+		mockBehavior         func(s *MockUserService)
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:        "Successful",
+			requestBody: `{"token":"0a1b2c3d"}`,
+			mockBehavior: func(s *MockUserService) {
+				s.On("VerifyEmail", mock.Anything, "0a1b2c3d").Return(nil)
+			},
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name:                 "Validation error",
+			requestBody:          `{}`,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"CM0006","message":"validation error","data":{"token":"failed on the 'required' tag"}}`,
+		},
+		{
+			name:        "Invalid token",
+			requestBody: `{"token":"0a1b2c3d"}`,
+			mockBehavior: func(s *MockUserService) {
+				s.On("VerifyEmail", mock.Anything, "0a1b2c3d").Return(entity.ErrInvalidVerificationToken)
+			},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"code":"US0014","message":"email verification token is invalid or expired"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := NewMockUserService(t)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(service)
+			}
+
+			cnt := NewUserController(UserControllerConfig{
+				Service:   service,
+				Validator: validator.NewValidator(),
+			})
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, userVerifyPath, strings.NewReader(testCase.requestBody))
+
+			cnt.verifyEmail(rec, req)
+			resp := rec.Result()
+
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedResponseBody, string(respBody))
+		})
+	}
+}
+
+func TestUserController_resendVerification(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		mockBehavior         func(s *MockUserService)
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Successful",
+			mockBehavior: func(s *MockUserService) {
+				s.On("ResendVerification", mock.Anything, 1).Return(nil)
+			},
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name: "Email is already verified",
+			mockBehavior: func(s *MockUserService) {
+				s.On("ResendVerification", mock.Anything, 1).Return(entity.ErrForbiddenPerformAction)
+			},
+			expectedStatusCode:   http.StatusForbidden,
+			expectedResponseBody: `{"code":"CM0008","message":"it's forbidden to perform this action"}`,
+		},
+		{
+			name: "Internal server error",
+			mockBehavior: func(s *MockUserService) {
+				s.On("ResendVerification", mock.Anything, 1).Return(errUnexpected)
+			},
+			expectedStatusCode:   http.StatusInternalServerError,
+			expectedResponseBody: `{"code":"CM0001","message":"internal server error"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := NewMockUserService(t)
+			if testCase.mockBehavior != nil {
+				testCase.mockBehavior(service)
+			}
+
+			cnt := NewUserController(UserControllerConfig{
+				Service:   service,
+				Validator: validator.NewValidator(),
+			})
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, userMePath+"/verify-email/resend", nil)
+			ctx := ctxutil.WithUserID(req.Context(), "1")
+			req = req.WithContext(ctx)
+
+			cnt.resendVerification(rec, req)
+			resp := rec.Result()
+
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedResponseBody, string(respBody))
+		})
+	}
+}
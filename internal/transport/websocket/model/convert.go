@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	"github.com/Chatyx/backend/internal/dto"
 	"github.com/Chatyx/backend/internal/entity"
 
@@ -19,6 +21,8 @@ func NewMessageFromEntity(message entity.Message) *Message {
 		chatType = ChatType_DIALOG
 	case entity.GroupChatType:
 		chatType = ChatType_GROUP
+	case entity.ChannelChatType:
+		chatType = ChatType_CHANNEL
 	}
 
 	var contentType ContentType
@@ -29,16 +33,33 @@ func NewMessageFromEntity(message entity.Message) *Message {
 		contentType = ContentType_IMAGE
 	}
 
+	var serviceAction *ServiceAction
+	if message.ServiceAction != nil {
+		serviceAction = &ServiceAction{
+			Type:   message.ServiceAction.Type.String(),
+			Params: message.ServiceAction.Params,
+		}
+	}
+
+	var forwardedFromID *int64
+	if message.ForwardedFromID != nil {
+		id := int64(*message.ForwardedFromID)
+		forwardedFromID = &id
+	}
+
 	return &Message{
-		Id:          int64(message.ID),
-		ChatId:      int64(message.ChatID.ID),
-		ChatType:    chatType,
-		SenderId:    int64(message.SenderID),
-		Content:     message.Content,
-		ContentType: contentType,
-		IsService:   message.IsService,
-		SentAt:      timestamppb.New(message.SentAt),
-		Delivered:   deliveredAt,
+		Id:              int64(message.ID),
+		ChatId:          int64(message.ChatID.ID),
+		ChatType:        chatType,
+		SenderId:        int64(message.SenderID),
+		Content:         message.Content,
+		ContentType:     contentType,
+		IsService:       message.IsService,
+		SentAt:          timestamppb.New(message.SentAt),
+		Delivered:       deliveredAt,
+		ServiceAction:   serviceAction,
+		ForwardedFromId: forwardedFromID,
+		Muted:           message.Muted,
 	}
 }
 
@@ -50,6 +71,8 @@ func (x *MessageCreate) DTO() dto.MessageCreate {
 		chatType = entity.DialogChatType
 	case ChatType_GROUP:
 		chatType = entity.GroupChatType
+	case ChatType_CHANNEL:
+		chatType = entity.ChannelChatType
 	}
 
 	return dto.MessageCreate{
@@ -61,3 +84,132 @@ func (x *MessageCreate) DTO() dto.MessageCreate {
 		ContentType: entity.TextContentType,
 	}
 }
+
+func (x *Message) Entity() entity.Message {
+	var deliveredAt *time.Time
+	if x.Delivered != nil {
+		t := x.Delivered.AsTime()
+		deliveredAt = &t
+	}
+
+	var contentType entity.ContentType
+	switch x.ContentType {
+	case ContentType_TEXT:
+		contentType = entity.TextContentType
+	case ContentType_IMAGE:
+		contentType = entity.ImageContentType
+	}
+
+	var serviceAction *entity.ServiceAction
+	if x.ServiceAction != nil {
+		serviceAction = &entity.ServiceAction{
+			Type:   entity.ServiceActionType(x.ServiceAction.Type),
+			Params: x.ServiceAction.Params,
+		}
+	}
+
+	var forwardedFromID *int
+	if x.ForwardedFromId != nil {
+		id := int(*x.ForwardedFromId)
+		forwardedFromID = &id
+	}
+
+	return entity.Message{
+		ID: int(x.Id),
+		ChatID: entity.ChatID{
+			ID:   int(x.ChatId),
+			Type: x.ChatType.entity(),
+		},
+		SenderID:        int(x.SenderId),
+		Content:         x.Content,
+		ContentType:     contentType,
+		IsService:       x.IsService,
+		ServiceAction:   serviceAction,
+		ForwardedFromID: forwardedFromID,
+		SentAt:          x.SentAt.AsTime(),
+		DeliveredAt:     deliveredAt,
+		Muted:           x.Muted,
+	}
+}
+
+func NewParticipantEventFromEntity(event entity.ParticipantEvent) *ParticipantEvent {
+	var eventType ParticipantEventType
+	switch event.Type {
+	case entity.AddedParticipant:
+		eventType = ParticipantEventType_ADDED
+	case entity.RemovedParticipant:
+		eventType = ParticipantEventType_REMOVED
+	case entity.JoinRequested:
+		eventType = ParticipantEventType_JOIN_REQUESTED
+	case entity.RestrictedParticipant:
+		eventType = ParticipantEventType_RESTRICTED
+	case entity.ChatUpdated:
+		eventType = ParticipantEventType_CHAT_UPDATED
+	case entity.ChatSettingsUpdated:
+		eventType = ParticipantEventType_CHAT_SETTINGS_UPDATED
+	case entity.ChatFoldersUpdated:
+		eventType = ParticipantEventType_CHAT_FOLDERS_UPDATED
+	}
+
+	return &ParticipantEvent{
+		Type:        eventType,
+		ChatId:      int64(event.ChatID.ID),
+		ChatType:    newChatTypeFromEntity(event.ChatID.Type),
+		UserId:      int64(event.UserID),
+		InitiatorId: int64(event.InitiatorID),
+	}
+}
+
+func (x *ParticipantEvent) Entity() entity.ParticipantEvent {
+	var eventType entity.ParticipantEventType
+	switch x.Type {
+	case ParticipantEventType_ADDED:
+		eventType = entity.AddedParticipant
+	case ParticipantEventType_REMOVED:
+		eventType = entity.RemovedParticipant
+	case ParticipantEventType_JOIN_REQUESTED:
+		eventType = entity.JoinRequested
+	case ParticipantEventType_RESTRICTED:
+		eventType = entity.RestrictedParticipant
+	case ParticipantEventType_CHAT_UPDATED:
+		eventType = entity.ChatUpdated
+	case ParticipantEventType_CHAT_SETTINGS_UPDATED:
+		eventType = entity.ChatSettingsUpdated
+	case ParticipantEventType_CHAT_FOLDERS_UPDATED:
+		eventType = entity.ChatFoldersUpdated
+	}
+
+	return entity.ParticipantEvent{
+		Type: eventType,
+		ChatID: entity.ChatID{
+			ID:   int(x.ChatId),
+			Type: x.ChatType.entity(),
+		},
+		UserID:      int(x.UserId),
+		InitiatorID: int(x.InitiatorId),
+	}
+}
+
+func newChatTypeFromEntity(chatType entity.ChatType) ChatType {
+	switch chatType {
+	case entity.DialogChatType:
+		return ChatType_DIALOG
+	case entity.GroupChatType:
+		return ChatType_GROUP
+	case entity.ChannelChatType:
+		return ChatType_CHANNEL
+	}
+	return ChatType_DIALOG
+}
+
+func (x ChatType) entity() entity.ChatType {
+	switch x {
+	case ChatType_DIALOG:
+		return entity.DialogChatType
+	case ChatType_GROUP:
+		return entity.GroupChatType
+	case ChatType_CHANNEL:
+		return entity.ChannelChatType
+	}
+	return ""
+}
@@ -0,0 +1,385 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v4.24.3
+// source: model/sysbus.proto
+
+package model
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ParticipantEventType int32
+
+const (
+	ParticipantEventType_ADDED                 ParticipantEventType = 0
+	ParticipantEventType_REMOVED               ParticipantEventType = 1
+	ParticipantEventType_JOIN_REQUESTED        ParticipantEventType = 2
+	ParticipantEventType_RESTRICTED            ParticipantEventType = 3
+	ParticipantEventType_CHAT_UPDATED          ParticipantEventType = 4
+	ParticipantEventType_CHAT_SETTINGS_UPDATED ParticipantEventType = 5
+	ParticipantEventType_CHAT_FOLDERS_UPDATED  ParticipantEventType = 6
+)
+
+// Enum value maps for ParticipantEventType.
+var (
+	ParticipantEventType_name = map[int32]string{
+		0: "ADDED",
+		1: "REMOVED",
+		2: "JOIN_REQUESTED",
+		3: "RESTRICTED",
+		4: "CHAT_UPDATED",
+		5: "CHAT_SETTINGS_UPDATED",
+		6: "CHAT_FOLDERS_UPDATED",
+	}
+	ParticipantEventType_value = map[string]int32{
+		"ADDED":                 0,
+		"REMOVED":               1,
+		"JOIN_REQUESTED":        2,
+		"RESTRICTED":            3,
+		"CHAT_UPDATED":          4,
+		"CHAT_SETTINGS_UPDATED": 5,
+		"CHAT_FOLDERS_UPDATED":  6,
+	}
+)
+
+func (x ParticipantEventType) Enum() *ParticipantEventType {
+	p := new(ParticipantEventType)
+	*p = x
+	return p
+}
+
+func (x ParticipantEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ParticipantEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_model_sysbus_proto_enumTypes[0].Descriptor()
+}
+
+func (ParticipantEventType) Type() protoreflect.EnumType {
+	return &file_model_sysbus_proto_enumTypes[0]
+}
+
+func (x ParticipantEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ParticipantEventType.Descriptor instead.
+func (ParticipantEventType) EnumDescriptor() ([]byte, []int) {
+	return file_model_sysbus_proto_rawDescGZIP(), []int{0}
+}
+
+type ParticipantEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type        ParticipantEventType `protobuf:"varint,1,opt,name=type,proto3,enum=model.ParticipantEventType" json:"type,omitempty"`
+	ChatId      int64                `protobuf:"varint,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	ChatType    ChatType             `protobuf:"varint,3,opt,name=chat_type,json=chatType,proto3,enum=model.ChatType" json:"chat_type,omitempty"`
+	UserId      int64                `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	InitiatorId int64                `protobuf:"varint,5,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
+}
+
+func (x *ParticipantEvent) Reset() {
+	*x = ParticipantEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_model_sysbus_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParticipantEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantEvent) ProtoMessage() {}
+
+func (x *ParticipantEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_model_sysbus_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParticipantEvent.ProtoReflect.Descriptor instead.
+func (*ParticipantEvent) Descriptor() ([]byte, []int) {
+	return file_model_sysbus_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ParticipantEvent) GetType() ParticipantEventType {
+	if x != nil {
+		return x.Type
+	}
+	return ParticipantEventType_ADDED
+}
+
+func (x *ParticipantEvent) GetChatId() int64 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+func (x *ParticipantEvent) GetChatType() ChatType {
+	if x != nil {
+		return x.ChatType
+	}
+	return ChatType_DIALOG
+}
+
+func (x *ParticipantEvent) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ParticipantEvent) GetInitiatorId() int64 {
+	if x != nil {
+		return x.InitiatorId
+	}
+	return 0
+}
+
+// Envelope wraps payloads that application nodes exchange via the sysbus.
+// Fields must be only added, so nodes running an older schema version
+// skip unknown ones while decoding.
+type Envelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SchemaVersion uint32 `protobuf:"varint,1,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	// Types that are assignable to Payload:
+	//	*Envelope_Message
+	//	*Envelope_ParticipantEvent
+	Payload isEnvelope_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *Envelope) Reset() {
+	*x = Envelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_model_sysbus_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Envelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Envelope) ProtoMessage() {}
+
+func (x *Envelope) ProtoReflect() protoreflect.Message {
+	mi := &file_model_sysbus_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Envelope.ProtoReflect.Descriptor instead.
+func (*Envelope) Descriptor() ([]byte, []int) {
+	return file_model_sysbus_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Envelope) GetSchemaVersion() uint32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (m *Envelope) GetPayload() isEnvelope_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *Envelope) GetMessage() *Message {
+	if x, ok := x.GetPayload().(*Envelope_Message); ok {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *Envelope) GetParticipantEvent() *ParticipantEvent {
+	if x, ok := x.GetPayload().(*Envelope_ParticipantEvent); ok {
+		return x.ParticipantEvent
+	}
+	return nil
+}
+
+type isEnvelope_Payload interface {
+	isEnvelope_Payload()
+}
+
+type Envelope_Message struct {
+	Message *Message `protobuf:"bytes,2,opt,name=message,proto3,oneof"`
+}
+
+type Envelope_ParticipantEvent struct {
+	ParticipantEvent *ParticipantEvent `protobuf:"bytes,3,opt,name=participant_event,json=participantEvent,proto3,oneof"`
+}
+
+func (*Envelope_Message) isEnvelope_Payload() {}
+
+func (*Envelope_ParticipantEvent) isEnvelope_Payload() {}
+
+var File_model_sysbus_proto protoreflect.FileDescriptor
+
+var file_model_sysbus_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2f, 0x73, 0x79, 0x73, 0x62, 0x75, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x1a, 0x13, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xc6, 0x01, 0x0a, 0x10, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49, 0x64, 0x12,
+	0x2c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0xb0, 0x01, 0x0a, 0x08, 0x45, 0x6e,
+	0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x46, 0x0a, 0x11, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52,
+	0x10, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2a, 0x99, 0x01, 0x0a,
+	0x14, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a,
+	0x0e, 0x4a, 0x4f, 0x49, 0x4e, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x45, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x45, 0x44, 0x10,
+	0x03, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x48, 0x41, 0x54, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45,
+	0x44, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x48, 0x41, 0x54, 0x5f, 0x53, 0x45, 0x54, 0x54,
+	0x49, 0x4e, 0x47, 0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x05, 0x12, 0x18,
+	0x0a, 0x14, 0x43, 0x48, 0x41, 0x54, 0x5f, 0x46, 0x4f, 0x4c, 0x44, 0x45, 0x52, 0x53, 0x5f, 0x55,
+	0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x06, 0x42, 0x09, 0x5a, 0x07, 0x2e, 0x3b, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_model_sysbus_proto_rawDescOnce sync.Once
+	file_model_sysbus_proto_rawDescData = file_model_sysbus_proto_rawDesc
+)
+
+func file_model_sysbus_proto_rawDescGZIP() []byte {
+	file_model_sysbus_proto_rawDescOnce.Do(func() {
+		file_model_sysbus_proto_rawDescData = protoimpl.X.CompressGZIP(file_model_sysbus_proto_rawDescData)
+	})
+	return file_model_sysbus_proto_rawDescData
+}
+
+var file_model_sysbus_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_model_sysbus_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_model_sysbus_proto_goTypes = []interface{}{
+	(ParticipantEventType)(0), // 0: model.ParticipantEventType
+	(*ParticipantEvent)(nil),  // 1: model.ParticipantEvent
+	(*Envelope)(nil),          // 2: model.Envelope
+	(ChatType)(0),             // 3: model.ChatType
+	(*Message)(nil),           // 4: model.Message
+}
+var file_model_sysbus_proto_depIdxs = []int32{
+	0, // 0: model.ParticipantEvent.type:type_name -> model.ParticipantEventType
+	3, // 1: model.ParticipantEvent.chat_type:type_name -> model.ChatType
+	4, // 2: model.Envelope.message:type_name -> model.Message
+	1, // 3: model.Envelope.participant_event:type_name -> model.ParticipantEvent
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_model_sysbus_proto_init() }
+func file_model_sysbus_proto_init() {
+	if File_model_sysbus_proto != nil {
+		return
+	}
+	file_model_message_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_model_sysbus_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParticipantEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_model_sysbus_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Envelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_model_sysbus_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*Envelope_Message)(nil),
+		(*Envelope_ParticipantEvent)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_model_sysbus_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_model_sysbus_proto_goTypes,
+		DependencyIndexes: file_model_sysbus_proto_depIdxs,
+		EnumInfos:         file_model_sysbus_proto_enumTypes,
+		MessageInfos:      file_model_sysbus_proto_msgTypes,
+	}.Build()
+	File_model_sysbus_proto = out.File
+	file_model_sysbus_proto_rawDesc = nil
+	file_model_sysbus_proto_goTypes = nil
+	file_model_sysbus_proto_depIdxs = nil
+}
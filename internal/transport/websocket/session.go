@@ -28,7 +28,7 @@ type MessageServeManager interface {
 	BeginServe(ctx context.Context, inCh <-chan dto.MessageCreate) (<-chan entity.Message, <-chan error, error)
 }
 
-//go:generate protoc --go_out=./model ./model/message.proto
+//go:generate protoc --go_out=./model ./model/message.proto ./model/sysbus.proto
 type ClientSession struct {
 	userID  ctxutil.UserID
 	logger  *log.Logger
@@ -129,7 +129,27 @@ func (s *ClientSession) writeMessages(outCh <-chan entity.Message, errCh <-chan
 			}
 
 			logger := s.logger.WithError(err)
-			if errors.Is(err, entity.ErrGroupNotFound) || errors.Is(err, entity.ErrDialogNotFound) {
+			if errors.Is(err, entity.ErrRestrictedGroupParticipant) {
+				// The restricted participant still can read chats, so the session goes on.
+				logger.Debug("Message of the restricted participant is rejected")
+				continue
+			}
+			if errors.Is(err, entity.ErrForbiddenPerformAction) || errors.Is(err, entity.ErrGroupSlowModeActive) ||
+				errors.Is(err, entity.ErrUserBlocked) || errors.Is(err, entity.ErrEmailNotVerified) {
+				// Channel subscribers, group participants who can't post due to the group
+				// settings, blocked users and users with unverified email still can read chats.
+				logger.Debug("Message is rejected by chat settings")
+				continue
+			}
+
+			if errors.Is(err, entity.ErrMessageConsumerOverflow) {
+				// The client reconnects and fetches the dropped messages.
+				logger.Warn("Session doesn't keep up with messages")
+				return
+			}
+
+			if errors.Is(err, entity.ErrGroupNotFound) || errors.Is(err, entity.ErrDialogNotFound) ||
+				errors.Is(err, entity.ErrChannelNotFound) {
 				logger.Debug("Error while serving messages")
 			} else {
 				logger.Error("Error while serving messages")
@@ -4,6 +4,7 @@ import "errors"
 
 var (
 	ErrWrongCredentials    = errors.New("wrong username or password")
+	ErrLoginForbidden      = errors.New("login is forbidden")
 	ErrInvalidRefreshToken = errors.New("invalid refresh token")
 	ErrSessionNotFound     = errors.New("session is not found")
 )
@@ -19,6 +19,8 @@ const (
 	defaultRefreshTokeTTL = 60 * 24 * time.Hour
 )
 
+// CheckPasswordFunc checks credentials of the user. It returns an error wrapping ErrLoginForbidden
+// if the credentials are right, but the user isn't allowed to log in.
 type CheckPasswordFunc func(ctx context.Context, user, password string) (userID string, ok bool, err error)
 
 type EnrichClaimsFunc func(claims token.Claims)
@@ -142,6 +144,9 @@ func (s *Service) Login(ctx context.Context, cred Credentials, opts ...MetaOptio
 
 	userID, ok, err := s.checkPassword(ctx, cred.Username, cred.Password)
 	if err != nil {
+		if errors.Is(err, ErrLoginForbidden) {
+			s.logger.Warnf("User `%s` is forbidden to login", cred.Username)
+		}
 		return pair, fmt.Errorf("check password: %w", err)
 	}
 	if !ok {
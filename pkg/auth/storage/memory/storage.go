@@ -0,0 +1,68 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	core "github.com/Chatyx/backend/pkg/auth"
+)
+
+type Storage struct {
+	mu           sync.Mutex
+	sessions     map[string]core.Session
+	userSessions map[string]map[string]struct{}
+}
+
+func NewStorage() *Storage {
+	return &Storage{
+		sessions:     make(map[string]core.Session),
+		userSessions: make(map[string]map[string]struct{}),
+	}
+}
+
+func (s *Storage) Set(_ context.Context, sess core.Session) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sessions[sess.RefreshToken] = sess
+
+	refreshTokens, ok := s.userSessions[sess.UserID]
+	if !ok {
+		refreshTokens = make(map[string]struct{})
+		s.userSessions[sess.UserID] = refreshTokens
+	}
+	refreshTokens[sess.RefreshToken] = struct{}{}
+
+	return nil
+}
+
+func (s *Storage) GetWithDelete(_ context.Context, refreshToken string) (core.Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sess, ok := s.sessions[refreshToken]
+	if !ok {
+		return core.Session{}, core.ErrSessionNotFound
+	}
+
+	delete(s.sessions, refreshToken)
+	delete(s.userSessions[sess.UserID], refreshToken)
+
+	return sess, nil
+}
+
+func (s *Storage) DeleteAllByUserID(_ context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for refreshToken := range s.userSessions[id] {
+		delete(s.sessions, refreshToken)
+	}
+	delete(s.userSessions, id)
+
+	return nil
+}
+
+func (s *Storage) Close() error {
+	return nil
+}
@@ -0,0 +1,75 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	core "github.com/Chatyx/backend/pkg/auth"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Storage struct {
+	pool *pgxpool.Pool
+}
+
+func NewStorage(pool *pgxpool.Pool) *Storage {
+	return &Storage{pool: pool}
+}
+
+func (s *Storage) Set(ctx context.Context, sess core.Session) error {
+	query := `INSERT INTO sessions
+		(refresh_token, user_id, fingerprint, ip, expires_at, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6)`
+
+	_, err := s.pool.Exec(ctx, query,
+		sess.RefreshToken, sess.UserID, sess.Fingerprint,
+		sess.IP.String(), sess.ExpiresAt, sess.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("exec query to insert session: %v", err)
+	}
+	return nil
+}
+
+func (s *Storage) GetWithDelete(ctx context.Context, refreshToken string) (core.Session, error) {
+	var (
+		sess core.Session
+		ip   string
+	)
+
+	query := `DELETE FROM sessions
+	WHERE refresh_token = $1
+	RETURNING user_id, fingerprint, ip, expires_at, created_at`
+
+	err := s.pool.QueryRow(ctx, query, refreshToken).Scan(
+		&sess.UserID, &sess.Fingerprint, &ip,
+		&sess.ExpiresAt, &sess.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return sess, core.ErrSessionNotFound
+		}
+		return sess, fmt.Errorf("exec query to delete session: %v", err)
+	}
+
+	sess.RefreshToken = refreshToken
+	sess.IP = net.ParseIP(ip)
+
+	return sess, nil
+}
+
+func (s *Storage) DeleteAllByUserID(ctx context.Context, id string) error {
+	if _, err := s.pool.Exec(ctx, "DELETE FROM sessions WHERE user_id = $1", id); err != nil {
+		return fmt.Errorf("exec query to delete all user sessions: %v", err)
+	}
+	return nil
+}
+
+// Close does nothing because the pool is owned by the caller.
+func (s *Storage) Close() error {
+	return nil
+}
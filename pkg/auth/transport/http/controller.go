@@ -133,6 +133,7 @@ func (c *Controller) Register(mux *httprouter.Router) {
 //	@Success		200				{object}	TokenPair
 //	@Failure		400				{object}	httputil.Error
 //	@Failure		401				{object}	httputil.Error
+//	@Failure		403				{object}	httputil.Error
 //	@Failure		500				{object}	httputil.Error
 //	@Router			/auth/login [post]
 func (c *Controller) login(w http.ResponseWriter, req *http.Request) {
@@ -177,6 +178,8 @@ func (c *Controller) login(w http.ResponseWriter, req *http.Request) {
 		switch {
 		case errors.Is(err, core.ErrWrongCredentials):
 			httputil.RespondError(ctx, w, ErrLoginFailed.Wrap(err))
+		case errors.Is(err, core.ErrLoginForbidden):
+			httputil.RespondError(ctx, w, ErrLoginForbidden.Wrap(err))
 		default:
 			httputil.RespondError(ctx, w, err)
 		}
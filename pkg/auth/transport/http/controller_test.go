@@ -2,6 +2,7 @@ package http
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -81,6 +82,24 @@ func TestController_login(t *testing.T) {
 			expectedStatusCode:   http.StatusUnauthorized,
 			expectedResponseBody: `{"code":"AU0001","message":"login failed"}`,
 		},
+		{
+			name:           "Login is forbidden",
+			requestBody:    `{"username":"root","password":"root1234"}`,
+			setFingerprint: true,
+			mockBehavior: func(s *MockService) {
+				s.On("Login",
+					mock.Anything,
+					auth.Credentials{
+						Username:    "root",
+						Password:    "root1234",
+						Fingerprint: defaultFingerprint,
+					},
+					mock.AnythingOfType("auth.MetaOption"),
+				).Return(auth.TokenPair{}, fmt.Errorf("check password: %w", auth.ErrLoginForbidden))
+			},
+			expectedStatusCode:   http.StatusForbidden,
+			expectedResponseBody: `{"code":"AU0003","message":"login is forbidden"}`,
+		},
 		{
 			name:                 "Decode body error",
 			requestBody:          `{"username":"root","password":"root1234`,
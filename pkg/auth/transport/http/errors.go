@@ -9,4 +9,5 @@ import (
 var (
 	ErrLoginFailed         = httputil.Error{Code: "AU0001", Message: "login failed", StatusCode: http.StatusUnauthorized}
 	ErrInvalidRefreshToken = httputil.Error{Code: "AU0002", Message: "invalid refresh token", StatusCode: http.StatusBadRequest}
+	ErrLoginForbidden      = httputil.Error{Code: "AU0003", Message: "login is forbidden", StatusCode: http.StatusForbidden}
 )
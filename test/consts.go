@@ -9,6 +9,8 @@ const (
 	mickUserID   = 2
 	mickUsername = "mick47"
 
+	williamUsername = "william86"
+
 	jacobUserID   = 4
 	jacobUsername = "jacob86"
 )
@@ -86,6 +86,27 @@ func (s *AppTestSuite) TestMessagesNotInGroupViaWebsocket() {
 	s.testCommunicationIfSenderNotInChat(jacobConn, johnConn, chatID)
 }
 
+func (s *AppTestSuite) TestMessagesPendingInGroupViaWebsocket() {
+	johnPair, err := s.authenticate(johnUsername)
+	s.Require().NoError(err, "Failed to authenticate")
+
+	johnConn, err := s.newWebsocketConn(johnPair.AccessToken)
+	s.Require().NoError(err, "Failed to open websocket connection")
+	defer johnConn.Close()
+
+	williamPair, err := s.authenticate(williamUsername)
+	s.Require().NoError(err, "Failed to authenticate")
+
+	williamConn, err := s.newWebsocketConn(williamPair.AccessToken)
+	s.Require().NoError(err, "Failed to open websocket connection")
+	defer williamConn.Close()
+
+	time.Sleep(delay)
+
+	chatID := entity.ChatID{ID: 2, Type: entity.GroupChatType}
+	s.testCommunicationIfReceiverNotInChat(johnConn, williamConn, chatID)
+}
+
 func (s *AppTestSuite) TestMessagesNotInDialogViaWebsocket() {
 	johnPair, err := s.authenticate(johnUsername)
 	s.Require().NoError(err, "Failed to authenticate")
@@ -238,6 +259,27 @@ func (s *AppTestSuite) testCommunicationIfSenderNotInChat(sendConn, recConn *ws.
 	}
 }
 
+func (s *AppTestSuite) testCommunicationIfReceiverNotInChat(sendConn, recConn *ws.Conn, chatID entity.ChatID) {
+	err := s.sendMessageViaWebsocket(sendConn, chatID, "Hello! You aren't in this chat yet")
+	s.Require().NoError(err, "Failed to send message via websocket")
+
+	msgCh := make(chan entity.Message, 1)
+	go func() {
+		msg, err := s.receiveMessageViaWebsocket(recConn)
+		if err != nil {
+			return
+		}
+
+		msgCh <- msg
+	}()
+
+	select {
+	case <-msgCh:
+		s.T().Error("Got a message, but not expected")
+	case <-time.After(receiveMessageTimeout):
+	}
+}
+
 func (s *AppTestSuite) sendMessageViaWebsocket(conn *ws.Conn, chatID entity.ChatID, text string) error {
 	var chatType model.ChatType
 	switch chatID.Type {